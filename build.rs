@@ -8,6 +8,7 @@ fn main() -> Result<(), Box<dyn std::error::Error>> {
                 "proto/common.proto",
                 "proto/security.proto",
                 "proto/dfdaemon.proto",
+                "proto/model_config.proto",
                 "proto/manager.proto",
                 "proto/scheduler.proto",
             ],
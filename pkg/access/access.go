@@ -0,0 +1,239 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package access evaluates the access control of manager.v2.Application,
+// the rules are compiled once and used to authorize every common.v2.Download.
+package access
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	errordetailsv2 "d7y.io/api/v2/pkg/apis/errordetails/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// tokenDigestPrefix is the prefix of the token digest.
+const tokenDigestPrefix = "sha256:"
+
+// Credential is the credential of application, the token is the api token
+// and the identity is the URI SAN of the client certificate.
+type Credential struct {
+	// Token is the api token of application.
+	Token string
+
+	// Identity is the mTLS identity of application.
+	Identity string
+}
+
+// rules is the compiled access control of application.
+type rules struct {
+	// tokenDigests is the set of the hex encoded sha256 digests of the api tokens.
+	tokenDigests map[string]struct{}
+
+	// identities is the set of the mTLS identities.
+	identities map[string]struct{}
+
+	// allow matches the allowed urls, nil represents all urls are allowed.
+	allow *regexp.Regexp
+
+	// deny matches the denied urls, nil represents no urls are denied.
+	deny *regexp.Regexp
+}
+
+// Evaluator evaluates the access control of applications, it is safe for concurrent use.
+type Evaluator struct {
+	// applications is the compiled rules by application name.
+	applications map[string]*rules
+
+	// defaultAccessControl is the access control of the unknown applications.
+	defaultAccessControl *managerv2.ApplicationAccessControl
+
+	// defaults is the compiled rules of the unknown applications, nil represents
+	// the unknown applications are denied.
+	defaults *rules
+}
+
+// Option is a functional option for configuring the Evaluator.
+type Option func(e *Evaluator)
+
+// WithDefaultAccessControl sets the default access control of the unknown applications,
+// including the empty application name. The empty access control allows the unknown
+// applications to download any url. Without the default access control, the unknown
+// applications are denied with UNKNOWN_APPLICATION.
+func WithDefaultAccessControl(accessControl *managerv2.ApplicationAccessControl) Option {
+	return func(e *Evaluator) {
+		e.defaultAccessControl = accessControl
+	}
+}
+
+// New returns the evaluator of the applications, the applications without access control
+// are allowed to download any url, and the unknown applications are denied unless the
+// default access control is set. The application names must be unique and not empty.
+func New(applications []*managerv2.Application, options ...Option) (*Evaluator, error) {
+	e := &Evaluator{applications: make(map[string]*rules, len(applications))}
+	for _, opt := range options {
+		opt(e)
+	}
+
+	for _, application := range applications {
+		if application.GetName() == "" {
+			return nil, errors.New("application name must not be empty")
+		}
+
+		if _, ok := e.applications[application.GetName()]; ok {
+			return nil, fmt.Errorf("duplicate application %s", application.GetName())
+		}
+
+		r, err := compile(application.GetAccessControl())
+		if err != nil {
+			return nil, fmt.Errorf("application %s: %w", application.GetName(), err)
+		}
+
+		e.applications[application.GetName()] = r
+	}
+
+	if e.defaultAccessControl != nil {
+		r, err := compile(e.defaultAccessControl)
+		if err != nil {
+			return nil, fmt.Errorf("default access control: %w", err)
+		}
+
+		e.defaults = r
+	}
+
+	return e, nil
+}
+
+// compile compiles the access control of application, the nil access control has no rules.
+func compile(accessControl *managerv2.ApplicationAccessControl) (*rules, error) {
+	r := &rules{
+		tokenDigests: make(map[string]struct{}, len(accessControl.GetTokenDigests())),
+		identities:   make(map[string]struct{}, len(accessControl.GetIdentities())),
+	}
+
+	for _, digest := range accessControl.GetTokenDigests() {
+		if !strings.HasPrefix(digest, tokenDigestPrefix) {
+			return nil, fmt.Errorf("invalid token digest %s", digest)
+		}
+
+		r.tokenDigests[strings.ToLower(strings.TrimPrefix(digest, tokenDigestPrefix))] = struct{}{}
+	}
+
+	for _, identity := range accessControl.GetIdentities() {
+		r.identities[identity] = struct{}{}
+	}
+
+	var err error
+	if r.allow, err = compileRegexes(accessControl.GetAllowUrlRegexes()); err != nil {
+		return nil, err
+	}
+
+	if r.deny, err = compileRegexes(accessControl.GetDenyUrlRegexes()); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// compileRegexes compiles the regexes into one regexp, so the url is matched by one pass.
+func compileRegexes(regexes []string) (*regexp.Regexp, error) {
+	if len(regexes) == 0 {
+		return nil, nil
+	}
+
+	for _, regex := range regexes {
+		if _, err := regexp.Compile(regex); err != nil {
+			return nil, fmt.Errorf("invalid url regex %s: %w", regex, err)
+		}
+	}
+
+	return regexp.Compile("(?:" + strings.Join(regexes, ")|(?:") + ")")
+}
+
+// Authorize authorizes the credential to download, it returns the grpc status error
+// with PermissionDenied code and errordetails.v2.AccessDeniedError if the access is denied.
+func (e *Evaluator) Authorize(download *commonv2.Download, credential Credential) error {
+	r, ok := e.applications[download.GetApplication()]
+	if !ok {
+		if e.defaults == nil {
+			return newError(download, errordetailsv2.AccessDeniedReason_UNKNOWN_APPLICATION, "unknown application")
+		}
+
+		r = e.defaults
+	}
+
+	if !r.authenticate(credential) {
+		return newError(download, errordetailsv2.AccessDeniedReason_INVALID_CREDENTIAL, "invalid credential of application")
+	}
+
+	if r.deny != nil && r.deny.MatchString(download.GetUrl()) {
+		return newError(download, errordetailsv2.AccessDeniedReason_URL_DENIED, "url is denied by application")
+	}
+
+	if r.allow != nil && !r.allow.MatchString(download.GetUrl()) {
+		return newError(download, errordetailsv2.AccessDeniedReason_URL_NOT_ALLOWED, "url is not allowed by application")
+	}
+
+	return nil
+}
+
+// authenticate returns whether the credential matches the tokens or the identities,
+// the application without tokens and identities does not require credential.
+func (r *rules) authenticate(credential Credential) bool {
+	if len(r.tokenDigests) == 0 && len(r.identities) == 0 {
+		return true
+	}
+
+	if credential.Token != "" {
+		digest := sha256.Sum256([]byte(credential.Token))
+		if _, ok := r.tokenDigests[hex.EncodeToString(digest[:])]; ok {
+			return true
+		}
+	}
+
+	if credential.Identity != "" {
+		if _, ok := r.identities[credential.Identity]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newError returns the grpc status error of access denied.
+func newError(download *commonv2.Download, reason errordetailsv2.AccessDeniedReason, description string) error {
+	st := status.New(codes.PermissionDenied, fmt.Sprintf("%s: %s", description, download.GetApplication()))
+	dst, err := st.WithDetails(&errordetailsv2.AccessDeniedError{
+		Application: download.GetApplication(),
+		Url:         download.GetUrl(),
+		Reason:      reason,
+		Description: description,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return dst.Err()
+}
@@ -0,0 +1,229 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	errordetailsv2 "d7y.io/api/v2/pkg/apis/errordetails/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+const (
+	// testToken is the api token of the restricted application.
+	testToken = "secret"
+
+	// testIdentity is the mTLS identity of the restricted application.
+	testIdentity = "spiffe://dragonfly/application/restricted"
+)
+
+// tokenDigest returns the token digest of the token.
+func tokenDigest(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return tokenDigestPrefix + hex.EncodeToString(digest[:])
+}
+
+// testApplications returns the open application without access control and the restricted
+// application which allows the urls of example.com except the executables.
+func testApplications() []*managerv2.Application {
+	return []*managerv2.Application{
+		{Name: "open"},
+		{
+			Name: "restricted",
+			AccessControl: &managerv2.ApplicationAccessControl{
+				TokenDigests:    []string{tokenDigest(testToken)},
+				Identities:      []string{testIdentity},
+				AllowUrlRegexes: []string{`^https://example\.com/`, `^https://mirror\.example\.com/`},
+				DenyUrlRegexes:  []string{`\.exe$`},
+			},
+		},
+	}
+}
+
+// reasonOf returns the access denied reason of the error, UNSPECIFIED represents the error
+// has no access denied details.
+func reasonOf(t *testing.T, err error) errordetailsv2.AccessDeniedReason {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.PermissionDenied {
+		t.Fatalf("error code = %v, want %v", st.Code(), codes.PermissionDenied)
+	}
+
+	for _, detail := range st.Details() {
+		if accessDenied, ok := detail.(*errordetailsv2.AccessDeniedError); ok {
+			return accessDenied.GetReason()
+		}
+	}
+
+	return errordetailsv2.AccessDeniedReason_UNSPECIFIED
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		applications []*managerv2.Application
+		options      []Option
+		ok           bool
+	}{
+		{
+			name:         "valid",
+			applications: testApplications(),
+			ok:           true,
+		},
+		{
+			name:         "duplicate application",
+			applications: append(testApplications(), &managerv2.Application{Name: "open"}),
+		},
+		{
+			name:         "empty application name",
+			applications: []*managerv2.Application{{}},
+		},
+		{
+			name: "invalid token digest",
+			applications: []*managerv2.Application{
+				{Name: "foo", AccessControl: &managerv2.ApplicationAccessControl{TokenDigests: []string{"md5:0"}}},
+			},
+		},
+		{
+			name: "invalid url regex",
+			applications: []*managerv2.Application{
+				{Name: "foo", AccessControl: &managerv2.ApplicationAccessControl{DenyUrlRegexes: []string{"("}}},
+			},
+		},
+		{
+			name:         "invalid default access control",
+			applications: testApplications(),
+			options:      []Option{WithDefaultAccessControl(&managerv2.ApplicationAccessControl{AllowUrlRegexes: []string{"["}})},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := New(tc.applications, tc.options...); (err == nil) != tc.ok {
+				t.Fatalf("New() error = %v, want ok %t", err, tc.ok)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    []Option
+		download   *commonv2.Download
+		credential Credential
+		reason     errordetailsv2.AccessDeniedReason
+		allowed    bool
+	}{
+		{
+			name:     "application without access control",
+			download: &commonv2.Download{Application: "open", Url: "https://example.org/foo.exe"},
+			allowed:  true,
+		},
+		{
+			name:       "allowed url with token",
+			download:   &commonv2.Download{Application: "restricted", Url: "https://example.com/foo"},
+			credential: Credential{Token: testToken},
+			allowed:    true,
+		},
+		{
+			name:       "second allowed url with identity",
+			download:   &commonv2.Download{Application: "restricted", Url: "https://mirror.example.com/foo"},
+			credential: Credential{Identity: testIdentity},
+			allowed:    true,
+		},
+		{
+			name:       "invalid token",
+			download:   &commonv2.Download{Application: "restricted", Url: "https://example.com/foo"},
+			credential: Credential{Token: "invalid", Identity: "spiffe://dragonfly/application/open"},
+			reason:     errordetailsv2.AccessDeniedReason_INVALID_CREDENTIAL,
+		},
+		{
+			name:     "missing credential",
+			download: &commonv2.Download{Application: "restricted", Url: "https://example.com/foo"},
+			reason:   errordetailsv2.AccessDeniedReason_INVALID_CREDENTIAL,
+		},
+		{
+			name:       "deny takes precedence over allow",
+			download:   &commonv2.Download{Application: "restricted", Url: "https://example.com/foo.exe"},
+			credential: Credential{Token: testToken},
+			reason:     errordetailsv2.AccessDeniedReason_URL_DENIED,
+		},
+		{
+			name:       "url not allowed",
+			download:   &commonv2.Download{Application: "restricted", Url: "https://example.org/foo"},
+			credential: Credential{Token: testToken},
+			reason:     errordetailsv2.AccessDeniedReason_URL_NOT_ALLOWED,
+		},
+		{
+			name:     "unknown application",
+			download: &commonv2.Download{Application: "unknown", Url: "https://example.com/foo"},
+			reason:   errordetailsv2.AccessDeniedReason_UNKNOWN_APPLICATION,
+		},
+		{
+			name:     "empty application name",
+			download: &commonv2.Download{Url: "https://example.com/foo"},
+			reason:   errordetailsv2.AccessDeniedReason_UNKNOWN_APPLICATION,
+		},
+		{
+			name:     "unknown application with empty default access control",
+			options:  []Option{WithDefaultAccessControl(&managerv2.ApplicationAccessControl{})},
+			download: &commonv2.Download{Application: "unknown", Url: "https://example.org/foo"},
+			allowed:  true,
+		},
+		{
+			name:     "empty application name with default access control",
+			options:  []Option{WithDefaultAccessControl(&managerv2.ApplicationAccessControl{DenyUrlRegexes: []string{`^https://example\.org/`}})},
+			download: &commonv2.Download{Url: "https://example.org/foo"},
+			reason:   errordetailsv2.AccessDeniedReason_URL_DENIED,
+		},
+		{
+			name:     "known application ignores default access control",
+			options:  []Option{WithDefaultAccessControl(&managerv2.ApplicationAccessControl{})},
+			download: &commonv2.Download{Application: "restricted", Url: "https://example.com/foo"},
+			reason:   errordetailsv2.AccessDeniedReason_INVALID_CREDENTIAL,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := New(testApplications(), tc.options...)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			err = e.Authorize(tc.download, tc.credential)
+			if tc.allowed {
+				if err != nil {
+					t.Fatalf("Authorize() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if reason := reasonOf(t, err); reason != tc.reason {
+				t.Fatalf("Authorize() reason = %v, want %v", reason, tc.reason)
+			}
+		})
+	}
+}
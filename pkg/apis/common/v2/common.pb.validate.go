@@ -282,6 +282,8 @@ func (m *Peer) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for FinishedPieceCount
+
 	if len(errors) > 0 {
 		return PeerMultiError(errors)
 	}
@@ -2009,6 +2011,35 @@ func (m *Download) validate(all bool) error {
 
 	// no validation rules for NeedBackToSource
 
+	if all {
+		switch v := interface{}(m.GetTraceContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTraceContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadValidationError{
+				field:  "TraceContext",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadMultiError(errors)
 	}
@@ -2088,6 +2119,124 @@ var _ interface {
 
 var _Download_Digest_Pattern = regexp.MustCompile("^(md5)|(sha256):[A-Fa-f0-9]+$")
 
+// Validate checks the field values on TraceContext with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TraceContext) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TraceContext with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TraceContextMultiError, or
+// nil if none found.
+func (m *TraceContext) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TraceContext) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTraceparent() != "" {
+
+		if !_TraceContext_Traceparent_Pattern.MatchString(m.GetTraceparent()) {
+			err := TraceContextValidationError{
+				field:  "Traceparent",
+				reason: "value does not match regex pattern \"^[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}(-.*)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Tracestate
+
+	if len(errors) > 0 {
+		return TraceContextMultiError(errors)
+	}
+
+	return nil
+}
+
+// TraceContextMultiError is an error wrapping multiple validation errors
+// returned by TraceContext.ValidateAll() if the designated constraints aren't met.
+type TraceContextMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TraceContextMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TraceContextMultiError) AllErrors() []error { return m }
+
+// TraceContextValidationError is the validation error returned by
+// TraceContext.Validate if the designated constraints aren't met.
+type TraceContextValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TraceContextValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TraceContextValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TraceContextValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TraceContextValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TraceContextValidationError) ErrorName() string { return "TraceContextValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TraceContextValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTraceContext.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TraceContextValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TraceContextValidationError{}
+
+var _TraceContext_Traceparent_Pattern = regexp.MustCompile("^[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}(-.*)?$")
+
 // Validate checks the field values on Range with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2391,3 +2540,642 @@ var _ interface {
 } = PieceValidationError{}
 
 var _Piece_Digest_Pattern = regexp.MustCompile("^(md5)|(sha256):[A-Fa-f0-9]+$")
+
+// Validate checks the field values on DownloadTokenClaims with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTokenClaims) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTokenClaims with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTokenClaimsMultiError, or nil if none found.
+func (m *DownloadTokenClaims) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTokenClaims) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DownloadTokenClaimsValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		err := DownloadTokenClaimsValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetParentIds()) < 1 {
+		err := DownloadTokenClaimsValidationError{
+			field:  "ParentIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetParentIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := DownloadTokenClaimsValidationError{
+				field:  fmt.Sprintf("ParentIds[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetIssuedAt() == nil {
+		err := DownloadTokenClaimsValidationError{
+			field:  "IssuedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiredAt() == nil {
+		err := DownloadTokenClaimsValidationError{
+			field:  "ExpiredAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTokenClaimsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTokenClaimsMultiError is an error wrapping multiple validation
+// errors returned by DownloadTokenClaims.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTokenClaimsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTokenClaimsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTokenClaimsMultiError) AllErrors() []error { return m }
+
+// DownloadTokenClaimsValidationError is the validation error returned by
+// DownloadTokenClaims.Validate if the designated constraints aren't met.
+type DownloadTokenClaimsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTokenClaimsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTokenClaimsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTokenClaimsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTokenClaimsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTokenClaimsValidationError) ErrorName() string {
+	return "DownloadTokenClaimsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTokenClaimsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTokenClaims.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTokenClaimsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTokenClaimsValidationError{}
+
+// Validate checks the field values on DownloadToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DownloadToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DownloadTokenMultiError, or
+// nil if none found.
+func (m *DownloadToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetKeyId()) < 1 {
+		err := DownloadTokenValidationError{
+			field:  "KeyId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetClaims()) < 1 {
+		err := DownloadTokenValidationError{
+			field:  "Claims",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSignature()) != 64 {
+		err := DownloadTokenValidationError{
+			field:  "Signature",
+			reason: "value length must be 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTokenMultiError is an error wrapping multiple validation errors
+// returned by DownloadToken.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTokenMultiError) AllErrors() []error { return m }
+
+// DownloadTokenValidationError is the validation error returned by
+// DownloadToken.Validate if the designated constraints aren't met.
+type DownloadTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTokenValidationError) ErrorName() string { return "DownloadTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTokenValidationError{}
+
+// Validate checks the field values on TrafficStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TrafficStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrafficStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrafficStatisticsMultiError, or nil if none found.
+func (m *TrafficStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrafficStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := TrafficType_name[int32(m.GetTrafficType())]; !ok {
+		err := TrafficStatisticsValidationError{
+			field:  "TrafficType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Bytes
+
+	// no validation rules for PieceCount
+
+	if len(errors) > 0 {
+		return TrafficStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrafficStatisticsMultiError is an error wrapping multiple validation errors
+// returned by TrafficStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type TrafficStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrafficStatisticsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrafficStatisticsMultiError) AllErrors() []error { return m }
+
+// TrafficStatisticsValidationError is the validation error returned by
+// TrafficStatistics.Validate if the designated constraints aren't met.
+type TrafficStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficStatisticsValidationError) ErrorName() string {
+	return "TrafficStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficStatisticsValidationError{}
+
+// Validate checks the field values on DownloadStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadStatisticsMultiError, or nil if none found.
+func (m *DownloadStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		err := DownloadStatisticsValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DownloadStatisticsValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		err := DownloadStatisticsValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartedAt() == nil {
+		err := DownloadStatisticsValidationError{
+			field:  "StartedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFinishedAt() == nil {
+		err := DownloadStatisticsValidationError{
+			field:  "FinishedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTotalPieceCost() == nil {
+		err := DownloadStatisticsValidationError{
+			field:  "TotalPieceCost",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DownloadedBytes
+
+	// no validation rules for FinishedPieceCount
+
+	// no validation rules for FailedPieceCount
+
+	// no validation rules for RetryCount
+
+	// no validation rules for ParentCount
+
+	// no validation rules for ParentSwitchCount
+
+	for idx, item := range m.GetTraffics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadStatisticsValidationError{
+						field:  fmt.Sprintf("Traffics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadStatisticsValidationError{
+						field:  fmt.Sprintf("Traffics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadStatisticsValidationError{
+					field:  fmt.Sprintf("Traffics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BackToSource
+
+	if len(errors) > 0 {
+		return DownloadStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadStatisticsMultiError is an error wrapping multiple validation errors
+// returned by DownloadStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadStatisticsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadStatisticsMultiError) AllErrors() []error { return m }
+
+// DownloadStatisticsValidationError is the validation error returned by
+// DownloadStatistics.Validate if the designated constraints aren't met.
+type DownloadStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadStatisticsValidationError) ErrorName() string {
+	return "DownloadStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadStatisticsValidationError{}
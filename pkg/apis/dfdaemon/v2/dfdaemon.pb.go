@@ -27,6 +27,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -77,14 +78,24 @@ func (*InterestedAllPiecesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{0}
 }
 
-// InterestedPiecesRequest represents interested pieces request of SyncPiecesRequest.
+// InterestedPiecesRequest represents interested pieces request of SyncPiecesRequest, exactly
+// one of piece_numbers and piece_bitmap must be set, and the parent rejects the request which
+// sets both or neither of them, refer to bitmap.InterestedPieces of d7y.io/api/v2/pkg/bitmap.
 type InterestedPiecesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Interested piece numbers.
+	// Interested piece numbers, it must be empty if piece_bitmap is set.
 	PieceNumbers []uint32 `protobuf:"varint,1,rep,packed,name=piece_numbers,json=pieceNumbers,proto3" json:"piece_numbers,omitempty"`
+	// Interested piece numbers encoded by the run-length bitmap, refer to d7y.io/api/v2/pkg/bitmap,
+	// it must be empty if piece_numbers is set.
+	PieceBitmap []byte `protobuf:"bytes,2,opt,name=piece_bitmap,json=pieceBitmap,proto3" json:"piece_bitmap,omitempty"`
+	// Requested order of serving the interested pieces, PRIORITY_ORDER represents
+	// the parent serves pieces in order of piece_numbers. The bitmap carries no order,
+	// so PRIORITY_ORDER with piece_bitmap is served in ascending order of piece number
+	// as SEQUENTIAL_ORDER.
+	PieceOrder v2.PieceOrder `protobuf:"varint,3,opt,name=piece_order,json=pieceOrder,proto3,enum=common.v2.PieceOrder" json:"piece_order,omitempty"`
 }
 
 func (x *InterestedPiecesRequest) Reset() {
@@ -126,7 +137,21 @@ func (x *InterestedPiecesRequest) GetPieceNumbers() []uint32 {
 	return nil
 }
 
-// SyncPiecesRequest represents request of AnnouncePeer.
+func (x *InterestedPiecesRequest) GetPieceBitmap() []byte {
+	if x != nil {
+		return x.PieceBitmap
+	}
+	return nil
+}
+
+func (x *InterestedPiecesRequest) GetPieceOrder() v2.PieceOrder {
+	if x != nil {
+		return x.PieceOrder
+	}
+	return v2.PieceOrder(0)
+}
+
+// SyncPiecesRequest represents request of SyncPieces.
 type SyncPiecesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -137,6 +162,14 @@ type SyncPiecesRequest struct {
 	//	*SyncPiecesRequest_InterestedAllPiecesRequest
 	//	*SyncPiecesRequest_InterestedPiecesRequest
 	Request isSyncPiecesRequest_Request `protobuf_oneof:"request"`
+	// Token of downloading pieces which is issued by the scheduler, it is required by the
+	// first request of the stream if the parent enforces tokens by configuration, and the parent
+	// returns PermissionDenied if the token is invalid, expired or not scoped to the task and
+	// the parent. The token is a short-lived bearer token, anyone holding it can download the
+	// pieces until it expires, so it must only be sent over the encrypted connection.
+	DownloadToken *v2.DownloadToken `protobuf:"bytes,3,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
+	// Trace context of the request, the spans of serving the pieces are the children of it.
+	TraceContext *v2.TraceContext `protobuf:"bytes,4,opt,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty"`
 }
 
 func (x *SyncPiecesRequest) Reset() {
@@ -192,6 +225,20 @@ func (x *SyncPiecesRequest) GetInterestedPiecesRequest() *InterestedPiecesReques
 	return nil
 }
 
+func (x *SyncPiecesRequest) GetDownloadToken() *v2.DownloadToken {
+	if x != nil {
+		return x.DownloadToken
+	}
+	return nil
+}
+
+func (x *SyncPiecesRequest) GetTraceContext() *v2.TraceContext {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 type isSyncPiecesRequest_Request interface {
 	isSyncPiecesRequest_Request()
 }
@@ -208,7 +255,8 @@ func (*SyncPiecesRequest_InterestedAllPiecesRequest) isSyncPiecesRequest_Request
 
 func (*SyncPiecesRequest_InterestedPiecesRequest) isSyncPiecesRequest_Request() {}
 
-// InterestedPiecesResponse represents interested pieces response of SyncPiecesResponse.
+// InterestedPiecesResponse represents interested pieces response of SyncPiecesResponse,
+// it returns the metadata of the interested pieces which are available in the parent.
 type InterestedPiecesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -257,20 +305,20 @@ func (x *InterestedPiecesResponse) GetPieces() []*v2.Piece {
 	return nil
 }
 
-// SyncPiecesResponse represents response of SyncPieces.
-type SyncPiecesResponse struct {
+// AvailablePiecesResponse represents available pieces response of SyncPiecesResponse.
+type AvailablePiecesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*SyncPiecesResponse_InterestedPiecesResponse
-	Response isSyncPiecesResponse_Response `protobuf_oneof:"response"`
+	// Available piece numbers of the parent encoded by the run-length bitmap, refer to d7y.io/api/v2/pkg/bitmap.
+	PieceBitmap []byte `protobuf:"bytes,1,opt,name=piece_bitmap,json=pieceBitmap,proto3" json:"piece_bitmap,omitempty"`
+	// Total piece count of task, -1 represents the piece count is unknown.
+	PieceCount int32 `protobuf:"varint,2,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
 }
 
-func (x *SyncPiecesResponse) Reset() {
-	*x = SyncPiecesResponse{}
+func (x *AvailablePiecesResponse) Reset() {
+	*x = AvailablePiecesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,13 +326,13 @@ func (x *SyncPiecesResponse) Reset() {
 	}
 }
 
-func (x *SyncPiecesResponse) String() string {
+func (x *AvailablePiecesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncPiecesResponse) ProtoMessage() {}
+func (*AvailablePiecesResponse) ProtoMessage() {}
 
-func (x *SyncPiecesResponse) ProtoReflect() protoreflect.Message {
+func (x *AvailablePiecesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,47 +344,38 @@ func (x *SyncPiecesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncPiecesResponse.ProtoReflect.Descriptor instead.
-func (*SyncPiecesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailablePiecesResponse.ProtoReflect.Descriptor instead.
+func (*AvailablePiecesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{4}
 }
 
-func (m *SyncPiecesResponse) GetResponse() isSyncPiecesResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *AvailablePiecesResponse) GetPieceBitmap() []byte {
+	if x != nil {
+		return x.PieceBitmap
 	}
 	return nil
 }
 
-func (x *SyncPiecesResponse) GetInterestedPiecesResponse() *InterestedPiecesResponse {
-	if x, ok := x.GetResponse().(*SyncPiecesResponse_InterestedPiecesResponse); ok {
-		return x.InterestedPiecesResponse
+func (x *AvailablePiecesResponse) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
 	}
-	return nil
-}
-
-type isSyncPiecesResponse_Response interface {
-	isSyncPiecesResponse_Response()
-}
-
-type SyncPiecesResponse_InterestedPiecesResponse struct {
-	InterestedPiecesResponse *InterestedPiecesResponse `protobuf:"bytes,1,opt,name=interested_pieces_response,json=interestedPiecesResponse,proto3,oneof"`
+	return 0
 }
 
-func (*SyncPiecesResponse_InterestedPiecesResponse) isSyncPiecesResponse_Response() {}
-
-// DownloadTaskRequest represents request of DownloadTask.
-type DownloadTaskRequest struct {
+// HavePiecesResponse represents have pieces response of SyncPiecesResponse,
+// it announces the pieces which are newly downloaded by the parent.
+type HavePiecesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Download information.
-	Download *v2.Download `protobuf:"bytes,1,opt,name=download,proto3" json:"download,omitempty"`
+	// Newly available piece numbers encoded by the run-length bitmap, refer to d7y.io/api/v2/pkg/bitmap.
+	PieceBitmap []byte `protobuf:"bytes,1,opt,name=piece_bitmap,json=pieceBitmap,proto3" json:"piece_bitmap,omitempty"`
 }
 
-func (x *DownloadTaskRequest) Reset() {
-	*x = DownloadTaskRequest{}
+func (x *HavePiecesResponse) Reset() {
+	*x = HavePiecesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -344,13 +383,13 @@ func (x *DownloadTaskRequest) Reset() {
 	}
 }
 
-func (x *DownloadTaskRequest) String() string {
+func (x *HavePiecesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadTaskRequest) ProtoMessage() {}
+func (*HavePiecesResponse) ProtoMessage() {}
 
-func (x *DownloadTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *HavePiecesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,30 +401,31 @@ func (x *DownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*DownloadTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HavePiecesResponse.ProtoReflect.Descriptor instead.
+func (*HavePiecesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DownloadTaskRequest) GetDownload() *v2.Download {
+func (x *HavePiecesResponse) GetPieceBitmap() []byte {
 	if x != nil {
-		return x.Download
+		return x.PieceBitmap
 	}
 	return nil
 }
 
-// UploadTaskRequest represents request of UploadTask.
-type UploadTaskRequest struct {
+// LostPiecesResponse represents lost pieces response of SyncPiecesResponse,
+// it announces the pieces which are evicted by the parent and no longer available.
+type LostPiecesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Task metadata.
-	Task *v2.Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// Lost piece numbers encoded by the run-length bitmap, refer to d7y.io/api/v2/pkg/bitmap.
+	PieceBitmap []byte `protobuf:"bytes,1,opt,name=piece_bitmap,json=pieceBitmap,proto3" json:"piece_bitmap,omitempty"`
 }
 
-func (x *UploadTaskRequest) Reset() {
-	*x = UploadTaskRequest{}
+func (x *LostPiecesResponse) Reset() {
+	*x = LostPiecesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,13 +433,13 @@ func (x *UploadTaskRequest) Reset() {
 	}
 }
 
-func (x *UploadTaskRequest) String() string {
+func (x *LostPiecesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadTaskRequest) ProtoMessage() {}
+func (*LostPiecesResponse) ProtoMessage() {}
 
-func (x *UploadTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *LostPiecesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,30 +451,33 @@ func (x *UploadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadTaskRequest.ProtoReflect.Descriptor instead.
-func (*UploadTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LostPiecesResponse.ProtoReflect.Descriptor instead.
+func (*LostPiecesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UploadTaskRequest) GetTask() *v2.Task {
+func (x *LostPiecesResponse) GetPieceBitmap() []byte {
 	if x != nil {
-		return x.Task
+		return x.PieceBitmap
 	}
 	return nil
 }
 
-// StatTaskRequest represents request of StatTask.
-type StatTaskRequest struct {
+// ParentFinishedResponse represents parent finished response of SyncPiecesResponse,
+// it announces that the parent has downloaded all pieces, and no more have pieces response is sent.
+type ParentFinishedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Task id.
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Total content length.
+	ContentLength int64 `protobuf:"varint,1,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// Total piece count.
+	PieceCount int32 `protobuf:"varint,2,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
 }
 
-func (x *StatTaskRequest) Reset() {
-	*x = StatTaskRequest{}
+func (x *ParentFinishedResponse) Reset() {
+	*x = ParentFinishedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +485,13 @@ func (x *StatTaskRequest) Reset() {
 	}
 }
 
-func (x *StatTaskRequest) String() string {
+func (x *ParentFinishedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatTaskRequest) ProtoMessage() {}
+func (*ParentFinishedResponse) ProtoMessage() {}
 
-func (x *StatTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *ParentFinishedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,30 +503,39 @@ func (x *StatTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatTaskRequest.ProtoReflect.Descriptor instead.
-func (*StatTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParentFinishedResponse.ProtoReflect.Descriptor instead.
+func (*ParentFinishedResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *StatTaskRequest) GetTaskId() string {
+func (x *ParentFinishedResponse) GetContentLength() int64 {
 	if x != nil {
-		return x.TaskId
+		return x.ContentLength
 	}
-	return ""
+	return 0
 }
 
-// StatTaskResponse represents response of StatTask.
-type StatTaskResponse struct {
+func (x *ParentFinishedResponse) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+// ParentFailedResponse represents parent failed response of SyncPiecesResponse,
+// it announces that the parent fails to download the task, the pieces which have been
+// announced are still available until the stream is closed or lost pieces response is sent.
+type ParentFailedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Task metadata.
-	Task *v2.Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// The description of the parent download failed.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *StatTaskResponse) Reset() {
-	*x = StatTaskResponse{}
+func (x *ParentFailedResponse) Reset() {
+	*x = ParentFailedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -491,13 +543,13 @@ func (x *StatTaskResponse) Reset() {
 	}
 }
 
-func (x *StatTaskResponse) String() string {
+func (x *ParentFailedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatTaskResponse) ProtoMessage() {}
+func (*ParentFailedResponse) ProtoMessage() {}
 
-func (x *StatTaskResponse) ProtoReflect() protoreflect.Message {
+func (x *ParentFailedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,30 +561,40 @@ func (x *StatTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatTaskResponse.ProtoReflect.Descriptor instead.
-func (*StatTaskResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParentFailedResponse.ProtoReflect.Descriptor instead.
+func (*ParentFailedResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StatTaskResponse) GetTask() *v2.Task {
+func (x *ParentFailedResponse) GetDescription() string {
 	if x != nil {
-		return x.Task
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-// DeleteTaskRequest represents request of DeleteTask.
-type DeleteTaskRequest struct {
+// SyncPiecesResponse represents response of SyncPieces.
+// The parent sends available pieces response first, and then sends have pieces response
+// and lost pieces response incrementally when its pieces are changed, so the child can keep
+// the stream long-lived and pipeline the interested pieces requests instead of polling.
+type SyncPiecesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Task id.
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*SyncPiecesResponse_InterestedPiecesResponse
+	//	*SyncPiecesResponse_AvailablePiecesResponse
+	//	*SyncPiecesResponse_HavePiecesResponse
+	//	*SyncPiecesResponse_LostPiecesResponse
+	//	*SyncPiecesResponse_ParentFinishedResponse
+	//	*SyncPiecesResponse_ParentFailedResponse
+	Response isSyncPiecesResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
+func (x *SyncPiecesResponse) Reset() {
+	*x = SyncPiecesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -540,13 +602,13 @@ func (x *DeleteTaskRequest) Reset() {
 	}
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *SyncPiecesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*SyncPiecesResponse) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *SyncPiecesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -558,193 +620,1051 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncPiecesResponse.ProtoReflect.Descriptor instead.
+func (*SyncPiecesResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteTaskRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
+func (m *SyncPiecesResponse) GetResponse() isSyncPiecesResponse_Response {
+	if m != nil {
+		return m.Response
 	}
-	return ""
+	return nil
 }
 
-var File_pkg_apis_dfdaemon_v2_dfdaemon_proto protoreflect.FileDescriptor
+func (x *SyncPiecesResponse) GetInterestedPiecesResponse() *InterestedPiecesResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_InterestedPiecesResponse); ok {
+		return x.InterestedPiecesResponse
+	}
+	return nil
+}
 
-var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x66, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x1a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
-	0x02, 0x08, 0x01, 0x52, 0x0c, 0x70, 0x69, 0x65, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x1d, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x50, 0x0a, 0x18, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12,
-	0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x65, 0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50,
-	0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x18, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x50, 0x0a, 0x13, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x42, 0x0a, 0x11,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b,
-	0x22, 0x33, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x73,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x35, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x32,
-	0xee, 0x02, 0x0a, 0x08, 0x44, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0a,
-	0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x66, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12,
-	0x48, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x20, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x0a, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x39, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x64, 0x66,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x44, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x2d, 0x5a, 0x2b, 0x64, 0x37, 0x79, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x66, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SyncPiecesResponse) GetAvailablePiecesResponse() *AvailablePiecesResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_AvailablePiecesResponse); ok {
+		return x.AvailablePiecesResponse
+	}
+	return nil
 }
 
-var (
-	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescOnce sync.Once
-	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData = file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDesc
-)
+func (x *SyncPiecesResponse) GetHavePiecesResponse() *HavePiecesResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_HavePiecesResponse); ok {
+		return x.HavePiecesResponse
+	}
+	return nil
+}
 
-func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP() []byte {
-	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescOnce.Do(func() {
-		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData)
-	})
-	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData
+func (x *SyncPiecesResponse) GetLostPiecesResponse() *LostPiecesResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_LostPiecesResponse); ok {
+		return x.LostPiecesResponse
+	}
+	return nil
 }
 
-var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_goTypes = []interface{}{
-	(*InterestedAllPiecesRequest)(nil), // 0: dfdaemon.v2.InterestedAllPiecesRequest
-	(*InterestedPiecesRequest)(nil),    // 1: dfdaemon.v2.InterestedPiecesRequest
-	(*SyncPiecesRequest)(nil),          // 2: dfdaemon.v2.SyncPiecesRequest
-	(*InterestedPiecesResponse)(nil),   // 3: dfdaemon.v2.InterestedPiecesResponse
-	(*SyncPiecesResponse)(nil),         // 4: dfdaemon.v2.SyncPiecesResponse
-	(*DownloadTaskRequest)(nil),        // 5: dfdaemon.v2.DownloadTaskRequest
-	(*UploadTaskRequest)(nil),          // 6: dfdaemon.v2.UploadTaskRequest
-	(*StatTaskRequest)(nil),            // 7: dfdaemon.v2.StatTaskRequest
-	(*StatTaskResponse)(nil),           // 8: dfdaemon.v2.StatTaskResponse
-	(*DeleteTaskRequest)(nil),          // 9: dfdaemon.v2.DeleteTaskRequest
-	(*v2.Piece)(nil),                   // 10: common.v2.Piece
-	(*v2.Download)(nil),                // 11: common.v2.Download
-	(*v2.Task)(nil),                    // 12: common.v2.Task
-	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
+func (x *SyncPiecesResponse) GetParentFinishedResponse() *ParentFinishedResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_ParentFinishedResponse); ok {
+		return x.ParentFinishedResponse
+	}
+	return nil
 }
-var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_depIdxs = []int32{
-	0,  // 0: dfdaemon.v2.SyncPiecesRequest.interested_all_pieces_request:type_name -> dfdaemon.v2.InterestedAllPiecesRequest
-	1,  // 1: dfdaemon.v2.SyncPiecesRequest.interested_pieces_request:type_name -> dfdaemon.v2.InterestedPiecesRequest
-	10, // 2: dfdaemon.v2.InterestedPiecesResponse.pieces:type_name -> common.v2.Piece
-	3,  // 3: dfdaemon.v2.SyncPiecesResponse.interested_pieces_response:type_name -> dfdaemon.v2.InterestedPiecesResponse
-	11, // 4: dfdaemon.v2.DownloadTaskRequest.download:type_name -> common.v2.Download
-	12, // 5: dfdaemon.v2.UploadTaskRequest.task:type_name -> common.v2.Task
-	12, // 6: dfdaemon.v2.StatTaskResponse.task:type_name -> common.v2.Task
-	2,  // 7: dfdaemon.v2.Dfdaemon.SyncPieces:input_type -> dfdaemon.v2.SyncPiecesRequest
-	5,  // 8: dfdaemon.v2.Dfdaemon.DownloadTask:input_type -> dfdaemon.v2.DownloadTaskRequest
-	6,  // 9: dfdaemon.v2.Dfdaemon.UploadTask:input_type -> dfdaemon.v2.UploadTaskRequest
-	7,  // 10: dfdaemon.v2.Dfdaemon.StatTask:input_type -> dfdaemon.v2.StatTaskRequest
-	9,  // 11: dfdaemon.v2.Dfdaemon.DeleteTask:input_type -> dfdaemon.v2.DeleteTaskRequest
-	4,  // 12: dfdaemon.v2.Dfdaemon.SyncPieces:output_type -> dfdaemon.v2.SyncPiecesResponse
-	13, // 13: dfdaemon.v2.Dfdaemon.DownloadTask:output_type -> google.protobuf.Empty
-	13, // 14: dfdaemon.v2.Dfdaemon.UploadTask:output_type -> google.protobuf.Empty
-	12, // 15: dfdaemon.v2.Dfdaemon.StatTask:output_type -> common.v2.Task
-	13, // 16: dfdaemon.v2.Dfdaemon.DeleteTask:output_type -> google.protobuf.Empty
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *SyncPiecesResponse) GetParentFailedResponse() *ParentFailedResponse {
+	if x, ok := x.GetResponse().(*SyncPiecesResponse_ParentFailedResponse); ok {
+		return x.ParentFailedResponse
+	}
+	return nil
 }
 
-func init() { file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() }
-func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
-	if File_pkg_apis_dfdaemon_v2_dfdaemon_proto != nil {
-		return
+type isSyncPiecesResponse_Response interface {
+	isSyncPiecesResponse_Response()
+}
+
+type SyncPiecesResponse_InterestedPiecesResponse struct {
+	InterestedPiecesResponse *InterestedPiecesResponse `protobuf:"bytes,1,opt,name=interested_pieces_response,json=interestedPiecesResponse,proto3,oneof"`
+}
+
+type SyncPiecesResponse_AvailablePiecesResponse struct {
+	AvailablePiecesResponse *AvailablePiecesResponse `protobuf:"bytes,2,opt,name=available_pieces_response,json=availablePiecesResponse,proto3,oneof"`
+}
+
+type SyncPiecesResponse_HavePiecesResponse struct {
+	HavePiecesResponse *HavePiecesResponse `protobuf:"bytes,3,opt,name=have_pieces_response,json=havePiecesResponse,proto3,oneof"`
+}
+
+type SyncPiecesResponse_LostPiecesResponse struct {
+	LostPiecesResponse *LostPiecesResponse `protobuf:"bytes,4,opt,name=lost_pieces_response,json=lostPiecesResponse,proto3,oneof"`
+}
+
+type SyncPiecesResponse_ParentFinishedResponse struct {
+	ParentFinishedResponse *ParentFinishedResponse `protobuf:"bytes,5,opt,name=parent_finished_response,json=parentFinishedResponse,proto3,oneof"`
+}
+
+type SyncPiecesResponse_ParentFailedResponse struct {
+	ParentFailedResponse *ParentFailedResponse `protobuf:"bytes,6,opt,name=parent_failed_response,json=parentFailedResponse,proto3,oneof"`
+}
+
+func (*SyncPiecesResponse_InterestedPiecesResponse) isSyncPiecesResponse_Response() {}
+
+func (*SyncPiecesResponse_AvailablePiecesResponse) isSyncPiecesResponse_Response() {}
+
+func (*SyncPiecesResponse_HavePiecesResponse) isSyncPiecesResponse_Response() {}
+
+func (*SyncPiecesResponse_LostPiecesResponse) isSyncPiecesResponse_Response() {}
+
+func (*SyncPiecesResponse_ParentFinishedResponse) isSyncPiecesResponse_Response() {}
+
+func (*SyncPiecesResponse_ParentFailedResponse) isSyncPiecesResponse_Response() {}
+
+// DownloadTaskRequest represents request of DownloadTask.
+type DownloadTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Download information.
+	Download *v2.Download `protobuf:"bytes,1,opt,name=download,proto3" json:"download,omitempty"`
+}
+
+func (x *DownloadTaskRequest) Reset() {
+	*x = DownloadTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterestedAllPiecesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *DownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskRequest) ProtoMessage() {}
+
+func (x *DownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterestedPiecesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*DownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DownloadTaskRequest) GetDownload() *v2.Download {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+// UploadTaskRequest represents request of UploadTask.
+type UploadTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Task metadata.
+	Task *v2.Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *UploadTaskRequest) Reset() {
+	*x = UploadTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTaskRequest) ProtoMessage() {}
+
+func (x *UploadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTaskRequest.ProtoReflect.Descriptor instead.
+func (*UploadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadTaskRequest) GetTask() *v2.Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// StatTaskRequest represents request of StatTask.
+type StatTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Task id.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Field mask of the returned task, empty field mask represents all fields.
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *StatTaskRequest) Reset() {
+	*x = StatTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatTaskRequest) ProtoMessage() {}
+
+func (x *StatTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatTaskRequest.ProtoReflect.Descriptor instead.
+func (*StatTaskRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StatTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *StatTaskRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// StatTaskResponse represents response of StatTask.
+type StatTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Task metadata.
+	Task *v2.Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *StatTaskResponse) Reset() {
+	*x = StatTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatTaskResponse) ProtoMessage() {}
+
+func (x *StatTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatTaskResponse.ProtoReflect.Descriptor instead.
+func (*StatTaskResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StatTaskResponse) GetTask() *v2.Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// DeleteTaskRequest represents request of DeleteTask.
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Task id.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// TriggerDownloadTaskRequest represents request of TriggerDownloadTask.
+type TriggerDownloadTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Download information.
+	Download *v2.Download `protobuf:"bytes,1,opt,name=download,proto3" json:"download,omitempty"`
+}
+
+func (x *TriggerDownloadTaskRequest) Reset() {
+	*x = TriggerDownloadTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDownloadTaskRequest) ProtoMessage() {}
+
+func (x *TriggerDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*TriggerDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TriggerDownloadTaskRequest) GetDownload() *v2.Download {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+// DownloadTaskStartedResponse represents task download started response of TriggerDownloadTaskResponse.
+type DownloadTaskStartedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reuse indicates whether the task has already been downloaded by the seed peer.
+	Reuse bool `protobuf:"varint,1,opt,name=reuse,proto3" json:"reuse,omitempty"`
+	// Task content length, -1 represents the content length is unknown.
+	ContentLength int64 `protobuf:"varint,2,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// Task piece count, -1 represents the piece count is unknown.
+	PieceCount int32 `protobuf:"varint,3,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+}
+
+func (x *DownloadTaskStartedResponse) Reset() {
+	*x = DownloadTaskStartedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskStartedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskStartedResponse) ProtoMessage() {}
+
+func (x *DownloadTaskStartedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskStartedResponse.ProtoReflect.Descriptor instead.
+func (*DownloadTaskStartedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DownloadTaskStartedResponse) GetReuse() bool {
+	if x != nil {
+		return x.Reuse
+	}
+	return false
+}
+
+func (x *DownloadTaskStartedResponse) GetContentLength() int64 {
+	if x != nil {
+		return x.ContentLength
+	}
+	return 0
+}
+
+func (x *DownloadTaskStartedResponse) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+// DownloadPieceFinishedResponse represents piece download finished response of TriggerDownloadTaskResponse.
+type DownloadPieceFinishedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Piece info.
+	Piece *v2.Piece `protobuf:"bytes,1,opt,name=piece,proto3" json:"piece,omitempty"`
+}
+
+func (x *DownloadPieceFinishedResponse) Reset() {
+	*x = DownloadPieceFinishedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadPieceFinishedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadPieceFinishedResponse) ProtoMessage() {}
+
+func (x *DownloadPieceFinishedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadPieceFinishedResponse.ProtoReflect.Descriptor instead.
+func (*DownloadPieceFinishedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DownloadPieceFinishedResponse) GetPiece() *v2.Piece {
+	if x != nil {
+		return x.Piece
+	}
+	return nil
+}
+
+// DownloadTaskFinishedResponse represents task download finished response of TriggerDownloadTaskResponse.
+type DownloadTaskFinishedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total content length.
+	ContentLength int64 `protobuf:"varint,1,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// Total piece count.
+	PieceCount int32 `protobuf:"varint,2,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+}
+
+func (x *DownloadTaskFinishedResponse) Reset() {
+	*x = DownloadTaskFinishedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskFinishedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskFinishedResponse) ProtoMessage() {}
+
+func (x *DownloadTaskFinishedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskFinishedResponse.ProtoReflect.Descriptor instead.
+func (*DownloadTaskFinishedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadTaskFinishedResponse) GetContentLength() int64 {
+	if x != nil {
+		return x.ContentLength
+	}
+	return 0
+}
+
+func (x *DownloadTaskFinishedResponse) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+// TriggerDownloadTaskResponse represents response of TriggerDownloadTask.
+type TriggerDownloadTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Host id of the seed peer.
+	HostId string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// Task id.
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Peer id of the seed peer.
+	PeerId string `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*TriggerDownloadTaskResponse_DownloadTaskStartedResponse
+	//	*TriggerDownloadTaskResponse_DownloadPieceFinishedResponse
+	//	*TriggerDownloadTaskResponse_DownloadTaskFinishedResponse
+	Response isTriggerDownloadTaskResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *TriggerDownloadTaskResponse) Reset() {
+	*x = TriggerDownloadTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDownloadTaskResponse) ProtoMessage() {}
+
+func (x *TriggerDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*TriggerDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TriggerDownloadTaskResponse) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *TriggerDownloadTaskResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TriggerDownloadTaskResponse) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (m *TriggerDownloadTaskResponse) GetResponse() isTriggerDownloadTaskResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *TriggerDownloadTaskResponse) GetDownloadTaskStartedResponse() *DownloadTaskStartedResponse {
+	if x, ok := x.GetResponse().(*TriggerDownloadTaskResponse_DownloadTaskStartedResponse); ok {
+		return x.DownloadTaskStartedResponse
+	}
+	return nil
+}
+
+func (x *TriggerDownloadTaskResponse) GetDownloadPieceFinishedResponse() *DownloadPieceFinishedResponse {
+	if x, ok := x.GetResponse().(*TriggerDownloadTaskResponse_DownloadPieceFinishedResponse); ok {
+		return x.DownloadPieceFinishedResponse
+	}
+	return nil
+}
+
+func (x *TriggerDownloadTaskResponse) GetDownloadTaskFinishedResponse() *DownloadTaskFinishedResponse {
+	if x, ok := x.GetResponse().(*TriggerDownloadTaskResponse_DownloadTaskFinishedResponse); ok {
+		return x.DownloadTaskFinishedResponse
+	}
+	return nil
+}
+
+type isTriggerDownloadTaskResponse_Response interface {
+	isTriggerDownloadTaskResponse_Response()
+}
+
+type TriggerDownloadTaskResponse_DownloadTaskStartedResponse struct {
+	DownloadTaskStartedResponse *DownloadTaskStartedResponse `protobuf:"bytes,4,opt,name=download_task_started_response,json=downloadTaskStartedResponse,proto3,oneof"`
+}
+
+type TriggerDownloadTaskResponse_DownloadPieceFinishedResponse struct {
+	DownloadPieceFinishedResponse *DownloadPieceFinishedResponse `protobuf:"bytes,5,opt,name=download_piece_finished_response,json=downloadPieceFinishedResponse,proto3,oneof"`
+}
+
+type TriggerDownloadTaskResponse_DownloadTaskFinishedResponse struct {
+	DownloadTaskFinishedResponse *DownloadTaskFinishedResponse `protobuf:"bytes,6,opt,name=download_task_finished_response,json=downloadTaskFinishedResponse,proto3,oneof"`
+}
+
+func (*TriggerDownloadTaskResponse_DownloadTaskStartedResponse) isTriggerDownloadTaskResponse_Response() {
+}
+
+func (*TriggerDownloadTaskResponse_DownloadPieceFinishedResponse) isTriggerDownloadTaskResponse_Response() {
+}
+
+func (*TriggerDownloadTaskResponse_DownloadTaskFinishedResponse) isTriggerDownloadTaskResponse_Response() {
+}
+
+var File_pkg_apis_dfdaemon_v2_dfdaemon_proto protoreflect.FileDescriptor
+
+var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x66, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x1a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x69, 0x65, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x17, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x69, 0x65, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x0c, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42,
+	0x06, 0x7a, 0x04, 0x10, 0x02, 0x70, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x70, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x69, 0x65, 0x63,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xf4, 0x02, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x1d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x19, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x3c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0e, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x50, 0x0a,
+	0x18, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x92, 0x01, 0x04, 0x08, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x22,
+	0x78, 0x0a, 0x17, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x65, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x69,
+	0x65, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10, 0x02, 0x52, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0xfa, 0x42, 0x0d,
+	0x1a, 0x0b, 0x28, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x0a, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x48, 0x61, 0x76,
+	0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x0c, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10, 0x02, 0x52, 0x0b,
+	0x70, 0x69, 0x65, 0x63, 0x65, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x40, 0x0a, 0x12, 0x4c,
+	0x6f, 0x73, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10, 0x02,
+	0x52, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x72, 0x0a,
+	0x16, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x41, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x04, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x48, 0x61, 0x76, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x68, 0x61, 0x76, 0x65, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x6c,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x66, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x73, 0x74, 0x50, 0x69, 0x65, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x6f,
+	0x73, 0x74, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x18, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x16, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x50, 0x0a,
+	0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x42, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x22, 0x6e, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x35, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x57, 0x0a,
+	0x1a, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x1b, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x75, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x75, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x28, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x1a,
+	0x0b, 0x28, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x0a, 0x70, 0x69,
+	0x65, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x1d, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x65, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x69, 0x65,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x70, 0x69, 0x65, 0x63, 0x65, 0x22, 0x78, 0x0a, 0x1c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0b, 0x70,
+	0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x70, 0x69, 0x65, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x03, 0x0a, 0x1b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x6f, 0x0a, 0x1e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x1b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x20,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69,
+	0x65, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64,
+	0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x32, 0xda, 0x03, 0x0a, 0x08, 0x44, 0x66, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x69, 0x65, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x44, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x44, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x1e, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6a, 0x0a, 0x13, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x27, 0x2e, 0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x66, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x64, 0x37, 0x79, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f,
+	0x64, 0x66, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b, 0x64, 0x66, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescOnce sync.Once
+	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData = file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDesc
+)
+
+func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescGZIP() []byte {
+	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescOnce.Do(func() {
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData)
+	})
+	return file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDescData
+}
+
+var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_goTypes = []interface{}{
+	(*InterestedAllPiecesRequest)(nil),    // 0: dfdaemon.v2.InterestedAllPiecesRequest
+	(*InterestedPiecesRequest)(nil),       // 1: dfdaemon.v2.InterestedPiecesRequest
+	(*SyncPiecesRequest)(nil),             // 2: dfdaemon.v2.SyncPiecesRequest
+	(*InterestedPiecesResponse)(nil),      // 3: dfdaemon.v2.InterestedPiecesResponse
+	(*AvailablePiecesResponse)(nil),       // 4: dfdaemon.v2.AvailablePiecesResponse
+	(*HavePiecesResponse)(nil),            // 5: dfdaemon.v2.HavePiecesResponse
+	(*LostPiecesResponse)(nil),            // 6: dfdaemon.v2.LostPiecesResponse
+	(*ParentFinishedResponse)(nil),        // 7: dfdaemon.v2.ParentFinishedResponse
+	(*ParentFailedResponse)(nil),          // 8: dfdaemon.v2.ParentFailedResponse
+	(*SyncPiecesResponse)(nil),            // 9: dfdaemon.v2.SyncPiecesResponse
+	(*DownloadTaskRequest)(nil),           // 10: dfdaemon.v2.DownloadTaskRequest
+	(*UploadTaskRequest)(nil),             // 11: dfdaemon.v2.UploadTaskRequest
+	(*StatTaskRequest)(nil),               // 12: dfdaemon.v2.StatTaskRequest
+	(*StatTaskResponse)(nil),              // 13: dfdaemon.v2.StatTaskResponse
+	(*DeleteTaskRequest)(nil),             // 14: dfdaemon.v2.DeleteTaskRequest
+	(*TriggerDownloadTaskRequest)(nil),    // 15: dfdaemon.v2.TriggerDownloadTaskRequest
+	(*DownloadTaskStartedResponse)(nil),   // 16: dfdaemon.v2.DownloadTaskStartedResponse
+	(*DownloadPieceFinishedResponse)(nil), // 17: dfdaemon.v2.DownloadPieceFinishedResponse
+	(*DownloadTaskFinishedResponse)(nil),  // 18: dfdaemon.v2.DownloadTaskFinishedResponse
+	(*TriggerDownloadTaskResponse)(nil),   // 19: dfdaemon.v2.TriggerDownloadTaskResponse
+	(v2.PieceOrder)(0),                    // 20: common.v2.PieceOrder
+	(*v2.DownloadToken)(nil),              // 21: common.v2.DownloadToken
+	(*v2.TraceContext)(nil),               // 22: common.v2.TraceContext
+	(*v2.Piece)(nil),                      // 23: common.v2.Piece
+	(*v2.Download)(nil),                   // 24: common.v2.Download
+	(*v2.Task)(nil),                       // 25: common.v2.Task
+	(*fieldmaskpb.FieldMask)(nil),         // 26: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                 // 27: google.protobuf.Empty
+}
+var file_pkg_apis_dfdaemon_v2_dfdaemon_proto_depIdxs = []int32{
+	20, // 0: dfdaemon.v2.InterestedPiecesRequest.piece_order:type_name -> common.v2.PieceOrder
+	0,  // 1: dfdaemon.v2.SyncPiecesRequest.interested_all_pieces_request:type_name -> dfdaemon.v2.InterestedAllPiecesRequest
+	1,  // 2: dfdaemon.v2.SyncPiecesRequest.interested_pieces_request:type_name -> dfdaemon.v2.InterestedPiecesRequest
+	21, // 3: dfdaemon.v2.SyncPiecesRequest.download_token:type_name -> common.v2.DownloadToken
+	22, // 4: dfdaemon.v2.SyncPiecesRequest.trace_context:type_name -> common.v2.TraceContext
+	23, // 5: dfdaemon.v2.InterestedPiecesResponse.pieces:type_name -> common.v2.Piece
+	3,  // 6: dfdaemon.v2.SyncPiecesResponse.interested_pieces_response:type_name -> dfdaemon.v2.InterestedPiecesResponse
+	4,  // 7: dfdaemon.v2.SyncPiecesResponse.available_pieces_response:type_name -> dfdaemon.v2.AvailablePiecesResponse
+	5,  // 8: dfdaemon.v2.SyncPiecesResponse.have_pieces_response:type_name -> dfdaemon.v2.HavePiecesResponse
+	6,  // 9: dfdaemon.v2.SyncPiecesResponse.lost_pieces_response:type_name -> dfdaemon.v2.LostPiecesResponse
+	7,  // 10: dfdaemon.v2.SyncPiecesResponse.parent_finished_response:type_name -> dfdaemon.v2.ParentFinishedResponse
+	8,  // 11: dfdaemon.v2.SyncPiecesResponse.parent_failed_response:type_name -> dfdaemon.v2.ParentFailedResponse
+	24, // 12: dfdaemon.v2.DownloadTaskRequest.download:type_name -> common.v2.Download
+	25, // 13: dfdaemon.v2.UploadTaskRequest.task:type_name -> common.v2.Task
+	26, // 14: dfdaemon.v2.StatTaskRequest.field_mask:type_name -> google.protobuf.FieldMask
+	25, // 15: dfdaemon.v2.StatTaskResponse.task:type_name -> common.v2.Task
+	24, // 16: dfdaemon.v2.TriggerDownloadTaskRequest.download:type_name -> common.v2.Download
+	23, // 17: dfdaemon.v2.DownloadPieceFinishedResponse.piece:type_name -> common.v2.Piece
+	16, // 18: dfdaemon.v2.TriggerDownloadTaskResponse.download_task_started_response:type_name -> dfdaemon.v2.DownloadTaskStartedResponse
+	17, // 19: dfdaemon.v2.TriggerDownloadTaskResponse.download_piece_finished_response:type_name -> dfdaemon.v2.DownloadPieceFinishedResponse
+	18, // 20: dfdaemon.v2.TriggerDownloadTaskResponse.download_task_finished_response:type_name -> dfdaemon.v2.DownloadTaskFinishedResponse
+	2,  // 21: dfdaemon.v2.Dfdaemon.SyncPieces:input_type -> dfdaemon.v2.SyncPiecesRequest
+	10, // 22: dfdaemon.v2.Dfdaemon.DownloadTask:input_type -> dfdaemon.v2.DownloadTaskRequest
+	11, // 23: dfdaemon.v2.Dfdaemon.UploadTask:input_type -> dfdaemon.v2.UploadTaskRequest
+	12, // 24: dfdaemon.v2.Dfdaemon.StatTask:input_type -> dfdaemon.v2.StatTaskRequest
+	14, // 25: dfdaemon.v2.Dfdaemon.DeleteTask:input_type -> dfdaemon.v2.DeleteTaskRequest
+	15, // 26: dfdaemon.v2.Dfdaemon.TriggerDownloadTask:input_type -> dfdaemon.v2.TriggerDownloadTaskRequest
+	9,  // 27: dfdaemon.v2.Dfdaemon.SyncPieces:output_type -> dfdaemon.v2.SyncPiecesResponse
+	27, // 28: dfdaemon.v2.Dfdaemon.DownloadTask:output_type -> google.protobuf.Empty
+	27, // 29: dfdaemon.v2.Dfdaemon.UploadTask:output_type -> google.protobuf.Empty
+	25, // 30: dfdaemon.v2.Dfdaemon.StatTask:output_type -> common.v2.Task
+	27, // 31: dfdaemon.v2.Dfdaemon.DeleteTask:output_type -> google.protobuf.Empty
+	19, // 32: dfdaemon.v2.Dfdaemon.TriggerDownloadTask:output_type -> dfdaemon.v2.TriggerDownloadTaskResponse
+	27, // [27:33] is the sub-list for method output_type
+	21, // [21:27] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() }
+func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
+	if File_pkg_apis_dfdaemon_v2_dfdaemon_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterestedAllPiecesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterestedPiecesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -776,7 +1696,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncPiecesResponse); i {
+			switch v := v.(*AvailablePiecesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -788,7 +1708,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadTaskRequest); i {
+			switch v := v.(*HavePiecesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -800,7 +1720,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadTaskRequest); i {
+			switch v := v.(*LostPiecesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -812,7 +1732,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatTaskRequest); i {
+			switch v := v.(*ParentFinishedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -824,7 +1744,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatTaskResponse); i {
+			switch v := v.(*ParentFailedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,6 +1756,66 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			}
 		}
 		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncPiecesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteTaskRequest); i {
 			case 0:
 				return &v.state
@@ -847,13 +1827,83 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerDownloadTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTaskStartedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadPieceFinishedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTaskFinishedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerDownloadTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*SyncPiecesRequest_InterestedAllPiecesRequest)(nil),
 		(*SyncPiecesRequest_InterestedPiecesRequest)(nil),
 	}
-	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*SyncPiecesResponse_InterestedPiecesResponse)(nil),
+		(*SyncPiecesResponse_AvailablePiecesResponse)(nil),
+		(*SyncPiecesResponse_HavePiecesResponse)(nil),
+		(*SyncPiecesResponse_LostPiecesResponse)(nil),
+		(*SyncPiecesResponse_ParentFinishedResponse)(nil),
+		(*SyncPiecesResponse_ParentFailedResponse)(nil),
+	}
+	file_pkg_apis_dfdaemon_v2_dfdaemon_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*TriggerDownloadTaskResponse_DownloadTaskStartedResponse)(nil),
+		(*TriggerDownloadTaskResponse_DownloadPieceFinishedResponse)(nil),
+		(*TriggerDownloadTaskResponse_DownloadTaskFinishedResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -861,7 +1911,7 @@ func file_pkg_apis_dfdaemon_v2_dfdaemon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_apis_dfdaemon_v2_dfdaemon_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	common "d7y.io/api/v2/pkg/apis/common/v2"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = common.PieceOrder(0)
 )
 
 // Validate checks the field values on InterestedAllPiecesRequest with the
@@ -159,10 +163,40 @@ func (m *InterestedPiecesRequest) validate(all bool) error {
 
 	var errors []error
 
-	if len(m.GetPieceNumbers()) < 1 {
+	if len(m.GetPieceNumbers()) > 0 {
+
+		if len(m.GetPieceNumbers()) < 1 {
+			err := InterestedPiecesRequestValidationError{
+				field:  "PieceNumbers",
+				reason: "value must contain at least 1 item(s)",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetPieceBitmap()) > 0 {
+
+		if len(m.GetPieceBitmap()) < 2 {
+			err := InterestedPiecesRequestValidationError{
+				field:  "PieceBitmap",
+				reason: "value length must be at least 2 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := common.PieceOrder_name[int32(m.GetPieceOrder())]; !ok {
 		err := InterestedPiecesRequestValidationError{
-			field:  "PieceNumbers",
-			reason: "value must contain at least 1 item(s)",
+			field:  "PieceOrder",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -272,6 +306,64 @@ func (m *SyncPiecesRequest) validate(all bool) error {
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetDownloadToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncPiecesRequestValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncPiecesRequestValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncPiecesRequestValidationError{
+				field:  "DownloadToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTraceContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncPiecesRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncPiecesRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTraceContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncPiecesRequestValidationError{
+				field:  "TraceContext",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	oneofRequestPresent := false
 	switch v := m.Request.(type) {
 	case *SyncPiecesRequest_InterestedAllPiecesRequest:
@@ -603,79 +695,43 @@ var _ interface {
 	ErrorName() string
 } = InterestedPiecesResponseValidationError{}
 
-// Validate checks the field values on SyncPiecesResponse with the rules
+// Validate checks the field values on AvailablePiecesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SyncPiecesResponse) Validate() error {
+func (m *AvailablePiecesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SyncPiecesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AvailablePiecesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SyncPiecesResponseMultiError, or nil if none found.
-func (m *SyncPiecesResponse) ValidateAll() error {
+// AvailablePiecesResponseMultiError, or nil if none found.
+func (m *AvailablePiecesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SyncPiecesResponse) validate(all bool) error {
+func (m *AvailablePiecesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	oneofResponsePresent := false
-	switch v := m.Response.(type) {
-	case *SyncPiecesResponse_InterestedPiecesResponse:
-		if v == nil {
-			err := SyncPiecesResponseValidationError{
-				field:  "Response",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if len(m.GetPieceBitmap()) < 2 {
+		err := AvailablePiecesResponseValidationError{
+			field:  "PieceBitmap",
+			reason: "value length must be at least 2 bytes",
 		}
-		oneofResponsePresent = true
-
-		if all {
-			switch v := interface{}(m.GetInterestedPiecesResponse()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SyncPiecesResponseValidationError{
-						field:  "InterestedPiecesResponse",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SyncPiecesResponseValidationError{
-						field:  "InterestedPiecesResponse",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetInterestedPiecesResponse()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SyncPiecesResponseValidationError{
-					field:  "InterestedPiecesResponse",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
-
-	default:
-		_ = v // ensures v is used
+		errors = append(errors, err)
 	}
-	if !oneofResponsePresent {
-		err := SyncPiecesResponseValidationError{
-			field:  "Response",
-			reason: "value is required",
+
+	if m.GetPieceCount() < -1 {
+		err := AvailablePiecesResponseValidationError{
+			field:  "PieceCount",
+			reason: "value must be greater than or equal to -1",
 		}
 		if !all {
 			return err
@@ -684,19 +740,19 @@ func (m *SyncPiecesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SyncPiecesResponseMultiError(errors)
+		return AvailablePiecesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SyncPiecesResponseMultiError is an error wrapping multiple validation errors
-// returned by SyncPiecesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SyncPiecesResponseMultiError []error
+// AvailablePiecesResponseMultiError is an error wrapping multiple validation
+// errors returned by AvailablePiecesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AvailablePiecesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SyncPiecesResponseMultiError) Error() string {
+func (m AvailablePiecesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -705,11 +761,11 @@ func (m SyncPiecesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SyncPiecesResponseMultiError) AllErrors() []error { return m }
+func (m AvailablePiecesResponseMultiError) AllErrors() []error { return m }
 
-// SyncPiecesResponseValidationError is the validation error returned by
-// SyncPiecesResponse.Validate if the designated constraints aren't met.
-type SyncPiecesResponseValidationError struct {
+// AvailablePiecesResponseValidationError is the validation error returned by
+// AvailablePiecesResponse.Validate if the designated constraints aren't met.
+type AvailablePiecesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -717,24 +773,24 @@ type SyncPiecesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SyncPiecesResponseValidationError) Field() string { return e.field }
+func (e AvailablePiecesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SyncPiecesResponseValidationError) Reason() string { return e.reason }
+func (e AvailablePiecesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SyncPiecesResponseValidationError) Cause() error { return e.cause }
+func (e AvailablePiecesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SyncPiecesResponseValidationError) Key() bool { return e.key }
+func (e AvailablePiecesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SyncPiecesResponseValidationError) ErrorName() string {
-	return "SyncPiecesResponseValidationError"
+func (e AvailablePiecesResponseValidationError) ErrorName() string {
+	return "AvailablePiecesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SyncPiecesResponseValidationError) Error() string {
+func (e AvailablePiecesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -746,14 +802,14 @@ func (e SyncPiecesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSyncPiecesResponse.%s: %s%s",
+		"invalid %sAvailablePiecesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SyncPiecesResponseValidationError{}
+var _ error = AvailablePiecesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -761,34 +817,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SyncPiecesResponseValidationError{}
+} = AvailablePiecesResponseValidationError{}
 
-// Validate checks the field values on DownloadTaskRequest with the rules
+// Validate checks the field values on HavePiecesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DownloadTaskRequest) Validate() error {
+func (m *HavePiecesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DownloadTaskRequest with the rules
+// ValidateAll checks the field values on HavePiecesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DownloadTaskRequestMultiError, or nil if none found.
-func (m *DownloadTaskRequest) ValidateAll() error {
+// HavePiecesResponseMultiError, or nil if none found.
+func (m *HavePiecesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DownloadTaskRequest) validate(all bool) error {
+func (m *HavePiecesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetDownload() == nil {
-		err := DownloadTaskRequestValidationError{
-			field:  "Download",
-			reason: "value is required",
+	if len(m.GetPieceBitmap()) < 2 {
+		err := HavePiecesResponseValidationError{
+			field:  "PieceBitmap",
+			reason: "value length must be at least 2 bytes",
 		}
 		if !all {
 			return err
@@ -796,49 +852,20 @@ func (m *DownloadTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetDownload()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DownloadTaskRequestValidationError{
-					field:  "Download",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DownloadTaskRequestValidationError{
-					field:  "Download",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDownload()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DownloadTaskRequestValidationError{
-				field:  "Download",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return DownloadTaskRequestMultiError(errors)
+		return HavePiecesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by DownloadTaskRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DownloadTaskRequestMultiError []error
+// HavePiecesResponseMultiError is an error wrapping multiple validation errors
+// returned by HavePiecesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type HavePiecesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DownloadTaskRequestMultiError) Error() string {
+func (m HavePiecesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -847,11 +874,11 @@ func (m DownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m HavePiecesResponseMultiError) AllErrors() []error { return m }
 
-// DownloadTaskRequestValidationError is the validation error returned by
-// DownloadTaskRequest.Validate if the designated constraints aren't met.
-type DownloadTaskRequestValidationError struct {
+// HavePiecesResponseValidationError is the validation error returned by
+// HavePiecesResponse.Validate if the designated constraints aren't met.
+type HavePiecesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -859,24 +886,24 @@ type DownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DownloadTaskRequestValidationError) Field() string { return e.field }
+func (e HavePiecesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e HavePiecesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e HavePiecesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e HavePiecesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DownloadTaskRequestValidationError) ErrorName() string {
-	return "DownloadTaskRequestValidationError"
+func (e HavePiecesResponseValidationError) ErrorName() string {
+	return "HavePiecesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DownloadTaskRequestValidationError) Error() string {
+func (e HavePiecesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -888,14 +915,14 @@ func (e DownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDownloadTaskRequest.%s: %s%s",
+		"invalid %sHavePiecesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DownloadTaskRequestValidationError{}
+var _ error = HavePiecesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -903,34 +930,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DownloadTaskRequestValidationError{}
+} = HavePiecesResponseValidationError{}
 
-// Validate checks the field values on UploadTaskRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UploadTaskRequest) Validate() error {
+// Validate checks the field values on LostPiecesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LostPiecesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadTaskRequest with the rules
+// ValidateAll checks the field values on LostPiecesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UploadTaskRequestMultiError, or nil if none found.
-func (m *UploadTaskRequest) ValidateAll() error {
+// LostPiecesResponseMultiError, or nil if none found.
+func (m *LostPiecesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadTaskRequest) validate(all bool) error {
+func (m *LostPiecesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetTask() == nil {
-		err := UploadTaskRequestValidationError{
-			field:  "Task",
-			reason: "value is required",
+	if len(m.GetPieceBitmap()) < 2 {
+		err := LostPiecesResponseValidationError{
+			field:  "PieceBitmap",
+			reason: "value length must be at least 2 bytes",
 		}
 		if !all {
 			return err
@@ -938,49 +965,20 @@ func (m *UploadTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UploadTaskRequestValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UploadTaskRequestValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UploadTaskRequestValidationError{
-				field:  "Task",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UploadTaskRequestMultiError(errors)
+		return LostPiecesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by UploadTaskRequest.ValidateAll() if the designated constraints
+// LostPiecesResponseMultiError is an error wrapping multiple validation errors
+// returned by LostPiecesResponse.ValidateAll() if the designated constraints
 // aren't met.
-type UploadTaskRequestMultiError []error
+type LostPiecesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadTaskRequestMultiError) Error() string {
+func (m LostPiecesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -989,11 +987,11 @@ func (m UploadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadTaskRequestMultiError) AllErrors() []error { return m }
+func (m LostPiecesResponseMultiError) AllErrors() []error { return m }
 
-// UploadTaskRequestValidationError is the validation error returned by
-// UploadTaskRequest.Validate if the designated constraints aren't met.
-type UploadTaskRequestValidationError struct {
+// LostPiecesResponseValidationError is the validation error returned by
+// LostPiecesResponse.Validate if the designated constraints aren't met.
+type LostPiecesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1001,24 +999,24 @@ type UploadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadTaskRequestValidationError) Field() string { return e.field }
+func (e LostPiecesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadTaskRequestValidationError) Reason() string { return e.reason }
+func (e LostPiecesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadTaskRequestValidationError) Cause() error { return e.cause }
+func (e LostPiecesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadTaskRequestValidationError) Key() bool { return e.key }
+func (e LostPiecesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadTaskRequestValidationError) ErrorName() string {
-	return "UploadTaskRequestValidationError"
+func (e LostPiecesResponseValidationError) ErrorName() string {
+	return "LostPiecesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UploadTaskRequestValidationError) Error() string {
+func (e LostPiecesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1030,14 +1028,14 @@ func (e UploadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadTaskRequest.%s: %s%s",
+		"invalid %sLostPiecesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadTaskRequestValidationError{}
+var _ error = LostPiecesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1045,55 +1043,1637 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadTaskRequestValidationError{}
+} = LostPiecesResponseValidationError{}
 
-// Validate checks the field values on StatTaskRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StatTaskRequest) Validate() error {
+// Validate checks the field values on ParentFinishedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ParentFinishedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatTaskRequest with the rules
+// ValidateAll checks the field values on ParentFinishedResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StatTaskRequestMultiError, or nil if none found.
-func (m *StatTaskRequest) ValidateAll() error {
+// ParentFinishedResponseMultiError, or nil if none found.
+func (m *ParentFinishedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatTaskRequest) validate(all bool) error {
+func (m *ParentFinishedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
-		err := StatTaskRequestValidationError{
-			field:  "TaskId",
+	if m.GetContentLength() < 0 {
+		err := ParentFinishedResponseValidationError{
+			field:  "ContentLength",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPieceCount() < 0 {
+		err := ParentFinishedResponseValidationError{
+			field:  "PieceCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ParentFinishedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ParentFinishedResponseMultiError is an error wrapping multiple validation
+// errors returned by ParentFinishedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ParentFinishedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ParentFinishedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ParentFinishedResponseMultiError) AllErrors() []error { return m }
+
+// ParentFinishedResponseValidationError is the validation error returned by
+// ParentFinishedResponse.Validate if the designated constraints aren't met.
+type ParentFinishedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParentFinishedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParentFinishedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParentFinishedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParentFinishedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParentFinishedResponseValidationError) ErrorName() string {
+	return "ParentFinishedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ParentFinishedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParentFinishedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParentFinishedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParentFinishedResponseValidationError{}
+
+// Validate checks the field values on ParentFailedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ParentFailedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ParentFailedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ParentFailedResponseMultiError, or nil if none found.
+func (m *ParentFailedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ParentFailedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDescription()) < 1 {
+		err := ParentFailedResponseValidationError{
+			field:  "Description",
 			reason: "value length must be at least 1 runes",
 		}
-		if !all {
-			return err
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ParentFailedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ParentFailedResponseMultiError is an error wrapping multiple validation
+// errors returned by ParentFailedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ParentFailedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ParentFailedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ParentFailedResponseMultiError) AllErrors() []error { return m }
+
+// ParentFailedResponseValidationError is the validation error returned by
+// ParentFailedResponse.Validate if the designated constraints aren't met.
+type ParentFailedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParentFailedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParentFailedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParentFailedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParentFailedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParentFailedResponseValidationError) ErrorName() string {
+	return "ParentFailedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ParentFailedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParentFailedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParentFailedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParentFailedResponseValidationError{}
+
+// Validate checks the field values on SyncPiecesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncPiecesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncPiecesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncPiecesResponseMultiError, or nil if none found.
+func (m *SyncPiecesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncPiecesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofResponsePresent := false
+	switch v := m.Response.(type) {
+	case *SyncPiecesResponse_InterestedPiecesResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetInterestedPiecesResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "InterestedPiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "InterestedPiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInterestedPiecesResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "InterestedPiecesResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SyncPiecesResponse_AvailablePiecesResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetAvailablePiecesResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "AvailablePiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "AvailablePiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAvailablePiecesResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "AvailablePiecesResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SyncPiecesResponse_HavePiecesResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetHavePiecesResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "HavePiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "HavePiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHavePiecesResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "HavePiecesResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SyncPiecesResponse_LostPiecesResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetLostPiecesResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "LostPiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "LostPiecesResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLostPiecesResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "LostPiecesResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SyncPiecesResponse_ParentFinishedResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetParentFinishedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "ParentFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "ParentFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetParentFinishedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "ParentFinishedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SyncPiecesResponse_ParentFailedResponse:
+		if v == nil {
+			err := SyncPiecesResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetParentFailedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "ParentFailedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncPiecesResponseValidationError{
+						field:  "ParentFailedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetParentFailedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncPiecesResponseValidationError{
+					field:  "ParentFailedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofResponsePresent {
+		err := SyncPiecesResponseValidationError{
+			field:  "Response",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SyncPiecesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncPiecesResponseMultiError is an error wrapping multiple validation errors
+// returned by SyncPiecesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SyncPiecesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncPiecesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncPiecesResponseMultiError) AllErrors() []error { return m }
+
+// SyncPiecesResponseValidationError is the validation error returned by
+// SyncPiecesResponse.Validate if the designated constraints aren't met.
+type SyncPiecesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncPiecesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncPiecesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncPiecesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncPiecesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncPiecesResponseValidationError) ErrorName() string {
+	return "SyncPiecesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncPiecesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncPiecesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncPiecesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncPiecesResponseValidationError{}
+
+// Validate checks the field values on DownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskRequestMultiError, or nil if none found.
+func (m *DownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDownload() == nil {
+		err := DownloadTaskRequestValidationError{
+			field:  "Download",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDownload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskRequestValidationError{
+					field:  "Download",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskRequestValidationError{
+					field:  "Download",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskRequestValidationError{
+				field:  "Download",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// DownloadTaskRequestValidationError is the validation error returned by
+// DownloadTaskRequest.Validate if the designated constraints aren't met.
+type DownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskRequestValidationError) ErrorName() string {
+	return "DownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskRequestValidationError{}
+
+// Validate checks the field values on UploadTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadTaskRequestMultiError, or nil if none found.
+func (m *UploadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTask() == nil {
+		err := UploadTaskRequestValidationError{
+			field:  "Task",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadTaskRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadTaskRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadTaskRequestValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadTaskRequestMultiError) AllErrors() []error { return m }
+
+// UploadTaskRequestValidationError is the validation error returned by
+// UploadTaskRequest.Validate if the designated constraints aren't met.
+type UploadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadTaskRequestValidationError) ErrorName() string {
+	return "UploadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadTaskRequestValidationError{}
+
+// Validate checks the field values on StatTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatTaskRequestMultiError, or nil if none found.
+func (m *StatTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := StatTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatTaskRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by StatTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StatTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatTaskRequestMultiError) AllErrors() []error { return m }
+
+// StatTaskRequestValidationError is the validation error returned by
+// StatTaskRequest.Validate if the designated constraints aren't met.
+type StatTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatTaskRequestValidationError) ErrorName() string { return "StatTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatTaskRequestValidationError{}
+
+// Validate checks the field values on StatTaskResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatTaskResponseMultiError, or nil if none found.
+func (m *StatTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTask() == nil {
+		err := StatTaskResponseValidationError{
+			field:  "Task",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatTaskResponseValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatTaskResponseValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatTaskResponseValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by StatTaskResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatTaskResponseMultiError) AllErrors() []error { return m }
+
+// StatTaskResponseValidationError is the validation error returned by
+// StatTaskResponse.Validate if the designated constraints aren't met.
+type StatTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatTaskResponseValidationError) ErrorName() string { return "StatTaskResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DeleteTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on TriggerDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerDownloadTaskRequestMultiError, or nil if none found.
+func (m *TriggerDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDownload() == nil {
+		err := TriggerDownloadTaskRequestValidationError{
+			field:  "Download",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDownload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerDownloadTaskRequestValidationError{
+					field:  "Download",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerDownloadTaskRequestValidationError{
+					field:  "Download",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerDownloadTaskRequestValidationError{
+				field:  "Download",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TriggerDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by TriggerDownloadTaskRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TriggerDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// TriggerDownloadTaskRequestValidationError is the validation error returned
+// by TriggerDownloadTaskRequest.Validate if the designated constraints aren't met.
+type TriggerDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerDownloadTaskRequestValidationError) ErrorName() string {
+	return "TriggerDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on DownloadTaskStartedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskStartedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskStartedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskStartedResponseMultiError, or nil if none found.
+func (m *DownloadTaskStartedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskStartedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reuse
+
+	if m.GetContentLength() < -1 {
+		err := DownloadTaskStartedResponseValidationError{
+			field:  "ContentLength",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPieceCount() < -1 {
+		err := DownloadTaskStartedResponseValidationError{
+			field:  "PieceCount",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskStartedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskStartedResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskStartedResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskStartedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskStartedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskStartedResponseMultiError) AllErrors() []error { return m }
+
+// DownloadTaskStartedResponseValidationError is the validation error returned
+// by DownloadTaskStartedResponse.Validate if the designated constraints
+// aren't met.
+type DownloadTaskStartedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskStartedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskStartedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskStartedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskStartedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskStartedResponseValidationError) ErrorName() string {
+	return "DownloadTaskStartedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskStartedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskStartedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskStartedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskStartedResponseValidationError{}
+
+// Validate checks the field values on DownloadPieceFinishedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadPieceFinishedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadPieceFinishedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DownloadPieceFinishedResponseMultiError, or nil if none found.
+func (m *DownloadPieceFinishedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadPieceFinishedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPiece() == nil {
+		err := DownloadPieceFinishedResponseValidationError{
+			field:  "Piece",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPiece()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadPieceFinishedResponseValidationError{
+					field:  "Piece",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadPieceFinishedResponseValidationError{
+					field:  "Piece",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPiece()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadPieceFinishedResponseValidationError{
+				field:  "Piece",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return StatTaskRequestMultiError(errors)
+		return DownloadPieceFinishedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by StatTaskRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StatTaskRequestMultiError []error
+// DownloadPieceFinishedResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadPieceFinishedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DownloadPieceFinishedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatTaskRequestMultiError) Error() string {
+func (m DownloadPieceFinishedResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1102,11 +2682,12 @@ func (m StatTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatTaskRequestMultiError) AllErrors() []error { return m }
+func (m DownloadPieceFinishedResponseMultiError) AllErrors() []error { return m }
 
-// StatTaskRequestValidationError is the validation error returned by
-// StatTaskRequest.Validate if the designated constraints aren't met.
-type StatTaskRequestValidationError struct {
+// DownloadPieceFinishedResponseValidationError is the validation error
+// returned by DownloadPieceFinishedResponse.Validate if the designated
+// constraints aren't met.
+type DownloadPieceFinishedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1114,22 +2695,24 @@ type StatTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatTaskRequestValidationError) Field() string { return e.field }
+func (e DownloadPieceFinishedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatTaskRequestValidationError) Reason() string { return e.reason }
+func (e DownloadPieceFinishedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatTaskRequestValidationError) Cause() error { return e.cause }
+func (e DownloadPieceFinishedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatTaskRequestValidationError) Key() bool { return e.key }
+func (e DownloadPieceFinishedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatTaskRequestValidationError) ErrorName() string { return "StatTaskRequestValidationError" }
+func (e DownloadPieceFinishedResponseValidationError) ErrorName() string {
+	return "DownloadPieceFinishedResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StatTaskRequestValidationError) Error() string {
+func (e DownloadPieceFinishedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1141,14 +2724,14 @@ func (e StatTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatTaskRequest.%s: %s%s",
+		"invalid %sDownloadPieceFinishedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatTaskRequestValidationError{}
+var _ error = DownloadPieceFinishedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1156,34 +2739,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatTaskRequestValidationError{}
+} = DownloadPieceFinishedResponseValidationError{}
 
-// Validate checks the field values on StatTaskResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StatTaskResponse) Validate() error {
+// Validate checks the field values on DownloadTaskFinishedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskFinishedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatTaskResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DownloadTaskFinishedResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StatTaskResponseMultiError, or nil if none found.
-func (m *StatTaskResponse) ValidateAll() error {
+// DownloadTaskFinishedResponseMultiError, or nil if none found.
+func (m *DownloadTaskFinishedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatTaskResponse) validate(all bool) error {
+func (m *DownloadTaskFinishedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetTask() == nil {
-		err := StatTaskResponseValidationError{
-			field:  "Task",
-			reason: "value is required",
+	if m.GetContentLength() < 0 {
+		err := DownloadTaskFinishedResponseValidationError{
+			field:  "ContentLength",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1191,49 +2774,31 @@ func (m *StatTaskResponse) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StatTaskResponseValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StatTaskResponseValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetPieceCount() < 0 {
+		err := DownloadTaskFinishedResponseValidationError{
+			field:  "PieceCount",
+			reason: "value must be greater than or equal to 0",
 		}
-	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StatTaskResponseValidationError{
-				field:  "Task",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return StatTaskResponseMultiError(errors)
+		return DownloadTaskFinishedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatTaskResponseMultiError is an error wrapping multiple validation errors
-// returned by StatTaskResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StatTaskResponseMultiError []error
+// DownloadTaskFinishedResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskFinishedResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskFinishedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatTaskResponseMultiError) Error() string {
+func (m DownloadTaskFinishedResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1242,11 +2807,12 @@ func (m StatTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatTaskResponseMultiError) AllErrors() []error { return m }
+func (m DownloadTaskFinishedResponseMultiError) AllErrors() []error { return m }
 
-// StatTaskResponseValidationError is the validation error returned by
-// StatTaskResponse.Validate if the designated constraints aren't met.
-type StatTaskResponseValidationError struct {
+// DownloadTaskFinishedResponseValidationError is the validation error returned
+// by DownloadTaskFinishedResponse.Validate if the designated constraints
+// aren't met.
+type DownloadTaskFinishedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1254,22 +2820,24 @@ type StatTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatTaskResponseValidationError) Field() string { return e.field }
+func (e DownloadTaskFinishedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatTaskResponseValidationError) Reason() string { return e.reason }
+func (e DownloadTaskFinishedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatTaskResponseValidationError) Cause() error { return e.cause }
+func (e DownloadTaskFinishedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatTaskResponseValidationError) Key() bool { return e.key }
+func (e DownloadTaskFinishedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatTaskResponseValidationError) ErrorName() string { return "StatTaskResponseValidationError" }
+func (e DownloadTaskFinishedResponseValidationError) ErrorName() string {
+	return "DownloadTaskFinishedResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StatTaskResponseValidationError) Error() string {
+func (e DownloadTaskFinishedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1281,14 +2849,14 @@ func (e StatTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatTaskResponse.%s: %s%s",
+		"invalid %sDownloadTaskFinishedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatTaskResponseValidationError{}
+var _ error = DownloadTaskFinishedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1296,32 +2864,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatTaskResponseValidationError{}
+} = DownloadTaskFinishedResponseValidationError{}
 
-// Validate checks the field values on DeleteTaskRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTaskRequest) Validate() error {
+// Validate checks the field values on TriggerDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerDownloadTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTaskRequestMultiError, or nil if none found.
-func (m *DeleteTaskRequest) ValidateAll() error {
+// TriggerDownloadTaskResponseMultiError, or nil if none found.
+func (m *TriggerDownloadTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTaskRequest) validate(all bool) error {
+func (m *TriggerDownloadTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		err := TriggerDownloadTaskResponseValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
-		err := DeleteTaskRequestValidationError{
+		err := TriggerDownloadTaskResponseValidationError{
 			field:  "TaskId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1331,20 +2910,173 @@ func (m *DeleteTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		err := TriggerDownloadTaskResponseValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofResponsePresent := false
+	switch v := m.Response.(type) {
+	case *TriggerDownloadTaskResponse_DownloadTaskStartedResponse:
+		if v == nil {
+			err := TriggerDownloadTaskResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetDownloadTaskStartedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadTaskStartedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadTaskStartedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDownloadTaskStartedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerDownloadTaskResponseValidationError{
+					field:  "DownloadTaskStartedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerDownloadTaskResponse_DownloadPieceFinishedResponse:
+		if v == nil {
+			err := TriggerDownloadTaskResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetDownloadPieceFinishedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadPieceFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadPieceFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDownloadPieceFinishedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerDownloadTaskResponseValidationError{
+					field:  "DownloadPieceFinishedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TriggerDownloadTaskResponse_DownloadTaskFinishedResponse:
+		if v == nil {
+			err := TriggerDownloadTaskResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetDownloadTaskFinishedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadTaskFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TriggerDownloadTaskResponseValidationError{
+						field:  "DownloadTaskFinishedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDownloadTaskFinishedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TriggerDownloadTaskResponseValidationError{
+					field:  "DownloadTaskFinishedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofResponsePresent {
+		err := TriggerDownloadTaskResponseValidationError{
+			field:  "Response",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteTaskRequestMultiError(errors)
+		return TriggerDownloadTaskResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteTaskRequestMultiError []error
+// TriggerDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by TriggerDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type TriggerDownloadTaskResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTaskRequestMultiError) Error() string {
+func (m TriggerDownloadTaskResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1353,11 +3085,12 @@ func (m DeleteTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+func (m TriggerDownloadTaskResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTaskRequestValidationError is the validation error returned by
-// DeleteTaskRequest.Validate if the designated constraints aren't met.
-type DeleteTaskRequestValidationError struct {
+// TriggerDownloadTaskResponseValidationError is the validation error returned
+// by TriggerDownloadTaskResponse.Validate if the designated constraints
+// aren't met.
+type TriggerDownloadTaskResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1365,24 +3098,24 @@ type DeleteTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+func (e TriggerDownloadTaskResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+func (e TriggerDownloadTaskResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+func (e TriggerDownloadTaskResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+func (e TriggerDownloadTaskResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTaskRequestValidationError) ErrorName() string {
-	return "DeleteTaskRequestValidationError"
+func (e TriggerDownloadTaskResponseValidationError) ErrorName() string {
+	return "TriggerDownloadTaskResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTaskRequestValidationError) Error() string {
+func (e TriggerDownloadTaskResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1394,14 +3127,14 @@ func (e DeleteTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTaskRequest.%s: %s%s",
+		"invalid %sTriggerDownloadTaskResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTaskRequestValidationError{}
+var _ error = TriggerDownloadTaskResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1409,4 +3142,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTaskRequestValidationError{}
+} = TriggerDownloadTaskResponseValidationError{}
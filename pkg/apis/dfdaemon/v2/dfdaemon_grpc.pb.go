@@ -24,7 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DfdaemonClient interface {
-	// SyncPieces syncs pieces from the other peers.
+	// SyncPieces syncs pieces from the other peers, the download token of SyncPiecesRequest is required.
+	// Downloading piece content from the download port of the parent also requires the download token,
+	// refer to d7y.io/api/v2/pkg/downloadtoken.
 	SyncPieces(ctx context.Context, opts ...grpc.CallOption) (Dfdaemon_SyncPiecesClient, error)
 	// DownloadTask downloads task back-to-source.
 	DownloadTask(ctx context.Context, in *DownloadTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -34,6 +36,9 @@ type DfdaemonClient interface {
 	StatTask(ctx context.Context, in *StatTaskRequest, opts ...grpc.CallOption) (*v2.Task, error)
 	// DeleteTask deletes task from p2p network.
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TriggerDownloadTask triggers the seed peer to download task,
+	// and streams the progress of the downloading pieces to scheduler.
+	TriggerDownloadTask(ctx context.Context, in *TriggerDownloadTaskRequest, opts ...grpc.CallOption) (Dfdaemon_TriggerDownloadTaskClient, error)
 }
 
 type dfdaemonClient struct {
@@ -111,11 +116,45 @@ func (c *dfdaemonClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest,
 	return out, nil
 }
 
+func (c *dfdaemonClient) TriggerDownloadTask(ctx context.Context, in *TriggerDownloadTaskRequest, opts ...grpc.CallOption) (Dfdaemon_TriggerDownloadTaskClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Dfdaemon_ServiceDesc.Streams[1], "/dfdaemon.v2.Dfdaemon/TriggerDownloadTask", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dfdaemonTriggerDownloadTaskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dfdaemon_TriggerDownloadTaskClient interface {
+	Recv() (*TriggerDownloadTaskResponse, error)
+	grpc.ClientStream
+}
+
+type dfdaemonTriggerDownloadTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *dfdaemonTriggerDownloadTaskClient) Recv() (*TriggerDownloadTaskResponse, error) {
+	m := new(TriggerDownloadTaskResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DfdaemonServer is the server API for Dfdaemon service.
 // All implementations should embed UnimplementedDfdaemonServer
 // for forward compatibility
 type DfdaemonServer interface {
-	// SyncPieces syncs pieces from the other peers.
+	// SyncPieces syncs pieces from the other peers, the download token of SyncPiecesRequest is required.
+	// Downloading piece content from the download port of the parent also requires the download token,
+	// refer to d7y.io/api/v2/pkg/downloadtoken.
 	SyncPieces(Dfdaemon_SyncPiecesServer) error
 	// DownloadTask downloads task back-to-source.
 	DownloadTask(context.Context, *DownloadTaskRequest) (*emptypb.Empty, error)
@@ -125,6 +164,9 @@ type DfdaemonServer interface {
 	StatTask(context.Context, *StatTaskRequest) (*v2.Task, error)
 	// DeleteTask deletes task from p2p network.
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	// TriggerDownloadTask triggers the seed peer to download task,
+	// and streams the progress of the downloading pieces to scheduler.
+	TriggerDownloadTask(*TriggerDownloadTaskRequest, Dfdaemon_TriggerDownloadTaskServer) error
 }
 
 // UnimplementedDfdaemonServer should be embedded to have forward compatible implementations.
@@ -146,6 +188,9 @@ func (UnimplementedDfdaemonServer) StatTask(context.Context, *StatTaskRequest) (
 func (UnimplementedDfdaemonServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedDfdaemonServer) TriggerDownloadTask(*TriggerDownloadTaskRequest, Dfdaemon_TriggerDownloadTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method TriggerDownloadTask not implemented")
+}
 
 // UnsafeDfdaemonServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DfdaemonServer will
@@ -256,6 +301,27 @@ func _Dfdaemon_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dfdaemon_TriggerDownloadTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TriggerDownloadTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DfdaemonServer).TriggerDownloadTask(m, &dfdaemonTriggerDownloadTaskServer{stream})
+}
+
+type Dfdaemon_TriggerDownloadTaskServer interface {
+	Send(*TriggerDownloadTaskResponse) error
+	grpc.ServerStream
+}
+
+type dfdaemonTriggerDownloadTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *dfdaemonTriggerDownloadTaskServer) Send(m *TriggerDownloadTaskResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Dfdaemon_ServiceDesc is the grpc.ServiceDesc for Dfdaemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -287,6 +353,11 @@ var Dfdaemon_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "TriggerDownloadTask",
+			Handler:       _Dfdaemon_TriggerDownloadTask_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/apis/dfdaemon/v2/dfdaemon.proto",
 }
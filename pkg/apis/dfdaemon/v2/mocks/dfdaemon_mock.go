@@ -119,6 +119,26 @@ func (mr *MockDfdaemonClientMockRecorder) SyncPieces(ctx interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPieces", reflect.TypeOf((*MockDfdaemonClient)(nil).SyncPieces), varargs...)
 }
 
+// TriggerDownloadTask mocks base method.
+func (m *MockDfdaemonClient) TriggerDownloadTask(ctx context.Context, in *dfdaemon.TriggerDownloadTaskRequest, opts ...grpc.CallOption) (dfdaemon.Dfdaemon_TriggerDownloadTaskClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerDownloadTask", varargs...)
+	ret0, _ := ret[0].(dfdaemon.Dfdaemon_TriggerDownloadTaskClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerDownloadTask indicates an expected call of TriggerDownloadTask.
+func (mr *MockDfdaemonClientMockRecorder) TriggerDownloadTask(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerDownloadTask", reflect.TypeOf((*MockDfdaemonClient)(nil).TriggerDownloadTask), varargs...)
+}
+
 // UploadTask mocks base method.
 func (m *MockDfdaemonClient) UploadTask(ctx context.Context, in *dfdaemon.UploadTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +296,129 @@ func (mr *MockDfdaemon_SyncPiecesClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockDfdaemon_SyncPiecesClient)(nil).Trailer))
 }
 
+// MockDfdaemon_TriggerDownloadTaskClient is a mock of Dfdaemon_TriggerDownloadTaskClient interface.
+type MockDfdaemon_TriggerDownloadTaskClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDfdaemon_TriggerDownloadTaskClientMockRecorder
+}
+
+// MockDfdaemon_TriggerDownloadTaskClientMockRecorder is the mock recorder for MockDfdaemon_TriggerDownloadTaskClient.
+type MockDfdaemon_TriggerDownloadTaskClientMockRecorder struct {
+	mock *MockDfdaemon_TriggerDownloadTaskClient
+}
+
+// NewMockDfdaemon_TriggerDownloadTaskClient creates a new mock instance.
+func NewMockDfdaemon_TriggerDownloadTaskClient(ctrl *gomock.Controller) *MockDfdaemon_TriggerDownloadTaskClient {
+	mock := &MockDfdaemon_TriggerDownloadTaskClient{ctrl: ctrl}
+	mock.recorder = &MockDfdaemon_TriggerDownloadTaskClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) EXPECT() *MockDfdaemon_TriggerDownloadTaskClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) Recv() (*dfdaemon.TriggerDownloadTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*dfdaemon.TriggerDownloadTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockDfdaemon_TriggerDownloadTaskClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockDfdaemon_TriggerDownloadTaskClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockDfdaemon_TriggerDownloadTaskClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskClient)(nil).Trailer))
+}
+
 // MockDfdaemonServer is a mock of DfdaemonServer interface.
 type MockDfdaemonServer struct {
 	ctrl     *gomock.Controller
@@ -358,6 +501,20 @@ func (mr *MockDfdaemonServerMockRecorder) SyncPieces(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPieces", reflect.TypeOf((*MockDfdaemonServer)(nil).SyncPieces), arg0)
 }
 
+// TriggerDownloadTask mocks base method.
+func (m *MockDfdaemonServer) TriggerDownloadTask(arg0 *dfdaemon.TriggerDownloadTaskRequest, arg1 dfdaemon.Dfdaemon_TriggerDownloadTaskServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerDownloadTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerDownloadTask indicates an expected call of TriggerDownloadTask.
+func (mr *MockDfdaemonServerMockRecorder) TriggerDownloadTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerDownloadTask", reflect.TypeOf((*MockDfdaemonServer)(nil).TriggerDownloadTask), arg0, arg1)
+}
+
 // UploadTask mocks base method.
 func (m *MockDfdaemonServer) UploadTask(arg0 context.Context, arg1 *dfdaemon.UploadTaskRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -541,3 +698,122 @@ func (mr *MockDfdaemon_SyncPiecesServerMockRecorder) SetTrailer(arg0 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockDfdaemon_SyncPiecesServer)(nil).SetTrailer), arg0)
 }
+
+// MockDfdaemon_TriggerDownloadTaskServer is a mock of Dfdaemon_TriggerDownloadTaskServer interface.
+type MockDfdaemon_TriggerDownloadTaskServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDfdaemon_TriggerDownloadTaskServerMockRecorder
+}
+
+// MockDfdaemon_TriggerDownloadTaskServerMockRecorder is the mock recorder for MockDfdaemon_TriggerDownloadTaskServer.
+type MockDfdaemon_TriggerDownloadTaskServerMockRecorder struct {
+	mock *MockDfdaemon_TriggerDownloadTaskServer
+}
+
+// NewMockDfdaemon_TriggerDownloadTaskServer creates a new mock instance.
+func NewMockDfdaemon_TriggerDownloadTaskServer(ctrl *gomock.Controller) *MockDfdaemon_TriggerDownloadTaskServer {
+	mock := &MockDfdaemon_TriggerDownloadTaskServer{ctrl: ctrl}
+	mock.recorder = &MockDfdaemon_TriggerDownloadTaskServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) EXPECT() *MockDfdaemon_TriggerDownloadTaskServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockDfdaemon_TriggerDownloadTaskServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) Send(arg0 *dfdaemon.TriggerDownloadTaskResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockDfdaemon_TriggerDownloadTaskServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockDfdaemon_TriggerDownloadTaskServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockDfdaemon_TriggerDownloadTaskServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockDfdaemon_TriggerDownloadTaskServer)(nil).SetTrailer), arg0)
+}
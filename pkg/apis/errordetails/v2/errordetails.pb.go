@@ -0,0 +1,275 @@
+//
+//     Copyright 2023 The Dragonfly Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: pkg/apis/errordetails/v2/errordetails.proto
+
+package errordetails
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccessDeniedReason represents reason of access denied.
+type AccessDeniedReason int32
+
+const (
+	// UNSPECIFIED represents the reason of access denied is not specified.
+	AccessDeniedReason_UNSPECIFIED AccessDeniedReason = 0
+	// INVALID_CREDENTIAL represents the token or the identity of the application is invalid.
+	AccessDeniedReason_INVALID_CREDENTIAL AccessDeniedReason = 1
+	// URL_DENIED represents the url matches the deny rules of the application.
+	AccessDeniedReason_URL_DENIED AccessDeniedReason = 2
+	// URL_NOT_ALLOWED represents the url does not match any allow rules of the application.
+	AccessDeniedReason_URL_NOT_ALLOWED AccessDeniedReason = 3
+	// UNKNOWN_APPLICATION represents the application is not configured, including the empty
+	// application name, and the manager does not configure the default access control of
+	// the unknown applications.
+	AccessDeniedReason_UNKNOWN_APPLICATION AccessDeniedReason = 4
+)
+
+// Enum value maps for AccessDeniedReason.
+var (
+	AccessDeniedReason_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "INVALID_CREDENTIAL",
+		2: "URL_DENIED",
+		3: "URL_NOT_ALLOWED",
+		4: "UNKNOWN_APPLICATION",
+	}
+	AccessDeniedReason_value = map[string]int32{
+		"UNSPECIFIED":         0,
+		"INVALID_CREDENTIAL":  1,
+		"URL_DENIED":          2,
+		"URL_NOT_ALLOWED":     3,
+		"UNKNOWN_APPLICATION": 4,
+	}
+)
+
+func (x AccessDeniedReason) Enum() *AccessDeniedReason {
+	p := new(AccessDeniedReason)
+	*p = x
+	return p
+}
+
+func (x AccessDeniedReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessDeniedReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_apis_errordetails_v2_errordetails_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessDeniedReason) Type() protoreflect.EnumType {
+	return &file_pkg_apis_errordetails_v2_errordetails_proto_enumTypes[0]
+}
+
+func (x AccessDeniedReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessDeniedReason.Descriptor instead.
+func (AccessDeniedReason) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_apis_errordetails_v2_errordetails_proto_rawDescGZIP(), []int{0}
+}
+
+// AccessDeniedError represents details of the access denied error,
+// it is carried by the details of grpc status with PermissionDenied code.
+type AccessDeniedError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Application name.
+	Application string `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	// Download url.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Reason of access denied.
+	Reason AccessDeniedReason `protobuf:"varint,3,opt,name=reason,proto3,enum=errordetails.v2.AccessDeniedReason" json:"reason,omitempty"`
+	// The description of access denied.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AccessDeniedError) Reset() {
+	*x = AccessDeniedError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_errordetails_v2_errordetails_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessDeniedError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessDeniedError) ProtoMessage() {}
+
+func (x *AccessDeniedError) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_errordetails_v2_errordetails_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessDeniedError.ProtoReflect.Descriptor instead.
+func (*AccessDeniedError) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_errordetails_v2_errordetails_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessDeniedError) GetApplication() string {
+	if x != nil {
+		return x.Application
+	}
+	return ""
+}
+
+func (x *AccessDeniedError) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AccessDeniedError) GetReason() AccessDeniedReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AccessDeniedReason_UNSPECIFIED
+}
+
+func (x *AccessDeniedError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_pkg_apis_errordetails_v2_errordetails_proto protoreflect.FileDescriptor
+
+var file_pkg_apis_errordetails_v2_errordetails_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x76, 0x32, 0x22, 0xa6,
+	0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x7b, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x5f, 0x44, 0x45,
+	0x4e, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x52, 0x4c, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x04, 0x42, 0x35, 0x5a, 0x33, 0x64, 0x37, 0x79, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_apis_errordetails_v2_errordetails_proto_rawDescOnce sync.Once
+	file_pkg_apis_errordetails_v2_errordetails_proto_rawDescData = file_pkg_apis_errordetails_v2_errordetails_proto_rawDesc
+)
+
+func file_pkg_apis_errordetails_v2_errordetails_proto_rawDescGZIP() []byte {
+	file_pkg_apis_errordetails_v2_errordetails_proto_rawDescOnce.Do(func() {
+		file_pkg_apis_errordetails_v2_errordetails_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_apis_errordetails_v2_errordetails_proto_rawDescData)
+	})
+	return file_pkg_apis_errordetails_v2_errordetails_proto_rawDescData
+}
+
+var file_pkg_apis_errordetails_v2_errordetails_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_apis_errordetails_v2_errordetails_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_apis_errordetails_v2_errordetails_proto_goTypes = []interface{}{
+	(AccessDeniedReason)(0),   // 0: errordetails.v2.AccessDeniedReason
+	(*AccessDeniedError)(nil), // 1: errordetails.v2.AccessDeniedError
+}
+var file_pkg_apis_errordetails_v2_errordetails_proto_depIdxs = []int32{
+	0, // 0: errordetails.v2.AccessDeniedError.reason:type_name -> errordetails.v2.AccessDeniedReason
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_apis_errordetails_v2_errordetails_proto_init() }
+func file_pkg_apis_errordetails_v2_errordetails_proto_init() {
+	if File_pkg_apis_errordetails_v2_errordetails_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_apis_errordetails_v2_errordetails_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessDeniedError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_apis_errordetails_v2_errordetails_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_apis_errordetails_v2_errordetails_proto_goTypes,
+		DependencyIndexes: file_pkg_apis_errordetails_v2_errordetails_proto_depIdxs,
+		EnumInfos:         file_pkg_apis_errordetails_v2_errordetails_proto_enumTypes,
+		MessageInfos:      file_pkg_apis_errordetails_v2_errordetails_proto_msgTypes,
+	}.Build()
+	File_pkg_apis_errordetails_v2_errordetails_proto = out.File
+	file_pkg_apis_errordetails_v2_errordetails_proto_rawDesc = nil
+	file_pkg_apis_errordetails_v2_errordetails_proto_goTypes = nil
+	file_pkg_apis_errordetails_v2_errordetails_proto_depIdxs = nil
+}
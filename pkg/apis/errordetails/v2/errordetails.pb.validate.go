@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/apis/errordetails/v2/errordetails.proto
+
+package errordetails
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AccessDeniedError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessDeniedError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessDeniedError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessDeniedErrorMultiError, or nil if none found.
+func (m *AccessDeniedError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessDeniedError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Application
+
+	// no validation rules for Url
+
+	// no validation rules for Reason
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return AccessDeniedErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessDeniedErrorMultiError is an error wrapping multiple validation errors
+// returned by AccessDeniedError.ValidateAll() if the designated constraints
+// aren't met.
+type AccessDeniedErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessDeniedErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessDeniedErrorMultiError) AllErrors() []error { return m }
+
+// AccessDeniedErrorValidationError is the validation error returned by
+// AccessDeniedError.Validate if the designated constraints aren't met.
+type AccessDeniedErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessDeniedErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessDeniedErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessDeniedErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessDeniedErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessDeniedErrorValidationError) ErrorName() string {
+	return "AccessDeniedErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessDeniedErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessDeniedError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessDeniedErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessDeniedErrorValidationError{}
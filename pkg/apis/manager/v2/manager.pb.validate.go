@@ -3005,32 +3005,32 @@ var _ interface {
 	ErrorName() string
 } = ApplicationPriorityValidationError{}
 
-// Validate checks the field values on Application with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Application) Validate() error {
+// Validate checks the field values on BackToSourceLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BackToSourceLimit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Application with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ApplicationMultiError, or
-// nil if none found.
-func (m *Application) ValidateAll() error {
+// ValidateAll checks the field values on BackToSourceLimit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BackToSourceLimitMultiError, or nil if none found.
+func (m *BackToSourceLimit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Application) validate(all bool) error {
+func (m *BackToSourceLimit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() < 1 {
-		err := ApplicationValidationError{
-			field:  "Id",
-			reason: "value must be greater than or equal to 1",
+	if l := utf8.RuneCountInString(m.GetHost()); l < 1 || l > 1024 {
+		err := BackToSourceLimitValidationError{
+			field:  "Host",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -3038,10 +3038,12 @@ func (m *Application) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
-		err := ApplicationValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 1024 runes, inclusive",
+	// no validation rules for ConcurrentTaskCount
+
+	if m.GetRateLimit() < 0 {
+		err := BackToSourceLimitValidationError{
+			field:  "RateLimit",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -3049,20 +3051,112 @@ func (m *Application) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = ApplicationValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := ApplicationValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
+	if len(errors) > 0 {
+		return BackToSourceLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// BackToSourceLimitMultiError is an error wrapping multiple validation errors
+// returned by BackToSourceLimit.ValidateAll() if the designated constraints
+// aren't met.
+type BackToSourceLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BackToSourceLimitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BackToSourceLimitMultiError) AllErrors() []error { return m }
+
+// BackToSourceLimitValidationError is the validation error returned by
+// BackToSourceLimit.Validate if the designated constraints aren't met.
+type BackToSourceLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BackToSourceLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BackToSourceLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BackToSourceLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BackToSourceLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BackToSourceLimitValidationError) ErrorName() string {
+	return "BackToSourceLimitValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BackToSourceLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBackToSourceLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BackToSourceLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BackToSourceLimitValidationError{}
+
+// Validate checks the field values on ApplicationQuota with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApplicationQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplicationQuota with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplicationQuotaMultiError, or nil if none found.
+func (m *ApplicationQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplicationQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUploadRateLimit() < 0 {
+		err := ApplicationQuotaValidationError{
+			field:  "UploadRateLimit",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -3070,12 +3164,10 @@ func (m *Application) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Bio
-
-	if m.GetPriority() == nil {
-		err := ApplicationValidationError{
-			field:  "Priority",
-			reason: "value is required",
+	if m.GetDownloadRateLimit() < 0 {
+		err := ApplicationQuotaValidationError{
+			field:  "DownloadRateLimit",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -3083,48 +3175,56 @@ func (m *Application) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetPriority()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ApplicationValidationError{
-					field:  "Priority",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for ConcurrentTaskCount
+
+	for idx, item := range m.GetBackToSourceLimits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplicationQuotaValidationError{
+						field:  fmt.Sprintf("BackToSourceLimits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplicationQuotaValidationError{
+						field:  fmt.Sprintf("BackToSourceLimits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ApplicationValidationError{
-					field:  "Priority",
+				return ApplicationQuotaValidationError{
+					field:  fmt.Sprintf("BackToSourceLimits[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPriority()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ApplicationValidationError{
-				field:  "Priority",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return ApplicationMultiError(errors)
+		return ApplicationQuotaMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApplicationMultiError is an error wrapping multiple validation errors
-// returned by Application.ValidateAll() if the designated constraints aren't met.
-type ApplicationMultiError []error
+// ApplicationQuotaMultiError is an error wrapping multiple validation errors
+// returned by ApplicationQuota.ValidateAll() if the designated constraints
+// aren't met.
+type ApplicationQuotaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApplicationMultiError) Error() string {
+func (m ApplicationQuotaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3133,11 +3233,11 @@ func (m ApplicationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApplicationMultiError) AllErrors() []error { return m }
+func (m ApplicationQuotaMultiError) AllErrors() []error { return m }
 
-// ApplicationValidationError is the validation error returned by
-// Application.Validate if the designated constraints aren't met.
-type ApplicationValidationError struct {
+// ApplicationQuotaValidationError is the validation error returned by
+// ApplicationQuota.Validate if the designated constraints aren't met.
+type ApplicationQuotaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3145,22 +3245,22 @@ type ApplicationValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplicationValidationError) Field() string { return e.field }
+func (e ApplicationQuotaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplicationValidationError) Reason() string { return e.reason }
+func (e ApplicationQuotaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplicationValidationError) Cause() error { return e.cause }
+func (e ApplicationQuotaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplicationValidationError) Key() bool { return e.key }
+func (e ApplicationQuotaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplicationValidationError) ErrorName() string { return "ApplicationValidationError" }
+func (e ApplicationQuotaValidationError) ErrorName() string { return "ApplicationQuotaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApplicationValidationError) Error() string {
+func (e ApplicationQuotaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3172,14 +3272,14 @@ func (e ApplicationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplication.%s: %s%s",
+		"invalid %sApplicationQuota.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplicationValidationError{}
+var _ error = ApplicationQuotaValidationError{}
 
 var _ interface {
 	Field() string
@@ -3187,34 +3287,4865 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplicationValidationError{}
+} = ApplicationQuotaValidationError{}
 
-// Validate checks the field values on ListApplicationsRequest with the rules
+// Validate checks the field values on ApplicationAccessControl with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListApplicationsRequest) Validate() error {
+func (m *ApplicationAccessControl) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListApplicationsRequest with the
+// ValidateAll checks the field values on ApplicationAccessControl with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListApplicationsRequestMultiError, or nil if none found.
-func (m *ListApplicationsRequest) ValidateAll() error {
+// ApplicationAccessControlMultiError, or nil if none found.
+func (m *ApplicationAccessControl) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListApplicationsRequest) validate(all bool) error {
+func (m *ApplicationAccessControl) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
-		err := ListApplicationsRequestValidationError{
-			field:  "SourceType",
-			reason: "value must be one of the defined enum values",
+	if len(m.GetTokenDigests()) > 0 {
+
+		for idx, item := range m.GetTokenDigests() {
+			_, _ = idx, item
+
+			if !_ApplicationAccessControl_TokenDigests_Pattern.MatchString(item) {
+				err := ApplicationAccessControlValidationError{
+					field:  fmt.Sprintf("TokenDigests[%v]", idx),
+					reason: "value does not match regex pattern \"^sha256:[A-Fa-f0-9]{64}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(m.GetIdentities()) > 0 {
+
+		for idx, item := range m.GetIdentities() {
+			_, _ = idx, item
+
+			if uri, err := url.Parse(item); err != nil {
+				err = ApplicationAccessControlValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else if !uri.IsAbs() {
+				err := ApplicationAccessControlValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "value must be absolute",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(m.GetAllowUrlRegexes()) > 0 {
+
+		for idx, item := range m.GetAllowUrlRegexes() {
+			_, _ = idx, item
+
+			if utf8.RuneCountInString(item) < 1 {
+				err := ApplicationAccessControlValidationError{
+					field:  fmt.Sprintf("AllowUrlRegexes[%v]", idx),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(m.GetDenyUrlRegexes()) > 0 {
+
+		for idx, item := range m.GetDenyUrlRegexes() {
+			_, _ = idx, item
+
+			if utf8.RuneCountInString(item) < 1 {
+				err := ApplicationAccessControlValidationError{
+					field:  fmt.Sprintf("DenyUrlRegexes[%v]", idx),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApplicationAccessControlMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplicationAccessControlMultiError is an error wrapping multiple validation
+// errors returned by ApplicationAccessControl.ValidateAll() if the designated
+// constraints aren't met.
+type ApplicationAccessControlMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplicationAccessControlMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplicationAccessControlMultiError) AllErrors() []error { return m }
+
+// ApplicationAccessControlValidationError is the validation error returned by
+// ApplicationAccessControl.Validate if the designated constraints aren't met.
+type ApplicationAccessControlValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationAccessControlValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationAccessControlValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationAccessControlValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationAccessControlValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationAccessControlValidationError) ErrorName() string {
+	return "ApplicationAccessControlValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationAccessControlValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationAccessControl.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationAccessControlValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationAccessControlValidationError{}
+
+var _ApplicationAccessControl_TokenDigests_Pattern = regexp.MustCompile("^sha256:[A-Fa-f0-9]{64}$")
+
+// Validate checks the field values on Application with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Application) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Application with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ApplicationMultiError, or
+// nil if none found.
+func (m *Application) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Application) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := ApplicationValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := ApplicationValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = ApplicationValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := ApplicationValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Bio
+
+	if m.GetPriority() == nil {
+		err := ApplicationValidationError{
+			field:  "Priority",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriority()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "Priority",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "Priority",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriority()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationValidationError{
+				field:  "Priority",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccessControl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "AccessControl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "AccessControl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccessControl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationValidationError{
+				field:  "AccessControl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApplicationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplicationMultiError is an error wrapping multiple validation errors
+// returned by Application.ValidateAll() if the designated constraints aren't met.
+type ApplicationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplicationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplicationMultiError) AllErrors() []error { return m }
+
+// ApplicationValidationError is the validation error returned by
+// Application.Validate if the designated constraints aren't met.
+type ApplicationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationValidationError) ErrorName() string { return "ApplicationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApplicationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationValidationError{}
+
+// Validate checks the field values on ListApplicationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsRequestMultiError, or nil if none found.
+func (m *ListApplicationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
+		err := ListApplicationsRequestValidationError{
+			field:  "SourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = ListApplicationsRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := ListApplicationsRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListApplicationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListApplicationsRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListApplicationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsRequestMultiError) AllErrors() []error { return m }
+
+// ListApplicationsRequestValidationError is the validation error returned by
+// ListApplicationsRequest.Validate if the designated constraints aren't met.
+type ListApplicationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsRequestValidationError) ErrorName() string {
+	return "ListApplicationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsRequestValidationError{}
+
+// Validate checks the field values on ListApplicationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsResponseMultiError, or nil if none found.
+func (m *ListApplicationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApplications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApplicationsResponseValidationError{
+					field:  fmt.Sprintf("Applications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListApplicationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsResponseMultiError) AllErrors() []error { return m }
+
+// ListApplicationsResponseValidationError is the validation error returned by
+// ListApplicationsResponse.Validate if the designated constraints aren't met.
+type ListApplicationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsResponseValidationError) ErrorName() string {
+	return "ListApplicationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsResponseValidationError{}
+
+// Validate checks the field values on GetQuotaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaRequestMultiError, or nil if none found.
+func (m *GetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
+		err := GetQuotaRequestValidationError{
+			field:  "SourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = GetQuotaRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := GetQuotaRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetApplication()); l < 1 || l > 1024 {
+		err := GetQuotaRequestValidationError{
+			field:  "Application",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetQuotaRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// GetQuotaRequestValidationError is the validation error returned by
+// GetQuotaRequest.Validate if the designated constraints aren't met.
+type GetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaRequestValidationError) ErrorName() string { return "GetQuotaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaRequestValidationError{}
+
+// Validate checks the field values on AuthorizeApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeApplicationRequestMultiError, or nil if none found.
+func (m *AuthorizeApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
+		err := AuthorizeApplicationRequestValidationError{
+			field:  "SourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = AuthorizeApplicationRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := AuthorizeApplicationRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetApplication()); l < 1 || l > 1024 {
+		err := AuthorizeApplicationRequestValidationError{
+			field:  "Application",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = AuthorizeApplicationRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := AuthorizeApplicationRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Credential.(type) {
+	case *AuthorizeApplicationRequest_Token:
+		if v == nil {
+			err := AuthorizeApplicationRequestValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if utf8.RuneCountInString(m.GetToken()) < 1 {
+			err := AuthorizeApplicationRequestValidationError{
+				field:  "Token",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *AuthorizeApplicationRequest_Identity:
+		if v == nil {
+			err := AuthorizeApplicationRequestValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetIdentity()); err != nil {
+			err = AuthorizeApplicationRequestValidationError{
+				field:  "Identity",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AuthorizeApplicationRequestValidationError{
+				field:  "Identity",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AuthorizeApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AuthorizeApplicationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// AuthorizeApplicationRequestMultiError is an error wrapping multiple
+// validation errors returned by AuthorizeApplicationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AuthorizeApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeApplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeApplicationRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeApplicationRequestValidationError is the validation error returned
+// by AuthorizeApplicationRequest.Validate if the designated constraints
+// aren't met.
+type AuthorizeApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeApplicationRequestValidationError) ErrorName() string {
+	return "AuthorizeApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeApplicationRequestValidationError{}
+
+// Validate checks the field values on DownloadTokenKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTokenKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTokenKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTokenKeyMultiError, or nil if none found.
+func (m *DownloadTokenKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTokenKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DownloadTokenKeyValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPublicKey()) != 32 {
+		err := DownloadTokenKeyValidationError{
+			field:  "PublicKey",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := DownloadTokenKeyValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiredAt() == nil {
+		err := DownloadTokenKeyValidationError{
+			field:  "ExpiredAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTokenKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTokenKeyMultiError is an error wrapping multiple validation errors
+// returned by DownloadTokenKey.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTokenKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTokenKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTokenKeyMultiError) AllErrors() []error { return m }
+
+// DownloadTokenKeyValidationError is the validation error returned by
+// DownloadTokenKey.Validate if the designated constraints aren't met.
+type DownloadTokenKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTokenKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTokenKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTokenKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTokenKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTokenKeyValidationError) ErrorName() string { return "DownloadTokenKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTokenKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTokenKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTokenKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTokenKeyValidationError{}
+
+// Validate checks the field values on ListDownloadTokenKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDownloadTokenKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDownloadTokenKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDownloadTokenKeysRequestMultiError, or nil if none found.
+func (m *ListDownloadTokenKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDownloadTokenKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
+		err := ListDownloadTokenKeysRequestValidationError{
+			field:  "SourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = ListDownloadTokenKeysRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := ListDownloadTokenKeysRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDownloadTokenKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListDownloadTokenKeysRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListDownloadTokenKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDownloadTokenKeysRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDownloadTokenKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDownloadTokenKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDownloadTokenKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListDownloadTokenKeysRequestValidationError is the validation error returned
+// by ListDownloadTokenKeysRequest.Validate if the designated constraints
+// aren't met.
+type ListDownloadTokenKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDownloadTokenKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDownloadTokenKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDownloadTokenKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDownloadTokenKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDownloadTokenKeysRequestValidationError) ErrorName() string {
+	return "ListDownloadTokenKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDownloadTokenKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDownloadTokenKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDownloadTokenKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDownloadTokenKeysRequestValidationError{}
+
+// Validate checks the field values on ListDownloadTokenKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDownloadTokenKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDownloadTokenKeysResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDownloadTokenKeysResponseMultiError, or nil if none found.
+func (m *ListDownloadTokenKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDownloadTokenKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDownloadTokenKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDownloadTokenKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDownloadTokenKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDownloadTokenKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDownloadTokenKeysResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDownloadTokenKeysResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDownloadTokenKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDownloadTokenKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDownloadTokenKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListDownloadTokenKeysResponseValidationError is the validation error
+// returned by ListDownloadTokenKeysResponse.Validate if the designated
+// constraints aren't met.
+type ListDownloadTokenKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDownloadTokenKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDownloadTokenKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDownloadTokenKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDownloadTokenKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDownloadTokenKeysResponseValidationError) ErrorName() string {
+	return "ListDownloadTokenKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDownloadTokenKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDownloadTokenKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDownloadTokenKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDownloadTokenKeysResponseValidationError{}
+
+// Validate checks the field values on DownloadTokenSigningKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTokenSigningKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTokenSigningKey with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTokenSigningKeyMultiError, or nil if none found.
+func (m *DownloadTokenSigningKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTokenSigningKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKey() == nil {
+		err := DownloadTokenSigningKeyValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTokenSigningKeyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTokenSigningKeyValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTokenSigningKeyValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPrivateKey()) != 64 {
+		err := DownloadTokenSigningKeyValidationError{
+			field:  "PrivateKey",
+			reason: "value length must be 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTokenSigningKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTokenSigningKeyMultiError is an error wrapping multiple validation
+// errors returned by DownloadTokenSigningKey.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTokenSigningKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTokenSigningKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTokenSigningKeyMultiError) AllErrors() []error { return m }
+
+// DownloadTokenSigningKeyValidationError is the validation error returned by
+// DownloadTokenSigningKey.Validate if the designated constraints aren't met.
+type DownloadTokenSigningKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTokenSigningKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTokenSigningKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTokenSigningKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTokenSigningKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTokenSigningKeyValidationError) ErrorName() string {
+	return "DownloadTokenSigningKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTokenSigningKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTokenSigningKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTokenSigningKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTokenSigningKeyValidationError{}
+
+// Validate checks the field values on GetDownloadTokenSigningKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDownloadTokenSigningKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTokenSigningKeyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDownloadTokenSigningKeyRequestMultiError, or nil if none found.
+func (m *GetDownloadTokenSigningKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTokenSigningKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SourceType_name[int32(m.GetSourceType())]; !ok {
+		err := GetDownloadTokenSigningKeyRequestValidationError{
+			field:  "SourceType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = GetDownloadTokenSigningKeyRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := GetDownloadTokenSigningKeyRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTokenSigningKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetDownloadTokenSigningKeyRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetDownloadTokenSigningKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDownloadTokenSigningKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDownloadTokenSigningKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTokenSigningKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTokenSigningKeyRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTokenSigningKeyRequestValidationError is the validation error
+// returned by GetDownloadTokenSigningKeyRequest.Validate if the designated
+// constraints aren't met.
+type GetDownloadTokenSigningKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTokenSigningKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTokenSigningKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTokenSigningKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTokenSigningKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTokenSigningKeyRequestValidationError) ErrorName() string {
+	return "GetDownloadTokenSigningKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTokenSigningKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTokenSigningKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTokenSigningKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTokenSigningKeyRequestValidationError{}
+
+// Validate checks the field values on CreateGNNRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateGNNRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGNNRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGNNRequestMultiError, or nil if none found.
+func (m *CreateGNNRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGNNRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetData()) < 1 {
+		err := CreateGNNRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRecall(); val < 0 || val > 1 {
+		err := CreateGNNRequestValidationError{
+			field:  "Recall",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPrecision(); val < 0 || val > 1 {
+		err := CreateGNNRequestValidationError{
+			field:  "Precision",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetF1Score(); val < 0 || val > 1 {
+		err := CreateGNNRequestValidationError{
+			field:  "F1Score",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGNNRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGNNRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGNNRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGNNRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGNNRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGNNRequestMultiError) AllErrors() []error { return m }
+
+// CreateGNNRequestValidationError is the validation error returned by
+// CreateGNNRequest.Validate if the designated constraints aren't met.
+type CreateGNNRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGNNRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGNNRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGNNRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGNNRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGNNRequestValidationError) ErrorName() string { return "CreateGNNRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateGNNRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGNNRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGNNRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGNNRequestValidationError{}
+
+// Validate checks the field values on CreateMLPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateMLPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMLPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMLPRequestMultiError, or nil if none found.
+func (m *CreateMLPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMLPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetData()) < 1 {
+		err := CreateMLPRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMse() < 0 {
+		err := CreateMLPRequestValidationError{
+			field:  "Mse",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMae() < 0 {
+		err := CreateMLPRequestValidationError{
+			field:  "Mae",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateMLPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMLPRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateMLPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateMLPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMLPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMLPRequestMultiError) AllErrors() []error { return m }
+
+// CreateMLPRequestValidationError is the validation error returned by
+// CreateMLPRequest.Validate if the designated constraints aren't met.
+type CreateMLPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMLPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMLPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMLPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMLPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMLPRequestValidationError) ErrorName() string { return "CreateMLPRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateMLPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMLPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMLPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMLPRequestValidationError{}
+
+// Validate checks the field values on CreateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateModelRequestMultiError, or nil if none found.
+func (m *CreateModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostname()) < 1 {
+		err := CreateModelRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := CreateModelRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TrainingJobId
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *CreateModelRequest_CreateGnnRequest:
+		if v == nil {
+			err := CreateModelRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetCreateGnnRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateModelRequestValidationError{
+						field:  "CreateGnnRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateModelRequestValidationError{
+						field:  "CreateGnnRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreateGnnRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateModelRequestValidationError{
+					field:  "CreateGnnRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateModelRequest_CreateMlpRequest:
+		if v == nil {
+			err := CreateModelRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetCreateMlpRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateModelRequestValidationError{
+						field:  "CreateMlpRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateModelRequestValidationError{
+						field:  "CreateMlpRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreateMlpRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateModelRequestValidationError{
+					field:  "CreateMlpRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := CreateModelRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateModelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateModelRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateModelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateModelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateModelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateModelRequestMultiError) AllErrors() []error { return m }
+
+// CreateModelRequestValidationError is the validation error returned by
+// CreateModelRequest.Validate if the designated constraints aren't met.
+type CreateModelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateModelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateModelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateModelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateModelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateModelRequestValidationError) ErrorName() string {
+	return "CreateModelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateModelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateModelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateModelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateModelRequestValidationError{}
+
+// Validate checks the field values on GNNEvaluation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GNNEvaluation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GNNEvaluation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GNNEvaluationMultiError, or
+// nil if none found.
+func (m *GNNEvaluation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GNNEvaluation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetRecall(); val < 0 || val > 1 {
+		err := GNNEvaluationValidationError{
+			field:  "Recall",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPrecision(); val < 0 || val > 1 {
+		err := GNNEvaluationValidationError{
+			field:  "Precision",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetF1Score(); val < 0 || val > 1 {
+		err := GNNEvaluationValidationError{
+			field:  "F1Score",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GNNEvaluationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GNNEvaluationMultiError is an error wrapping multiple validation errors
+// returned by GNNEvaluation.ValidateAll() if the designated constraints
+// aren't met.
+type GNNEvaluationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GNNEvaluationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GNNEvaluationMultiError) AllErrors() []error { return m }
+
+// GNNEvaluationValidationError is the validation error returned by
+// GNNEvaluation.Validate if the designated constraints aren't met.
+type GNNEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GNNEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GNNEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GNNEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GNNEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GNNEvaluationValidationError) ErrorName() string { return "GNNEvaluationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GNNEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGNNEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GNNEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GNNEvaluationValidationError{}
+
+// Validate checks the field values on MLPEvaluation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MLPEvaluation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MLPEvaluation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MLPEvaluationMultiError, or
+// nil if none found.
+func (m *MLPEvaluation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MLPEvaluation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMse() < 0 {
+		err := MLPEvaluationValidationError{
+			field:  "Mse",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMae() < 0 {
+		err := MLPEvaluationValidationError{
+			field:  "Mae",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MLPEvaluationMultiError(errors)
+	}
+
+	return nil
+}
+
+// MLPEvaluationMultiError is an error wrapping multiple validation errors
+// returned by MLPEvaluation.ValidateAll() if the designated constraints
+// aren't met.
+type MLPEvaluationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MLPEvaluationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MLPEvaluationMultiError) AllErrors() []error { return m }
+
+// MLPEvaluationValidationError is the validation error returned by
+// MLPEvaluation.Validate if the designated constraints aren't met.
+type MLPEvaluationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MLPEvaluationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MLPEvaluationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MLPEvaluationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MLPEvaluationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MLPEvaluationValidationError) ErrorName() string { return "MLPEvaluationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MLPEvaluationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMLPEvaluation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MLPEvaluationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MLPEvaluationValidationError{}
+
+// Validate checks the field values on Model with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Model) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Model with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ModelMultiError, or nil if none found.
+func (m *Model) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Model) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ModelValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := ModelValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ModelType_name[int32(m.GetType())]; !ok {
+		err := ModelValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ModelState_name[int32(m.GetState())]; !ok {
+		err := ModelValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := ModelValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TrainingJobId
+
+	if m.GetCreatedAt() == nil {
+		err := ModelValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() == nil {
+		err := ModelValidationError{
+			field:  "UpdatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCanaryPercentage() > 100 {
+		err := ModelValidationError{
+			field:  "CanaryPercentage",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPromotionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelValidationError{
+					field:  "PromotionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelValidationError{
+					field:  "PromotionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromotionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelValidationError{
+				field:  "PromotionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Evaluation.(type) {
+	case *Model_GnnEvaluation:
+		if v == nil {
+			err := ModelValidationError{
+				field:  "Evaluation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGnnEvaluation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelValidationError{
+						field:  "GnnEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelValidationError{
+						field:  "GnnEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGnnEvaluation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelValidationError{
+					field:  "GnnEvaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Model_MlpEvaluation:
+		if v == nil {
+			err := ModelValidationError{
+				field:  "Evaluation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMlpEvaluation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelValidationError{
+						field:  "MlpEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelValidationError{
+						field:  "MlpEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMlpEvaluation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelValidationError{
+					field:  "MlpEvaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ModelMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelMultiError is an error wrapping multiple validation errors returned by
+// Model.ValidateAll() if the designated constraints aren't met.
+type ModelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelMultiError) AllErrors() []error { return m }
+
+// ModelValidationError is the validation error returned by Model.Validate if
+// the designated constraints aren't met.
+type ModelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelValidationError) ErrorName() string { return "ModelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelValidationError{}
+
+// Validate checks the field values on ListModelsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListModelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModelsRequestMultiError, or nil if none found.
+func (m *ListModelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := ListModelsRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(m.GetStates()) > 0 {
+
+		for idx, item := range m.GetStates() {
+			_, _ = idx, item
+
+			if _, ok := ModelState_name[int32(item)]; !ok {
+				err := ListModelsRequestValidationError{
+					field:  fmt.Sprintf("States[%v]", idx),
+					reason: "value must be one of the defined enum values",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListModelsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListModelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModelsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListModelsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListModelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModelsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModelsRequestMultiError) AllErrors() []error { return m }
+
+// ListModelsRequestValidationError is the validation error returned by
+// ListModelsRequest.Validate if the designated constraints aren't met.
+type ListModelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModelsRequestValidationError) ErrorName() string {
+	return "ListModelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModelsRequestValidationError{}
+
+// Validate checks the field values on ListModelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModelsResponseMultiError, or nil if none found.
+func (m *ListModelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetModels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModelsResponseValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModelsResponseValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModelsResponseValidationError{
+					field:  fmt.Sprintf("Models[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListModelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModelsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListModelsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListModelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModelsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModelsResponseMultiError) AllErrors() []error { return m }
+
+// ListModelsResponseValidationError is the validation error returned by
+// ListModelsResponse.Validate if the designated constraints aren't met.
+type ListModelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModelsResponseValidationError) ErrorName() string {
+	return "ListModelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModelsResponseValidationError{}
+
+// Validate checks the field values on GetModelRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelRequestMultiError, or nil if none found.
+func (m *GetModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := GetModelRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetModelRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetModelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelRequestMultiError is an error wrapping multiple validation errors
+// returned by GetModelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetModelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelRequestMultiError) AllErrors() []error { return m }
+
+// GetModelRequestValidationError is the validation error returned by
+// GetModelRequest.Validate if the designated constraints aren't met.
+type GetModelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelRequestValidationError) ErrorName() string { return "GetModelRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetModelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelRequestValidationError{}
+
+// Validate checks the field values on ActivateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivateModelRequestMultiError, or nil if none found.
+func (m *ActivateModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivateModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := ActivateModelRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ActivateModelRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := ActivateModelRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ActivateModelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivateModelRequestMultiError is an error wrapping multiple validation
+// errors returned by ActivateModelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateModelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivateModelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivateModelRequestMultiError) AllErrors() []error { return m }
+
+// ActivateModelRequestValidationError is the validation error returned by
+// ActivateModelRequest.Validate if the designated constraints aren't met.
+type ActivateModelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateModelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateModelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateModelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateModelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateModelRequestValidationError) ErrorName() string {
+	return "ActivateModelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateModelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateModelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateModelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateModelRequestValidationError{}
+
+// Validate checks the field values on RollbackModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackModelRequestMultiError, or nil if none found.
+func (m *RollbackModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := RollbackModelRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RollbackModelRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackModelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackModelRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackModelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackModelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackModelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackModelRequestMultiError) AllErrors() []error { return m }
+
+// RollbackModelRequestValidationError is the validation error returned by
+// RollbackModelRequest.Validate if the designated constraints aren't met.
+type RollbackModelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackModelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackModelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackModelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackModelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackModelRequestValidationError) ErrorName() string {
+	return "RollbackModelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackModelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackModelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackModelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackModelRequestValidationError{}
+
+// Validate checks the field values on ModelFile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelFile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelFileMultiError, or nil
+// if none found.
+func (m *ModelFile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelFile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPath()); l < 1 || l > 1024 {
+		err := ModelFileValidationError{
+			field:  "Path",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = ModelFileValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := ModelFileValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ModelFile_Digest_Pattern.MatchString(m.GetDigest()) {
+		err := ModelFileValidationError{
+			field:  "Digest",
+			reason: "value does not match regex pattern \"^(md5|sha256):[A-Fa-f0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ModelFileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelFileMultiError is an error wrapping multiple validation errors returned
+// by ModelFile.ValidateAll() if the designated constraints aren't met.
+type ModelFileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelFileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelFileMultiError) AllErrors() []error { return m }
+
+// ModelFileValidationError is the validation error returned by
+// ModelFile.Validate if the designated constraints aren't met.
+type ModelFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelFileValidationError) ErrorName() string { return "ModelFileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelFileValidationError{}
+
+var _ModelFile_Digest_Pattern = regexp.MustCompile("^(md5|sha256):[A-Fa-f0-9]+$")
+
+// Validate checks the field values on InferenceEndpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InferenceEndpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InferenceEndpoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InferenceEndpointMultiError, or nil if none found.
+func (m *InferenceEndpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InferenceEndpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = InferenceEndpointValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := InferenceEndpointValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRepositoryPath()) < 1 {
+		err := InferenceEndpointValidationError{
+			field:  "RepositoryPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InferenceEndpointMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *InferenceEndpoint) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// InferenceEndpointMultiError is an error wrapping multiple validation errors
+// returned by InferenceEndpoint.ValidateAll() if the designated constraints
+// aren't met.
+type InferenceEndpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InferenceEndpointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InferenceEndpointMultiError) AllErrors() []error { return m }
+
+// InferenceEndpointValidationError is the validation error returned by
+// InferenceEndpoint.Validate if the designated constraints aren't met.
+type InferenceEndpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InferenceEndpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InferenceEndpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InferenceEndpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InferenceEndpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InferenceEndpointValidationError) ErrorName() string {
+	return "InferenceEndpointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InferenceEndpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInferenceEndpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InferenceEndpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InferenceEndpointValidationError{}
+
+// Validate checks the field values on ModelArtifact with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelArtifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelArtifact with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelArtifactMultiError, or
+// nil if none found.
+func (m *ModelArtifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelArtifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ModelArtifactValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := ModelArtifactValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := ModelArtifactValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetFiles()) < 1 {
+		err := ModelArtifactValidationError{
+			field:  "Files",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelArtifactValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelArtifactValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelArtifactValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetConfig() == nil {
+		err := ModelArtifactValidationError{
+			field:  "Config",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelArtifactValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelArtifactValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelArtifactValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEndpoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelArtifactValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelArtifactValidationError{
+						field:  fmt.Sprintf("Endpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelArtifactValidationError{
+					field:  fmt.Sprintf("Endpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModelArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelArtifactMultiError is an error wrapping multiple validation errors
+// returned by ModelArtifact.ValidateAll() if the designated constraints
+// aren't met.
+type ModelArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelArtifactMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelArtifactMultiError) AllErrors() []error { return m }
+
+// ModelArtifactValidationError is the validation error returned by
+// ModelArtifact.Validate if the designated constraints aren't met.
+type ModelArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelArtifactValidationError) ErrorName() string { return "ModelArtifactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelArtifactValidationError{}
+
+// Validate checks the field values on GetModelArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModelArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelArtifactRequestMultiError, or nil if none found.
+func (m *GetModelArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := GetModelArtifactRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetModelArtifactRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return GetModelArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by GetModelArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetModelArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelArtifactRequestMultiError) AllErrors() []error { return m }
+
+// GetModelArtifactRequestValidationError is the validation error returned by
+// GetModelArtifactRequest.Validate if the designated constraints aren't met.
+type GetModelArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelArtifactRequestValidationError) ErrorName() string {
+	return "GetModelArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModelArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelArtifactRequestValidationError{}
+
+// Validate checks the field values on ListModelArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModelArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModelArtifactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModelArtifactsRequestMultiError, or nil if none found.
+func (m *ListModelArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModelArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := ListModelArtifactsRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHostname() != "" {
+
+		if err := m._validateHostname(m.GetHostname()); err != nil {
+			err = ListModelArtifactsRequestValidationError{
+				field:  "Hostname",
+				reason: "value must be a valid hostname",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModelArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListModelArtifactsRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListModelArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListModelArtifactsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListModelArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModelArtifactsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModelArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListModelArtifactsRequestValidationError is the validation error returned by
+// ListModelArtifactsRequest.Validate if the designated constraints aren't met.
+type ListModelArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModelArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModelArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModelArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModelArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModelArtifactsRequestValidationError) ErrorName() string {
+	return "ListModelArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModelArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModelArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModelArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModelArtifactsRequestValidationError{}
+
+// Validate checks the field values on ListModelArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModelArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModelArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModelArtifactsResponseMultiError, or nil if none found.
+func (m *ListModelArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModelArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModelArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModelArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModelArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModelArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModelArtifactsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListModelArtifactsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListModelArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModelArtifactsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModelArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// ListModelArtifactsResponseValidationError is the validation error returned
+// by ListModelArtifactsResponse.Validate if the designated constraints aren't met.
+type ListModelArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModelArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModelArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModelArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModelArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModelArtifactsResponseValidationError) ErrorName() string {
+	return "ListModelArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModelArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModelArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModelArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModelArtifactsResponseValidationError{}
+
+// Validate checks the field values on ModelPromotionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelPromotionPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelPromotionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelPromotionPolicyMultiError, or nil if none found.
+func (m *ModelPromotionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelPromotionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMinSelectionCount() < 1 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "MinSelectionCount",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinAgreementRate(); val < 0 || val > 1 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "MinAgreementRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinSuccessRateDelta(); val < -1 || val > 1 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "MinSuccessRateDelta",
+			reason: "value must be inside range [-1, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxPieceCostRatio() <= 0 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "MaxPieceCostRatio",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxBackToSourceRatioDelta(); val < -1 || val > 1 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "MaxBackToSourceRatioDelta",
+			reason: "value must be inside range [-1, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCanaryPercentage(); val < 1 || val > 100 {
+		err := ModelPromotionPolicyValidationError{
+			field:  "CanaryPercentage",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ModelPromotionPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelPromotionPolicyMultiError is an error wrapping multiple validation
+// errors returned by ModelPromotionPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type ModelPromotionPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelPromotionPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelPromotionPolicyMultiError) AllErrors() []error { return m }
+
+// ModelPromotionPolicyValidationError is the validation error returned by
+// ModelPromotionPolicy.Validate if the designated constraints aren't met.
+type ModelPromotionPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelPromotionPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelPromotionPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelPromotionPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelPromotionPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelPromotionPolicyValidationError) ErrorName() string {
+	return "ModelPromotionPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModelPromotionPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelPromotionPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelPromotionPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelPromotionPolicyValidationError{}
+
+// Validate checks the field values on EvaluateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateModelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateModelRequestMultiError, or nil if none found.
+func (m *EvaluateModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchedulerClusterId() < 1 {
+		err := EvaluateModelRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
 			return err
@@ -3222,11 +8153,10 @@ func (m *ListApplicationsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateHostname(m.GetHostname()); err != nil {
-		err = ListApplicationsRequestValidationError{
-			field:  "Hostname",
-			reason: "value must be a valid hostname",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := EvaluateModelRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -3234,10 +8164,10 @@ func (m *ListApplicationsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if ip := net.ParseIP(m.GetIp()); ip == nil {
-		err := ListApplicationsRequestValidationError{
-			field:  "Ip",
-			reason: "value must be a valid IP address",
+	if m.GetVersion() < 1 {
+		err := EvaluateModelRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
 			return err
@@ -3245,50 +8175,71 @@ func (m *ListApplicationsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return ListApplicationsRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *ListApplicationsRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+	if _, ok := _EvaluateModelRequest_State_InLookup[m.GetState()]; !ok {
+		err := EvaluateModelRequestValidationError{
+			field:  "State",
+			reason: "value must be in list [SHADOW_MODEL CANARY_MODEL]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+	if m.GetCanaryPercentage() > 100 {
+		err := EvaluateModelRequestValidationError{
+			field:  "CanaryPercentage",
+			reason: "value must be less than or equal to 100",
 		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if all {
+		switch v := interface{}(m.GetPromotionPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateModelRequestValidationError{
+					field:  "PromotionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateModelRequestValidationError{
+					field:  "PromotionPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+	} else if v, ok := interface{}(m.GetPromotionPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateModelRequestValidationError{
+				field:  "PromotionPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return EvaluateModelRequestMultiError(errors)
+	}
+
 	return nil
 }
 
-// ListApplicationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListApplicationsRequest.ValidateAll() if the designated
+// EvaluateModelRequestMultiError is an error wrapping multiple validation
+// errors returned by EvaluateModelRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListApplicationsRequestMultiError []error
+type EvaluateModelRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListApplicationsRequestMultiError) Error() string {
+func (m EvaluateModelRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3297,11 +8248,11 @@ func (m ListApplicationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListApplicationsRequestMultiError) AllErrors() []error { return m }
+func (m EvaluateModelRequestMultiError) AllErrors() []error { return m }
 
-// ListApplicationsRequestValidationError is the validation error returned by
-// ListApplicationsRequest.Validate if the designated constraints aren't met.
-type ListApplicationsRequestValidationError struct {
+// EvaluateModelRequestValidationError is the validation error returned by
+// EvaluateModelRequest.Validate if the designated constraints aren't met.
+type EvaluateModelRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3309,24 +8260,24 @@ type ListApplicationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListApplicationsRequestValidationError) Field() string { return e.field }
+func (e EvaluateModelRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListApplicationsRequestValidationError) Reason() string { return e.reason }
+func (e EvaluateModelRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListApplicationsRequestValidationError) Cause() error { return e.cause }
+func (e EvaluateModelRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListApplicationsRequestValidationError) Key() bool { return e.key }
+func (e EvaluateModelRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListApplicationsRequestValidationError) ErrorName() string {
-	return "ListApplicationsRequestValidationError"
+func (e EvaluateModelRequestValidationError) ErrorName() string {
+	return "EvaluateModelRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListApplicationsRequestValidationError) Error() string {
+func (e EvaluateModelRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3338,14 +8289,14 @@ func (e ListApplicationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListApplicationsRequest.%s: %s%s",
+		"invalid %sEvaluateModelRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListApplicationsRequestValidationError{}
+var _ error = EvaluateModelRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3353,78 +8304,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListApplicationsRequestValidationError{}
+} = EvaluateModelRequestValidationError{}
 
-// Validate checks the field values on ListApplicationsResponse with the rules
+var _EvaluateModelRequest_State_InLookup = map[ModelState]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on OnlineEvaluationStats with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListApplicationsResponse) Validate() error {
+func (m *OnlineEvaluationStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListApplicationsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OnlineEvaluationStats with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListApplicationsResponseMultiError, or nil if none found.
-func (m *ListApplicationsResponse) ValidateAll() error {
+// OnlineEvaluationStatsMultiError, or nil if none found.
+func (m *OnlineEvaluationStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListApplicationsResponse) validate(all bool) error {
+func (m *OnlineEvaluationStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetApplications() {
-		_, _ = idx, item
+	// no validation rules for SelectionCount
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListApplicationsResponseValidationError{
-						field:  fmt.Sprintf("Applications[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListApplicationsResponseValidationError{
-						field:  fmt.Sprintf("Applications[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for SuccessCount
+
+	// no validation rules for AgreementCount
+
+	// no validation rules for PieceCount
+
+	if all {
+		switch v := interface{}(m.GetMeanPieceCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OnlineEvaluationStatsValidationError{
+					field:  "MeanPieceCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListApplicationsResponseValidationError{
-					field:  fmt.Sprintf("Applications[%v]", idx),
+				errors = append(errors, OnlineEvaluationStatsValidationError{
+					field:  "MeanPieceCost",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeanPieceCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OnlineEvaluationStatsValidationError{
+				field:  "MeanPieceCost",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for PeerCount
+
+	// no validation rules for BackToSourceCount
+
 	if len(errors) > 0 {
-		return ListApplicationsResponseMultiError(errors)
+		return OnlineEvaluationStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListApplicationsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListApplicationsResponse.ValidateAll() if the designated
+// OnlineEvaluationStatsMultiError is an error wrapping multiple validation
+// errors returned by OnlineEvaluationStats.ValidateAll() if the designated
 // constraints aren't met.
-type ListApplicationsResponseMultiError []error
+type OnlineEvaluationStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListApplicationsResponseMultiError) Error() string {
+func (m OnlineEvaluationStatsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3433,11 +8396,11 @@ func (m ListApplicationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListApplicationsResponseMultiError) AllErrors() []error { return m }
+func (m OnlineEvaluationStatsMultiError) AllErrors() []error { return m }
 
-// ListApplicationsResponseValidationError is the validation error returned by
-// ListApplicationsResponse.Validate if the designated constraints aren't met.
-type ListApplicationsResponseValidationError struct {
+// OnlineEvaluationStatsValidationError is the validation error returned by
+// OnlineEvaluationStats.Validate if the designated constraints aren't met.
+type OnlineEvaluationStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3445,24 +8408,24 @@ type ListApplicationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListApplicationsResponseValidationError) Field() string { return e.field }
+func (e OnlineEvaluationStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListApplicationsResponseValidationError) Reason() string { return e.reason }
+func (e OnlineEvaluationStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListApplicationsResponseValidationError) Cause() error { return e.cause }
+func (e OnlineEvaluationStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListApplicationsResponseValidationError) Key() bool { return e.key }
+func (e OnlineEvaluationStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListApplicationsResponseValidationError) ErrorName() string {
-	return "ListApplicationsResponseValidationError"
+func (e OnlineEvaluationStatsValidationError) ErrorName() string {
+	return "OnlineEvaluationStatsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListApplicationsResponseValidationError) Error() string {
+func (e OnlineEvaluationStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3474,14 +8437,14 @@ func (e ListApplicationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListApplicationsResponse.%s: %s%s",
+		"invalid %sOnlineEvaluationStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListApplicationsResponseValidationError{}
+var _ error = OnlineEvaluationStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3489,34 +8452,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListApplicationsResponseValidationError{}
+} = OnlineEvaluationStatsValidationError{}
 
-// Validate checks the field values on CreateGNNRequest with the rules defined
+// Validate checks the field values on OnlineEvaluation with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateGNNRequest) Validate() error {
+func (m *OnlineEvaluation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateGNNRequest with the rules
+// ValidateAll checks the field values on OnlineEvaluation with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateGNNRequestMultiError, or nil if none found.
-func (m *CreateGNNRequest) ValidateAll() error {
+// OnlineEvaluationMultiError, or nil if none found.
+func (m *OnlineEvaluation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateGNNRequest) validate(all bool) error {
+func (m *OnlineEvaluation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetData()) < 1 {
-		err := CreateGNNRequestValidationError{
-			field:  "Data",
-			reason: "value length must be at least 1 bytes",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := OnlineEvaluationValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -3524,10 +8487,10 @@ func (m *CreateGNNRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetRecall(); val < 0 || val > 1 {
-		err := CreateGNNRequestValidationError{
-			field:  "Recall",
-			reason: "value must be inside range [0, 1]",
+	if m.GetVersion() < 1 {
+		err := OnlineEvaluationValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
 			return err
@@ -3535,10 +8498,10 @@ func (m *CreateGNNRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetPrecision(); val < 0 || val > 1 {
-		err := CreateGNNRequestValidationError{
-			field:  "Precision",
-			reason: "value must be inside range [0, 1]",
+	if _, ok := ModelState_name[int32(m.GetState())]; !ok {
+		err := OnlineEvaluationValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -3546,10 +8509,90 @@ func (m *CreateGNNRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetF1Score(); val < 0 || val > 1 {
-		err := CreateGNNRequestValidationError{
-			field:  "F1Score",
-			reason: "value must be inside range [0, 1]",
+	if m.GetStats() == nil {
+		err := OnlineEvaluationValidationError{
+			field:  "Stats",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OnlineEvaluationValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OnlineEvaluationValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OnlineEvaluationValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaselineStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OnlineEvaluationValidationError{
+					field:  "BaselineStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OnlineEvaluationValidationError{
+					field:  "BaselineStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaselineStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OnlineEvaluationValidationError{
+				field:  "BaselineStats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetStartedAt() == nil {
+		err := OnlineEvaluationValidationError{
+			field:  "StartedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFinishedAt() == nil {
+		err := OnlineEvaluationValidationError{
+			field:  "FinishedAt",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -3558,19 +8601,19 @@ func (m *CreateGNNRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateGNNRequestMultiError(errors)
+		return OnlineEvaluationMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateGNNRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateGNNRequest.ValidateAll() if the designated constraints
+// OnlineEvaluationMultiError is an error wrapping multiple validation errors
+// returned by OnlineEvaluation.ValidateAll() if the designated constraints
 // aren't met.
-type CreateGNNRequestMultiError []error
+type OnlineEvaluationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateGNNRequestMultiError) Error() string {
+func (m OnlineEvaluationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3579,11 +8622,11 @@ func (m CreateGNNRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateGNNRequestMultiError) AllErrors() []error { return m }
+func (m OnlineEvaluationMultiError) AllErrors() []error { return m }
 
-// CreateGNNRequestValidationError is the validation error returned by
-// CreateGNNRequest.Validate if the designated constraints aren't met.
-type CreateGNNRequestValidationError struct {
+// OnlineEvaluationValidationError is the validation error returned by
+// OnlineEvaluation.Validate if the designated constraints aren't met.
+type OnlineEvaluationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3591,22 +8634,22 @@ type CreateGNNRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateGNNRequestValidationError) Field() string { return e.field }
+func (e OnlineEvaluationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateGNNRequestValidationError) Reason() string { return e.reason }
+func (e OnlineEvaluationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateGNNRequestValidationError) Cause() error { return e.cause }
+func (e OnlineEvaluationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateGNNRequestValidationError) Key() bool { return e.key }
+func (e OnlineEvaluationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateGNNRequestValidationError) ErrorName() string { return "CreateGNNRequestValidationError" }
+func (e OnlineEvaluationValidationError) ErrorName() string { return "OnlineEvaluationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateGNNRequestValidationError) Error() string {
+func (e OnlineEvaluationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3618,14 +8661,14 @@ func (e CreateGNNRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateGNNRequest.%s: %s%s",
+		"invalid %sOnlineEvaluation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateGNNRequestValidationError{}
+var _ error = OnlineEvaluationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3633,34 +8676,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateGNNRequestValidationError{}
+} = OnlineEvaluationValidationError{}
 
-// Validate checks the field values on CreateMLPRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateMLPRequest) Validate() error {
+// Validate checks the field values on ReportOnlineEvaluationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportOnlineEvaluationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMLPRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateMLPRequestMultiError, or nil if none found.
-func (m *CreateMLPRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReportOnlineEvaluationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReportOnlineEvaluationRequestMultiError, or nil if none found.
+func (m *ReportOnlineEvaluationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMLPRequest) validate(all bool) error {
+func (m *ReportOnlineEvaluationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetData()) < 1 {
-		err := CreateMLPRequestValidationError{
-			field:  "Data",
-			reason: "value length must be at least 1 bytes",
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = ReportOnlineEvaluationRequestValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -3668,10 +8712,10 @@ func (m *CreateMLPRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMse() < 0 {
-		err := CreateMLPRequestValidationError{
-			field:  "Mse",
-			reason: "value must be greater than or equal to 0",
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := ReportOnlineEvaluationRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
 		}
 		if !all {
 			return err
@@ -3679,10 +8723,10 @@ func (m *CreateMLPRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMae() < 0 {
-		err := CreateMLPRequestValidationError{
-			field:  "Mae",
-			reason: "value must be greater than or equal to 0",
+	if m.GetSchedulerClusterId() < 1 {
+		err := ReportOnlineEvaluationRequestValidationError{
+			field:  "SchedulerClusterId",
+			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
 			return err
@@ -3690,20 +8734,95 @@ func (m *CreateMLPRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetEvaluations()) < 1 {
+		err := ReportOnlineEvaluationRequestValidationError{
+			field:  "Evaluations",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEvaluations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportOnlineEvaluationRequestValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportOnlineEvaluationRequestValidationError{
+						field:  fmt.Sprintf("Evaluations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportOnlineEvaluationRequestValidationError{
+					field:  fmt.Sprintf("Evaluations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateMLPRequestMultiError(errors)
+		return ReportOnlineEvaluationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMLPRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateMLPRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateMLPRequestMultiError []error
+func (m *ReportOnlineEvaluationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReportOnlineEvaluationRequestMultiError is an error wrapping multiple
+// validation errors returned by ReportOnlineEvaluationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReportOnlineEvaluationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMLPRequestMultiError) Error() string {
+func (m ReportOnlineEvaluationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3712,11 +8831,12 @@ func (m CreateMLPRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMLPRequestMultiError) AllErrors() []error { return m }
+func (m ReportOnlineEvaluationRequestMultiError) AllErrors() []error { return m }
 
-// CreateMLPRequestValidationError is the validation error returned by
-// CreateMLPRequest.Validate if the designated constraints aren't met.
-type CreateMLPRequestValidationError struct {
+// ReportOnlineEvaluationRequestValidationError is the validation error
+// returned by ReportOnlineEvaluationRequest.Validate if the designated
+// constraints aren't met.
+type ReportOnlineEvaluationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3724,22 +8844,24 @@ type CreateMLPRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMLPRequestValidationError) Field() string { return e.field }
+func (e ReportOnlineEvaluationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMLPRequestValidationError) Reason() string { return e.reason }
+func (e ReportOnlineEvaluationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMLPRequestValidationError) Cause() error { return e.cause }
+func (e ReportOnlineEvaluationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMLPRequestValidationError) Key() bool { return e.key }
+func (e ReportOnlineEvaluationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMLPRequestValidationError) ErrorName() string { return "CreateMLPRequestValidationError" }
+func (e ReportOnlineEvaluationRequestValidationError) ErrorName() string {
+	return "ReportOnlineEvaluationRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateMLPRequestValidationError) Error() string {
+func (e ReportOnlineEvaluationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3751,14 +8873,14 @@ func (e CreateMLPRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMLPRequest.%s: %s%s",
+		"invalid %sReportOnlineEvaluationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMLPRequestValidationError{}
+var _ error = ReportOnlineEvaluationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3766,166 +8888,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMLPRequestValidationError{}
+} = ReportOnlineEvaluationRequestValidationError{}
 
-// Validate checks the field values on CreateModelRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReportOnlineEvaluationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateModelRequest) Validate() error {
+func (m *ReportOnlineEvaluationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateModelRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateModelRequestMultiError, or nil if none found.
-func (m *CreateModelRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReportOnlineEvaluationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReportOnlineEvaluationResponseMultiError, or nil if none found.
+func (m *ReportOnlineEvaluationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateModelRequest) validate(all bool) error {
+func (m *ReportOnlineEvaluationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetHostname()) < 1 {
-		err := CreateModelRequestValidationError{
-			field:  "Hostname",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if ip := net.ParseIP(m.GetIp()); ip == nil {
-		err := CreateModelRequestValidationError{
-			field:  "Ip",
-			reason: "value must be a valid IP address",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	oneofRequestPresent := false
-	switch v := m.Request.(type) {
-	case *CreateModelRequest_CreateGnnRequest:
-		if v == nil {
-			err := CreateModelRequestValidationError{
-				field:  "Request",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		oneofRequestPresent = true
-
-		if all {
-			switch v := interface{}(m.GetCreateGnnRequest()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateModelRequestValidationError{
-						field:  "CreateGnnRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateModelRequestValidationError{
-						field:  "CreateGnnRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCreateGnnRequest()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateModelRequestValidationError{
-					field:  "CreateGnnRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *CreateModelRequest_CreateMlpRequest:
-		if v == nil {
-			err := CreateModelRequestValidationError{
-				field:  "Request",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		oneofRequestPresent = true
+	for idx, item := range m.GetModels() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetCreateMlpRequest()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateModelRequestValidationError{
-						field:  "CreateMlpRequest",
+					errors = append(errors, ReportOnlineEvaluationResponseValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateModelRequestValidationError{
-						field:  "CreateMlpRequest",
+					errors = append(errors, ReportOnlineEvaluationResponseValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCreateMlpRequest()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateModelRequestValidationError{
-					field:  "CreateMlpRequest",
+				return ReportOnlineEvaluationResponseValidationError{
+					field:  fmt.Sprintf("Models[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-	if !oneofRequestPresent {
-		err := CreateModelRequestValidationError{
-			field:  "Request",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateModelRequestMultiError(errors)
+		return ReportOnlineEvaluationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateModelRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateModelRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateModelRequestMultiError []error
+// ReportOnlineEvaluationResponseMultiError is an error wrapping multiple
+// validation errors returned by ReportOnlineEvaluationResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReportOnlineEvaluationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateModelRequestMultiError) Error() string {
+func (m ReportOnlineEvaluationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3934,11 +8968,12 @@ func (m CreateModelRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateModelRequestMultiError) AllErrors() []error { return m }
+func (m ReportOnlineEvaluationResponseMultiError) AllErrors() []error { return m }
 
-// CreateModelRequestValidationError is the validation error returned by
-// CreateModelRequest.Validate if the designated constraints aren't met.
-type CreateModelRequestValidationError struct {
+// ReportOnlineEvaluationResponseValidationError is the validation error
+// returned by ReportOnlineEvaluationResponse.Validate if the designated
+// constraints aren't met.
+type ReportOnlineEvaluationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3946,24 +8981,24 @@ type CreateModelRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateModelRequestValidationError) Field() string { return e.field }
+func (e ReportOnlineEvaluationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateModelRequestValidationError) Reason() string { return e.reason }
+func (e ReportOnlineEvaluationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateModelRequestValidationError) Cause() error { return e.cause }
+func (e ReportOnlineEvaluationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateModelRequestValidationError) Key() bool { return e.key }
+func (e ReportOnlineEvaluationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateModelRequestValidationError) ErrorName() string {
-	return "CreateModelRequestValidationError"
+func (e ReportOnlineEvaluationResponseValidationError) ErrorName() string {
+	return "ReportOnlineEvaluationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateModelRequestValidationError) Error() string {
+func (e ReportOnlineEvaluationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3975,14 +9010,14 @@ func (e CreateModelRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateModelRequest.%s: %s%s",
+		"invalid %sReportOnlineEvaluationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateModelRequestValidationError{}
+var _ error = ReportOnlineEvaluationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3990,7 +9025,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateModelRequestValidationError{}
+} = ReportOnlineEvaluationResponseValidationError{}
 
 // Validate checks the field values on KeepAliveRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -41,8 +41,38 @@ type ManagerClient interface {
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
 	// List applications configuration.
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	// Get effective quota of application.
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*ApplicationQuota, error)
+	// Authorize application to download the url, it returns PermissionDenied code
+	// with errordetails.v2.AccessDeniedError if the access is denied. The unknown applications,
+	// including the empty application name, are denied with UNKNOWN_APPLICATION unless the
+	// manager configures the default access control of the unknown applications.
+	AuthorizeApplication(ctx context.Context, in *AuthorizeApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List download token keys without private keys.
+	ListDownloadTokenKeys(ctx context.Context, in *ListDownloadTokenKeysRequest, opts ...grpc.CallOption) (*ListDownloadTokenKeysResponse, error)
+	// Get the newest download token key with the private key, it is only allowed to be
+	// called by the scheduler.
+	GetDownloadTokenSigningKey(ctx context.Context, in *GetDownloadTokenSigningKeyRequest, opts ...grpc.CallOption) (*DownloadTokenSigningKey, error)
 	// Create model and update data of model to object storage.
 	CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List versions of models in the model registry.
+	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
+	// Get version of model, the active version is returned if the version is zero.
+	GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*Model, error)
+	// Activate version of model, the previous active version is inactive.
+	ActivateModel(ctx context.Context, in *ActivateModelRequest, opts ...grpc.CallOption) (*Model, error)
+	// Rollback model to the previous active version, it returns FailedPrecondition code
+	// if there is no previous active version.
+	RollbackModel(ctx context.Context, in *RollbackModelRequest, opts ...grpc.CallOption) (*Model, error)
+	// EvaluateModel serves model version in shadow or canary.
+	EvaluateModel(ctx context.Context, in *EvaluateModelRequest, opts ...grpc.CallOption) (*Model, error)
+	// ReportOnlineEvaluation reports online evaluations of the served model versions periodically,
+	// and the model versions whose states are changed by the promotion policies are streamed back.
+	ReportOnlineEvaluation(ctx context.Context, opts ...grpc.CallOption) (Manager_ReportOnlineEvaluationClient, error)
+	// GetModelArtifact gets artifact of model version.
+	GetModelArtifact(ctx context.Context, in *GetModelArtifactRequest, opts ...grpc.CallOption) (*ModelArtifact, error)
+	// ListModelArtifacts lists artifacts of the served model versions which are deployed to the inference servers.
+	ListModelArtifacts(ctx context.Context, in *ListModelArtifactsRequest, opts ...grpc.CallOption) (*ListModelArtifactsResponse, error)
 	// KeepAlive with manager.
 	KeepAlive(ctx context.Context, opts ...grpc.CallOption) (Manager_KeepAliveClient, error)
 }
@@ -136,6 +166,42 @@ func (c *managerClient) ListApplications(ctx context.Context, in *ListApplicatio
 	return out, nil
 }
 
+func (c *managerClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*ApplicationQuota, error) {
+	out := new(ApplicationQuota)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) AuthorizeApplication(ctx context.Context, in *AuthorizeApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/AuthorizeApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) ListDownloadTokenKeys(ctx context.Context, in *ListDownloadTokenKeysRequest, opts ...grpc.CallOption) (*ListDownloadTokenKeysResponse, error) {
+	out := new(ListDownloadTokenKeysResponse)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/ListDownloadTokenKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetDownloadTokenSigningKey(ctx context.Context, in *GetDownloadTokenSigningKeyRequest, opts ...grpc.CallOption) (*DownloadTokenSigningKey, error) {
+	out := new(DownloadTokenSigningKey)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/GetDownloadTokenSigningKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) CreateModel(ctx context.Context, in *CreateModelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/manager.v2.Manager/CreateModel", in, out, opts...)
@@ -145,8 +211,102 @@ func (c *managerClient) CreateModel(ctx context.Context, in *CreateModelRequest,
 	return out, nil
 }
 
+func (c *managerClient) ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error) {
+	out := new(ListModelsResponse)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/ListModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetModel(ctx context.Context, in *GetModelRequest, opts ...grpc.CallOption) (*Model, error) {
+	out := new(Model)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/GetModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) ActivateModel(ctx context.Context, in *ActivateModelRequest, opts ...grpc.CallOption) (*Model, error) {
+	out := new(Model)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/ActivateModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RollbackModel(ctx context.Context, in *RollbackModelRequest, opts ...grpc.CallOption) (*Model, error) {
+	out := new(Model)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/RollbackModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) EvaluateModel(ctx context.Context, in *EvaluateModelRequest, opts ...grpc.CallOption) (*Model, error) {
+	out := new(Model)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/EvaluateModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) ReportOnlineEvaluation(ctx context.Context, opts ...grpc.CallOption) (Manager_ReportOnlineEvaluationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[0], "/manager.v2.Manager/ReportOnlineEvaluation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerReportOnlineEvaluationClient{stream}
+	return x, nil
+}
+
+type Manager_ReportOnlineEvaluationClient interface {
+	Send(*ReportOnlineEvaluationRequest) error
+	Recv() (*ReportOnlineEvaluationResponse, error)
+	grpc.ClientStream
+}
+
+type managerReportOnlineEvaluationClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerReportOnlineEvaluationClient) Send(m *ReportOnlineEvaluationRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *managerReportOnlineEvaluationClient) Recv() (*ReportOnlineEvaluationResponse, error) {
+	m := new(ReportOnlineEvaluationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managerClient) GetModelArtifact(ctx context.Context, in *GetModelArtifactRequest, opts ...grpc.CallOption) (*ModelArtifact, error) {
+	out := new(ModelArtifact)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/GetModelArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) ListModelArtifacts(ctx context.Context, in *ListModelArtifactsRequest, opts ...grpc.CallOption) (*ListModelArtifactsResponse, error) {
+	out := new(ListModelArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/manager.v2.Manager/ListModelArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) KeepAlive(ctx context.Context, opts ...grpc.CallOption) (Manager_KeepAliveClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[0], "/manager.v2.Manager/KeepAlive", opts...)
+	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[1], "/manager.v2.Manager/KeepAlive", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -201,8 +361,38 @@ type ManagerServer interface {
 	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
 	// List applications configuration.
 	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	// Get effective quota of application.
+	GetQuota(context.Context, *GetQuotaRequest) (*ApplicationQuota, error)
+	// Authorize application to download the url, it returns PermissionDenied code
+	// with errordetails.v2.AccessDeniedError if the access is denied. The unknown applications,
+	// including the empty application name, are denied with UNKNOWN_APPLICATION unless the
+	// manager configures the default access control of the unknown applications.
+	AuthorizeApplication(context.Context, *AuthorizeApplicationRequest) (*emptypb.Empty, error)
+	// List download token keys without private keys.
+	ListDownloadTokenKeys(context.Context, *ListDownloadTokenKeysRequest) (*ListDownloadTokenKeysResponse, error)
+	// Get the newest download token key with the private key, it is only allowed to be
+	// called by the scheduler.
+	GetDownloadTokenSigningKey(context.Context, *GetDownloadTokenSigningKeyRequest) (*DownloadTokenSigningKey, error)
 	// Create model and update data of model to object storage.
 	CreateModel(context.Context, *CreateModelRequest) (*emptypb.Empty, error)
+	// List versions of models in the model registry.
+	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	// Get version of model, the active version is returned if the version is zero.
+	GetModel(context.Context, *GetModelRequest) (*Model, error)
+	// Activate version of model, the previous active version is inactive.
+	ActivateModel(context.Context, *ActivateModelRequest) (*Model, error)
+	// Rollback model to the previous active version, it returns FailedPrecondition code
+	// if there is no previous active version.
+	RollbackModel(context.Context, *RollbackModelRequest) (*Model, error)
+	// EvaluateModel serves model version in shadow or canary.
+	EvaluateModel(context.Context, *EvaluateModelRequest) (*Model, error)
+	// ReportOnlineEvaluation reports online evaluations of the served model versions periodically,
+	// and the model versions whose states are changed by the promotion policies are streamed back.
+	ReportOnlineEvaluation(Manager_ReportOnlineEvaluationServer) error
+	// GetModelArtifact gets artifact of model version.
+	GetModelArtifact(context.Context, *GetModelArtifactRequest) (*ModelArtifact, error)
+	// ListModelArtifacts lists artifacts of the served model versions which are deployed to the inference servers.
+	ListModelArtifacts(context.Context, *ListModelArtifactsRequest) (*ListModelArtifactsResponse, error)
 	// KeepAlive with manager.
 	KeepAlive(Manager_KeepAliveServer) error
 }
@@ -238,9 +428,45 @@ func (UnimplementedManagerServer) ListBuckets(context.Context, *ListBucketsReque
 func (UnimplementedManagerServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
 }
+func (UnimplementedManagerServer) GetQuota(context.Context, *GetQuotaRequest) (*ApplicationQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedManagerServer) AuthorizeApplication(context.Context, *AuthorizeApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeApplication not implemented")
+}
+func (UnimplementedManagerServer) ListDownloadTokenKeys(context.Context, *ListDownloadTokenKeysRequest) (*ListDownloadTokenKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDownloadTokenKeys not implemented")
+}
+func (UnimplementedManagerServer) GetDownloadTokenSigningKey(context.Context, *GetDownloadTokenSigningKeyRequest) (*DownloadTokenSigningKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTokenSigningKey not implemented")
+}
 func (UnimplementedManagerServer) CreateModel(context.Context, *CreateModelRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateModel not implemented")
 }
+func (UnimplementedManagerServer) ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModels not implemented")
+}
+func (UnimplementedManagerServer) GetModel(context.Context, *GetModelRequest) (*Model, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModel not implemented")
+}
+func (UnimplementedManagerServer) ActivateModel(context.Context, *ActivateModelRequest) (*Model, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateModel not implemented")
+}
+func (UnimplementedManagerServer) RollbackModel(context.Context, *RollbackModelRequest) (*Model, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackModel not implemented")
+}
+func (UnimplementedManagerServer) EvaluateModel(context.Context, *EvaluateModelRequest) (*Model, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateModel not implemented")
+}
+func (UnimplementedManagerServer) ReportOnlineEvaluation(Manager_ReportOnlineEvaluationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportOnlineEvaluation not implemented")
+}
+func (UnimplementedManagerServer) GetModelArtifact(context.Context, *GetModelArtifactRequest) (*ModelArtifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelArtifact not implemented")
+}
+func (UnimplementedManagerServer) ListModelArtifacts(context.Context, *ListModelArtifactsRequest) (*ListModelArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModelArtifacts not implemented")
+}
 func (UnimplementedManagerServer) KeepAlive(Manager_KeepAliveServer) error {
 	return status.Errorf(codes.Unimplemented, "method KeepAlive not implemented")
 }
@@ -418,6 +644,78 @@ func _Manager_ListApplications_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_AuthorizeApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).AuthorizeApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/AuthorizeApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).AuthorizeApplication(ctx, req.(*AuthorizeApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_ListDownloadTokenKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownloadTokenKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListDownloadTokenKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/ListDownloadTokenKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListDownloadTokenKeys(ctx, req.(*ListDownloadTokenKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetDownloadTokenSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTokenSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetDownloadTokenSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/GetDownloadTokenSigningKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetDownloadTokenSigningKey(ctx, req.(*GetDownloadTokenSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_CreateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateModelRequest)
 	if err := dec(in); err != nil {
@@ -436,6 +734,158 @@ func _Manager_CreateModel_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/ListModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListModels(ctx, req.(*ListModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/GetModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetModel(ctx, req.(*GetModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_ActivateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ActivateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/ActivateModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ActivateModel(ctx, req.(*ActivateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RollbackModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RollbackModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/RollbackModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RollbackModel(ctx, req.(*RollbackModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_EvaluateModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).EvaluateModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/EvaluateModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).EvaluateModel(ctx, req.(*EvaluateModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_ReportOnlineEvaluation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagerServer).ReportOnlineEvaluation(&managerReportOnlineEvaluationServer{stream})
+}
+
+type Manager_ReportOnlineEvaluationServer interface {
+	Send(*ReportOnlineEvaluationResponse) error
+	Recv() (*ReportOnlineEvaluationRequest, error)
+	grpc.ServerStream
+}
+
+type managerReportOnlineEvaluationServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerReportOnlineEvaluationServer) Send(m *ReportOnlineEvaluationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *managerReportOnlineEvaluationServer) Recv() (*ReportOnlineEvaluationRequest, error) {
+	m := new(ReportOnlineEvaluationRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Manager_GetModelArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetModelArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/GetModelArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetModelArtifact(ctx, req.(*GetModelArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_ListModelArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListModelArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.v2.Manager/ListModelArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListModelArtifacts(ctx, req.(*ListModelArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_KeepAlive_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ManagerServer).KeepAlive(&managerKeepAliveServer{stream})
 }
@@ -505,12 +955,62 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListApplications",
 			Handler:    _Manager_ListApplications_Handler,
 		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Manager_GetQuota_Handler,
+		},
+		{
+			MethodName: "AuthorizeApplication",
+			Handler:    _Manager_AuthorizeApplication_Handler,
+		},
+		{
+			MethodName: "ListDownloadTokenKeys",
+			Handler:    _Manager_ListDownloadTokenKeys_Handler,
+		},
+		{
+			MethodName: "GetDownloadTokenSigningKey",
+			Handler:    _Manager_GetDownloadTokenSigningKey_Handler,
+		},
 		{
 			MethodName: "CreateModel",
 			Handler:    _Manager_CreateModel_Handler,
 		},
+		{
+			MethodName: "ListModels",
+			Handler:    _Manager_ListModels_Handler,
+		},
+		{
+			MethodName: "GetModel",
+			Handler:    _Manager_GetModel_Handler,
+		},
+		{
+			MethodName: "ActivateModel",
+			Handler:    _Manager_ActivateModel_Handler,
+		},
+		{
+			MethodName: "RollbackModel",
+			Handler:    _Manager_RollbackModel_Handler,
+		},
+		{
+			MethodName: "EvaluateModel",
+			Handler:    _Manager_EvaluateModel_Handler,
+		},
+		{
+			MethodName: "GetModelArtifact",
+			Handler:    _Manager_GetModelArtifact_Handler,
+		},
+		{
+			MethodName: "ListModelArtifacts",
+			Handler:    _Manager_ListModelArtifacts_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportOnlineEvaluation",
+			Handler:       _Manager_ReportOnlineEvaluation_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "KeepAlive",
 			Handler:       _Manager_KeepAlive_Handler,
@@ -38,6 +38,46 @@ func (m *MockManagerClient) EXPECT() *MockManagerClientMockRecorder {
 	return m.recorder
 }
 
+// ActivateModel mocks base method.
+func (m *MockManagerClient) ActivateModel(ctx context.Context, in *manager.ActivateModelRequest, opts ...grpc.CallOption) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActivateModel", varargs...)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateModel indicates an expected call of ActivateModel.
+func (mr *MockManagerClientMockRecorder) ActivateModel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateModel", reflect.TypeOf((*MockManagerClient)(nil).ActivateModel), varargs...)
+}
+
+// AuthorizeApplication mocks base method.
+func (m *MockManagerClient) AuthorizeApplication(ctx context.Context, in *manager.AuthorizeApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthorizeApplication", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeApplication indicates an expected call of AuthorizeApplication.
+func (mr *MockManagerClientMockRecorder) AuthorizeApplication(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeApplication", reflect.TypeOf((*MockManagerClient)(nil).AuthorizeApplication), varargs...)
+}
+
 // CreateModel mocks base method.
 func (m *MockManagerClient) CreateModel(ctx context.Context, in *manager.CreateModelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +118,86 @@ func (mr *MockManagerClientMockRecorder) DeleteSeedPeer(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeedPeer", reflect.TypeOf((*MockManagerClient)(nil).DeleteSeedPeer), varargs...)
 }
 
+// EvaluateModel mocks base method.
+func (m *MockManagerClient) EvaluateModel(ctx context.Context, in *manager.EvaluateModelRequest, opts ...grpc.CallOption) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateModel", varargs...)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateModel indicates an expected call of EvaluateModel.
+func (mr *MockManagerClientMockRecorder) EvaluateModel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateModel", reflect.TypeOf((*MockManagerClient)(nil).EvaluateModel), varargs...)
+}
+
+// GetDownloadTokenSigningKey mocks base method.
+func (m *MockManagerClient) GetDownloadTokenSigningKey(ctx context.Context, in *manager.GetDownloadTokenSigningKeyRequest, opts ...grpc.CallOption) (*manager.DownloadTokenSigningKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDownloadTokenSigningKey", varargs...)
+	ret0, _ := ret[0].(*manager.DownloadTokenSigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadTokenSigningKey indicates an expected call of GetDownloadTokenSigningKey.
+func (mr *MockManagerClientMockRecorder) GetDownloadTokenSigningKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadTokenSigningKey", reflect.TypeOf((*MockManagerClient)(nil).GetDownloadTokenSigningKey), varargs...)
+}
+
+// GetModel mocks base method.
+func (m *MockManagerClient) GetModel(ctx context.Context, in *manager.GetModelRequest, opts ...grpc.CallOption) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetModel", varargs...)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModel indicates an expected call of GetModel.
+func (mr *MockManagerClientMockRecorder) GetModel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModel", reflect.TypeOf((*MockManagerClient)(nil).GetModel), varargs...)
+}
+
+// GetModelArtifact mocks base method.
+func (m *MockManagerClient) GetModelArtifact(ctx context.Context, in *manager.GetModelArtifactRequest, opts ...grpc.CallOption) (*manager.ModelArtifact, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetModelArtifact", varargs...)
+	ret0, _ := ret[0].(*manager.ModelArtifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelArtifact indicates an expected call of GetModelArtifact.
+func (mr *MockManagerClientMockRecorder) GetModelArtifact(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelArtifact", reflect.TypeOf((*MockManagerClient)(nil).GetModelArtifact), varargs...)
+}
+
 // GetObjectStorage mocks base method.
 func (m *MockManagerClient) GetObjectStorage(ctx context.Context, in *manager.GetObjectStorageRequest, opts ...grpc.CallOption) (*manager.ObjectStorage, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +218,26 @@ func (mr *MockManagerClientMockRecorder) GetObjectStorage(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStorage", reflect.TypeOf((*MockManagerClient)(nil).GetObjectStorage), varargs...)
 }
 
+// GetQuota mocks base method.
+func (m *MockManagerClient) GetQuota(ctx context.Context, in *manager.GetQuotaRequest, opts ...grpc.CallOption) (*manager.ApplicationQuota, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetQuota", varargs...)
+	ret0, _ := ret[0].(*manager.ApplicationQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockManagerClientMockRecorder) GetQuota(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockManagerClient)(nil).GetQuota), varargs...)
+}
+
 // GetScheduler mocks base method.
 func (m *MockManagerClient) GetScheduler(ctx context.Context, in *manager.GetSchedulerRequest, opts ...grpc.CallOption) (*manager.Scheduler, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +338,66 @@ func (mr *MockManagerClientMockRecorder) ListBuckets(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockManagerClient)(nil).ListBuckets), varargs...)
 }
 
+// ListDownloadTokenKeys mocks base method.
+func (m *MockManagerClient) ListDownloadTokenKeys(ctx context.Context, in *manager.ListDownloadTokenKeysRequest, opts ...grpc.CallOption) (*manager.ListDownloadTokenKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDownloadTokenKeys", varargs...)
+	ret0, _ := ret[0].(*manager.ListDownloadTokenKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDownloadTokenKeys indicates an expected call of ListDownloadTokenKeys.
+func (mr *MockManagerClientMockRecorder) ListDownloadTokenKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDownloadTokenKeys", reflect.TypeOf((*MockManagerClient)(nil).ListDownloadTokenKeys), varargs...)
+}
+
+// ListModelArtifacts mocks base method.
+func (m *MockManagerClient) ListModelArtifacts(ctx context.Context, in *manager.ListModelArtifactsRequest, opts ...grpc.CallOption) (*manager.ListModelArtifactsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListModelArtifacts", varargs...)
+	ret0, _ := ret[0].(*manager.ListModelArtifactsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModelArtifacts indicates an expected call of ListModelArtifacts.
+func (mr *MockManagerClientMockRecorder) ListModelArtifacts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelArtifacts", reflect.TypeOf((*MockManagerClient)(nil).ListModelArtifacts), varargs...)
+}
+
+// ListModels mocks base method.
+func (m *MockManagerClient) ListModels(ctx context.Context, in *manager.ListModelsRequest, opts ...grpc.CallOption) (*manager.ListModelsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListModels", varargs...)
+	ret0, _ := ret[0].(*manager.ListModelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockManagerClientMockRecorder) ListModels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockManagerClient)(nil).ListModels), varargs...)
+}
+
 // ListSchedulers mocks base method.
 func (m *MockManagerClient) ListSchedulers(ctx context.Context, in *manager.ListSchedulersRequest, opts ...grpc.CallOption) (*manager.ListSchedulersResponse, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +418,46 @@ func (mr *MockManagerClientMockRecorder) ListSchedulers(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedulers", reflect.TypeOf((*MockManagerClient)(nil).ListSchedulers), varargs...)
 }
 
+// ReportOnlineEvaluation mocks base method.
+func (m *MockManagerClient) ReportOnlineEvaluation(ctx context.Context, opts ...grpc.CallOption) (manager.Manager_ReportOnlineEvaluationClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportOnlineEvaluation", varargs...)
+	ret0, _ := ret[0].(manager.Manager_ReportOnlineEvaluationClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportOnlineEvaluation indicates an expected call of ReportOnlineEvaluation.
+func (mr *MockManagerClientMockRecorder) ReportOnlineEvaluation(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportOnlineEvaluation", reflect.TypeOf((*MockManagerClient)(nil).ReportOnlineEvaluation), varargs...)
+}
+
+// RollbackModel mocks base method.
+func (m *MockManagerClient) RollbackModel(ctx context.Context, in *manager.RollbackModelRequest, opts ...grpc.CallOption) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackModel", varargs...)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackModel indicates an expected call of RollbackModel.
+func (mr *MockManagerClientMockRecorder) RollbackModel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackModel", reflect.TypeOf((*MockManagerClient)(nil).RollbackModel), varargs...)
+}
+
 // UpdateScheduler mocks base method.
 func (m *MockManagerClient) UpdateScheduler(ctx context.Context, in *manager.UpdateSchedulerRequest, opts ...grpc.CallOption) (*manager.Scheduler, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +498,143 @@ func (mr *MockManagerClientMockRecorder) UpdateSeedPeer(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeedPeer", reflect.TypeOf((*MockManagerClient)(nil).UpdateSeedPeer), varargs...)
 }
 
+// MockManager_ReportOnlineEvaluationClient is a mock of Manager_ReportOnlineEvaluationClient interface.
+type MockManager_ReportOnlineEvaluationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockManager_ReportOnlineEvaluationClientMockRecorder
+}
+
+// MockManager_ReportOnlineEvaluationClientMockRecorder is the mock recorder for MockManager_ReportOnlineEvaluationClient.
+type MockManager_ReportOnlineEvaluationClientMockRecorder struct {
+	mock *MockManager_ReportOnlineEvaluationClient
+}
+
+// NewMockManager_ReportOnlineEvaluationClient creates a new mock instance.
+func NewMockManager_ReportOnlineEvaluationClient(ctrl *gomock.Controller) *MockManager_ReportOnlineEvaluationClient {
+	mock := &MockManager_ReportOnlineEvaluationClient{ctrl: ctrl}
+	mock.recorder = &MockManager_ReportOnlineEvaluationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManager_ReportOnlineEvaluationClient) EXPECT() *MockManager_ReportOnlineEvaluationClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) Recv() (*manager.ReportOnlineEvaluationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*manager.ReportOnlineEvaluationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockManager_ReportOnlineEvaluationClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) Send(arg0 *manager.ReportOnlineEvaluationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockManager_ReportOnlineEvaluationClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockManager_ReportOnlineEvaluationClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockManager_ReportOnlineEvaluationClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockManager_ReportOnlineEvaluationClient)(nil).Trailer))
+}
+
 // MockManager_KeepAliveClient is a mock of Manager_KeepAliveClient interface.
 type MockManager_KeepAliveClient struct {
 	ctrl     *gomock.Controller
@@ -418,6 +795,36 @@ func (m *MockManagerServer) EXPECT() *MockManagerServerMockRecorder {
 	return m.recorder
 }
 
+// ActivateModel mocks base method.
+func (m *MockManagerServer) ActivateModel(arg0 context.Context, arg1 *manager.ActivateModelRequest) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateModel", arg0, arg1)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateModel indicates an expected call of ActivateModel.
+func (mr *MockManagerServerMockRecorder) ActivateModel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateModel", reflect.TypeOf((*MockManagerServer)(nil).ActivateModel), arg0, arg1)
+}
+
+// AuthorizeApplication mocks base method.
+func (m *MockManagerServer) AuthorizeApplication(arg0 context.Context, arg1 *manager.AuthorizeApplicationRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeApplication", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeApplication indicates an expected call of AuthorizeApplication.
+func (mr *MockManagerServerMockRecorder) AuthorizeApplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeApplication", reflect.TypeOf((*MockManagerServer)(nil).AuthorizeApplication), arg0, arg1)
+}
+
 // CreateModel mocks base method.
 func (m *MockManagerServer) CreateModel(arg0 context.Context, arg1 *manager.CreateModelRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -448,6 +855,66 @@ func (mr *MockManagerServerMockRecorder) DeleteSeedPeer(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeedPeer", reflect.TypeOf((*MockManagerServer)(nil).DeleteSeedPeer), arg0, arg1)
 }
 
+// EvaluateModel mocks base method.
+func (m *MockManagerServer) EvaluateModel(arg0 context.Context, arg1 *manager.EvaluateModelRequest) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateModel", arg0, arg1)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateModel indicates an expected call of EvaluateModel.
+func (mr *MockManagerServerMockRecorder) EvaluateModel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateModel", reflect.TypeOf((*MockManagerServer)(nil).EvaluateModel), arg0, arg1)
+}
+
+// GetDownloadTokenSigningKey mocks base method.
+func (m *MockManagerServer) GetDownloadTokenSigningKey(arg0 context.Context, arg1 *manager.GetDownloadTokenSigningKeyRequest) (*manager.DownloadTokenSigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDownloadTokenSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*manager.DownloadTokenSigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadTokenSigningKey indicates an expected call of GetDownloadTokenSigningKey.
+func (mr *MockManagerServerMockRecorder) GetDownloadTokenSigningKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadTokenSigningKey", reflect.TypeOf((*MockManagerServer)(nil).GetDownloadTokenSigningKey), arg0, arg1)
+}
+
+// GetModel mocks base method.
+func (m *MockManagerServer) GetModel(arg0 context.Context, arg1 *manager.GetModelRequest) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModel", arg0, arg1)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModel indicates an expected call of GetModel.
+func (mr *MockManagerServerMockRecorder) GetModel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModel", reflect.TypeOf((*MockManagerServer)(nil).GetModel), arg0, arg1)
+}
+
+// GetModelArtifact mocks base method.
+func (m *MockManagerServer) GetModelArtifact(arg0 context.Context, arg1 *manager.GetModelArtifactRequest) (*manager.ModelArtifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelArtifact", arg0, arg1)
+	ret0, _ := ret[0].(*manager.ModelArtifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelArtifact indicates an expected call of GetModelArtifact.
+func (mr *MockManagerServerMockRecorder) GetModelArtifact(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelArtifact", reflect.TypeOf((*MockManagerServer)(nil).GetModelArtifact), arg0, arg1)
+}
+
 // GetObjectStorage mocks base method.
 func (m *MockManagerServer) GetObjectStorage(arg0 context.Context, arg1 *manager.GetObjectStorageRequest) (*manager.ObjectStorage, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +930,21 @@ func (mr *MockManagerServerMockRecorder) GetObjectStorage(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStorage", reflect.TypeOf((*MockManagerServer)(nil).GetObjectStorage), arg0, arg1)
 }
 
+// GetQuota mocks base method.
+func (m *MockManagerServer) GetQuota(arg0 context.Context, arg1 *manager.GetQuotaRequest) (*manager.ApplicationQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", arg0, arg1)
+	ret0, _ := ret[0].(*manager.ApplicationQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockManagerServerMockRecorder) GetQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockManagerServer)(nil).GetQuota), arg0, arg1)
+}
+
 // GetScheduler mocks base method.
 func (m *MockManagerServer) GetScheduler(arg0 context.Context, arg1 *manager.GetSchedulerRequest) (*manager.Scheduler, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +1019,51 @@ func (mr *MockManagerServerMockRecorder) ListBuckets(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockManagerServer)(nil).ListBuckets), arg0, arg1)
 }
 
+// ListDownloadTokenKeys mocks base method.
+func (m *MockManagerServer) ListDownloadTokenKeys(arg0 context.Context, arg1 *manager.ListDownloadTokenKeysRequest) (*manager.ListDownloadTokenKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDownloadTokenKeys", arg0, arg1)
+	ret0, _ := ret[0].(*manager.ListDownloadTokenKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDownloadTokenKeys indicates an expected call of ListDownloadTokenKeys.
+func (mr *MockManagerServerMockRecorder) ListDownloadTokenKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDownloadTokenKeys", reflect.TypeOf((*MockManagerServer)(nil).ListDownloadTokenKeys), arg0, arg1)
+}
+
+// ListModelArtifacts mocks base method.
+func (m *MockManagerServer) ListModelArtifacts(arg0 context.Context, arg1 *manager.ListModelArtifactsRequest) (*manager.ListModelArtifactsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModelArtifacts", arg0, arg1)
+	ret0, _ := ret[0].(*manager.ListModelArtifactsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModelArtifacts indicates an expected call of ListModelArtifacts.
+func (mr *MockManagerServerMockRecorder) ListModelArtifacts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelArtifacts", reflect.TypeOf((*MockManagerServer)(nil).ListModelArtifacts), arg0, arg1)
+}
+
+// ListModels mocks base method.
+func (m *MockManagerServer) ListModels(arg0 context.Context, arg1 *manager.ListModelsRequest) (*manager.ListModelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModels", arg0, arg1)
+	ret0, _ := ret[0].(*manager.ListModelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockManagerServerMockRecorder) ListModels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockManagerServer)(nil).ListModels), arg0, arg1)
+}
+
 // ListSchedulers mocks base method.
 func (m *MockManagerServer) ListSchedulers(arg0 context.Context, arg1 *manager.ListSchedulersRequest) (*manager.ListSchedulersResponse, error) {
 	m.ctrl.T.Helper()
@@ -552,6 +1079,35 @@ func (mr *MockManagerServerMockRecorder) ListSchedulers(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedulers", reflect.TypeOf((*MockManagerServer)(nil).ListSchedulers), arg0, arg1)
 }
 
+// ReportOnlineEvaluation mocks base method.
+func (m *MockManagerServer) ReportOnlineEvaluation(arg0 manager.Manager_ReportOnlineEvaluationServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportOnlineEvaluation", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportOnlineEvaluation indicates an expected call of ReportOnlineEvaluation.
+func (mr *MockManagerServerMockRecorder) ReportOnlineEvaluation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportOnlineEvaluation", reflect.TypeOf((*MockManagerServer)(nil).ReportOnlineEvaluation), arg0)
+}
+
+// RollbackModel mocks base method.
+func (m *MockManagerServer) RollbackModel(arg0 context.Context, arg1 *manager.RollbackModelRequest) (*manager.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackModel", arg0, arg1)
+	ret0, _ := ret[0].(*manager.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackModel indicates an expected call of RollbackModel.
+func (mr *MockManagerServerMockRecorder) RollbackModel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackModel", reflect.TypeOf((*MockManagerServer)(nil).RollbackModel), arg0, arg1)
+}
+
 // UpdateScheduler mocks base method.
 func (m *MockManagerServer) UpdateScheduler(arg0 context.Context, arg1 *manager.UpdateSchedulerRequest) (*manager.Scheduler, error) {
 	m.ctrl.T.Helper()
@@ -617,6 +1173,140 @@ func (mr *MockUnsafeManagerServerMockRecorder) mustEmbedUnimplementedManagerServ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedManagerServer", reflect.TypeOf((*MockUnsafeManagerServer)(nil).mustEmbedUnimplementedManagerServer))
 }
 
+// MockManager_ReportOnlineEvaluationServer is a mock of Manager_ReportOnlineEvaluationServer interface.
+type MockManager_ReportOnlineEvaluationServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockManager_ReportOnlineEvaluationServerMockRecorder
+}
+
+// MockManager_ReportOnlineEvaluationServerMockRecorder is the mock recorder for MockManager_ReportOnlineEvaluationServer.
+type MockManager_ReportOnlineEvaluationServerMockRecorder struct {
+	mock *MockManager_ReportOnlineEvaluationServer
+}
+
+// NewMockManager_ReportOnlineEvaluationServer creates a new mock instance.
+func NewMockManager_ReportOnlineEvaluationServer(ctrl *gomock.Controller) *MockManager_ReportOnlineEvaluationServer {
+	mock := &MockManager_ReportOnlineEvaluationServer{ctrl: ctrl}
+	mock.recorder = &MockManager_ReportOnlineEvaluationServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManager_ReportOnlineEvaluationServer) EXPECT() *MockManager_ReportOnlineEvaluationServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) Recv() (*manager.ReportOnlineEvaluationRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*manager.ReportOnlineEvaluationRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockManager_ReportOnlineEvaluationServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) Send(arg0 *manager.ReportOnlineEvaluationResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockManager_ReportOnlineEvaluationServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockManager_ReportOnlineEvaluationServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockManager_ReportOnlineEvaluationServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockManager_ReportOnlineEvaluationServer)(nil).SetTrailer), arg0)
+}
+
 // MockManager_KeepAliveServer is a mock of Manager_KeepAliveServer interface.
 type MockManager_KeepAliveServer struct {
 	ctrl     *gomock.Controller
@@ -139,6 +139,106 @@ func (mr *MockSchedulerClientMockRecorder) LeavePeer(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeavePeer", reflect.TypeOf((*MockSchedulerClient)(nil).LeavePeer), varargs...)
 }
 
+// ListHostPeers mocks base method.
+func (m *MockSchedulerClient) ListHostPeers(ctx context.Context, in *scheduler.ListHostPeersRequest, opts ...grpc.CallOption) (*scheduler.ListHostPeersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostPeers", varargs...)
+	ret0, _ := ret[0].(*scheduler.ListHostPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostPeers indicates an expected call of ListHostPeers.
+func (mr *MockSchedulerClientMockRecorder) ListHostPeers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostPeers", reflect.TypeOf((*MockSchedulerClient)(nil).ListHostPeers), varargs...)
+}
+
+// ListProbeEdges mocks base method.
+func (m *MockSchedulerClient) ListProbeEdges(ctx context.Context, in *scheduler.ListProbeEdgesRequest, opts ...grpc.CallOption) (*scheduler.ListProbeEdgesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProbeEdges", varargs...)
+	ret0, _ := ret[0].(*scheduler.ListProbeEdgesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProbeEdges indicates an expected call of ListProbeEdges.
+func (mr *MockSchedulerClientMockRecorder) ListProbeEdges(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProbeEdges", reflect.TypeOf((*MockSchedulerClient)(nil).ListProbeEdges), varargs...)
+}
+
+// ListProbes mocks base method.
+func (m *MockSchedulerClient) ListProbes(ctx context.Context, in *scheduler.ListProbesRequest, opts ...grpc.CallOption) (*scheduler.ListProbesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProbes", varargs...)
+	ret0, _ := ret[0].(*scheduler.ListProbesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProbes indicates an expected call of ListProbes.
+func (mr *MockSchedulerClientMockRecorder) ListProbes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProbes", reflect.TypeOf((*MockSchedulerClient)(nil).ListProbes), varargs...)
+}
+
+// ListTaskPeers mocks base method.
+func (m *MockSchedulerClient) ListTaskPeers(ctx context.Context, in *scheduler.ListTaskPeersRequest, opts ...grpc.CallOption) (*scheduler.ListTaskPeersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskPeers", varargs...)
+	ret0, _ := ret[0].(*scheduler.ListTaskPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskPeers indicates an expected call of ListTaskPeers.
+func (mr *MockSchedulerClientMockRecorder) ListTaskPeers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskPeers", reflect.TypeOf((*MockSchedulerClient)(nil).ListTaskPeers), varargs...)
+}
+
+// ListTasks mocks base method.
+func (m *MockSchedulerClient) ListTasks(ctx context.Context, in *scheduler.ListTasksRequest, opts ...grpc.CallOption) (*scheduler.ListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTasks", varargs...)
+	ret0, _ := ret[0].(*scheduler.ListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockSchedulerClientMockRecorder) ListTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockSchedulerClient)(nil).ListTasks), varargs...)
+}
+
 // StatPeer mocks base method.
 func (m *MockSchedulerClient) StatPeer(ctx context.Context, in *scheduler.StatPeerRequest, opts ...grpc.CallOption) (*common.Peer, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +259,26 @@ func (mr *MockSchedulerClientMockRecorder) StatPeer(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatPeer", reflect.TypeOf((*MockSchedulerClient)(nil).StatPeer), varargs...)
 }
 
+// StatProbe mocks base method.
+func (m *MockSchedulerClient) StatProbe(ctx context.Context, in *scheduler.StatProbeRequest, opts ...grpc.CallOption) (*scheduler.Probe, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StatProbe", varargs...)
+	ret0, _ := ret[0].(*scheduler.Probe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatProbe indicates an expected call of StatProbe.
+func (mr *MockSchedulerClientMockRecorder) StatProbe(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatProbe", reflect.TypeOf((*MockSchedulerClient)(nil).StatProbe), varargs...)
+}
+
 // StatTask mocks base method.
 func (m *MockSchedulerClient) StatTask(ctx context.Context, in *scheduler.StatTaskRequest, opts ...grpc.CallOption) (*common.Task, error) {
 	m.ctrl.T.Helper()
@@ -570,6 +690,81 @@ func (mr *MockSchedulerServerMockRecorder) LeavePeer(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeavePeer", reflect.TypeOf((*MockSchedulerServer)(nil).LeavePeer), arg0, arg1)
 }
 
+// ListHostPeers mocks base method.
+func (m *MockSchedulerServer) ListHostPeers(arg0 context.Context, arg1 *scheduler.ListHostPeersRequest) (*scheduler.ListHostPeersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostPeers", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.ListHostPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostPeers indicates an expected call of ListHostPeers.
+func (mr *MockSchedulerServerMockRecorder) ListHostPeers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostPeers", reflect.TypeOf((*MockSchedulerServer)(nil).ListHostPeers), arg0, arg1)
+}
+
+// ListProbeEdges mocks base method.
+func (m *MockSchedulerServer) ListProbeEdges(arg0 context.Context, arg1 *scheduler.ListProbeEdgesRequest) (*scheduler.ListProbeEdgesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProbeEdges", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.ListProbeEdgesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProbeEdges indicates an expected call of ListProbeEdges.
+func (mr *MockSchedulerServerMockRecorder) ListProbeEdges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProbeEdges", reflect.TypeOf((*MockSchedulerServer)(nil).ListProbeEdges), arg0, arg1)
+}
+
+// ListProbes mocks base method.
+func (m *MockSchedulerServer) ListProbes(arg0 context.Context, arg1 *scheduler.ListProbesRequest) (*scheduler.ListProbesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProbes", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.ListProbesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProbes indicates an expected call of ListProbes.
+func (mr *MockSchedulerServerMockRecorder) ListProbes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProbes", reflect.TypeOf((*MockSchedulerServer)(nil).ListProbes), arg0, arg1)
+}
+
+// ListTaskPeers mocks base method.
+func (m *MockSchedulerServer) ListTaskPeers(arg0 context.Context, arg1 *scheduler.ListTaskPeersRequest) (*scheduler.ListTaskPeersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskPeers", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.ListTaskPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskPeers indicates an expected call of ListTaskPeers.
+func (mr *MockSchedulerServerMockRecorder) ListTaskPeers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskPeers", reflect.TypeOf((*MockSchedulerServer)(nil).ListTaskPeers), arg0, arg1)
+}
+
+// ListTasks mocks base method.
+func (m *MockSchedulerServer) ListTasks(arg0 context.Context, arg1 *scheduler.ListTasksRequest) (*scheduler.ListTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.ListTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockSchedulerServerMockRecorder) ListTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockSchedulerServer)(nil).ListTasks), arg0, arg1)
+}
+
 // StatPeer mocks base method.
 func (m *MockSchedulerServer) StatPeer(arg0 context.Context, arg1 *scheduler.StatPeerRequest) (*common.Peer, error) {
 	m.ctrl.T.Helper()
@@ -585,6 +780,21 @@ func (mr *MockSchedulerServerMockRecorder) StatPeer(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatPeer", reflect.TypeOf((*MockSchedulerServer)(nil).StatPeer), arg0, arg1)
 }
 
+// StatProbe mocks base method.
+func (m *MockSchedulerServer) StatProbe(arg0 context.Context, arg1 *scheduler.StatProbeRequest) (*scheduler.Probe, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatProbe", arg0, arg1)
+	ret0, _ := ret[0].(*scheduler.Probe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatProbe indicates an expected call of StatProbe.
+func (mr *MockSchedulerServerMockRecorder) StatProbe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatProbe", reflect.TypeOf((*MockSchedulerServer)(nil).StatProbe), arg0, arg1)
+}
+
 // StatTask mocks base method.
 func (m *MockSchedulerServer) StatTask(arg0 context.Context, arg1 *scheduler.StatTaskRequest) (*common.Task, error) {
 	m.ctrl.T.Helper()
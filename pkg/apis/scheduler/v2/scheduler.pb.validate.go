@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	common "d7y.io/api/v2/pkg/apis/common/v2"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = common.PieceOrder(0)
 )
 
 // Validate checks the field values on RegisterPeerRequest with the rules
@@ -582,6 +586,35 @@ func (m *DownloadPeerFinishedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadPeerFinishedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadPeerFinishedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadPeerFinishedRequestValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadPeerFinishedRequestMultiError(errors)
 	}
@@ -709,6 +742,35 @@ func (m *DownloadPeerBackToSourceFinishedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadPeerBackToSourceFinishedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadPeerBackToSourceFinishedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadPeerBackToSourceFinishedRequestValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadPeerBackToSourceFinishedRequestMultiError(errors)
 	}
@@ -824,6 +886,35 @@ func (m *DownloadPeerFailedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadPeerFailedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadPeerFailedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadPeerFailedRequestValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadPeerFailedRequestMultiError(errors)
 	}
@@ -938,6 +1029,35 @@ func (m *DownloadPeerBackToSourceFailedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetStatistics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadPeerBackToSourceFailedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadPeerBackToSourceFailedRequestValidationError{
+					field:  "Statistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatistics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadPeerBackToSourceFailedRequestValidationError{
+				field:  "Statistics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadPeerBackToSourceFailedRequestMultiError(errors)
 	}
@@ -2373,6 +2493,35 @@ func (m *AnnouncePeerRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetTraceContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnouncePeerRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnouncePeerRequestValidationError{
+					field:  "TraceContext",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTraceContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnouncePeerRequestValidationError{
+				field:  "TraceContext",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	oneofRequestPresent := false
 	switch v := m.Request.(type) {
 	case *AnnouncePeerRequest_RegisterPeerRequest:
@@ -3290,6 +3439,35 @@ func (m *SmallTaskResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDownloadToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SmallTaskResponseValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SmallTaskResponseValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SmallTaskResponseValidationError{
+				field:  "DownloadToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SmallTaskResponseMultiError(errors)
 	}
@@ -3370,6 +3548,117 @@ var _ interface {
 	ErrorName() string
 } = SmallTaskResponseValidationError{}
 
+// Validate checks the field values on PieceOrderHint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PieceOrderHint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PieceOrderHint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PieceOrderHintMultiError,
+// or nil if none found.
+func (m *PieceOrderHint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PieceOrderHint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := common.PieceOrder_name[int32(m.GetOrder())]; !ok {
+		err := PieceOrderHintValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PieceOrderHintMultiError(errors)
+	}
+
+	return nil
+}
+
+// PieceOrderHintMultiError is an error wrapping multiple validation errors
+// returned by PieceOrderHint.ValidateAll() if the designated constraints
+// aren't met.
+type PieceOrderHintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PieceOrderHintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PieceOrderHintMultiError) AllErrors() []error { return m }
+
+// PieceOrderHintValidationError is the validation error returned by
+// PieceOrderHint.Validate if the designated constraints aren't met.
+type PieceOrderHintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PieceOrderHintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PieceOrderHintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PieceOrderHintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PieceOrderHintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PieceOrderHintValidationError) ErrorName() string { return "PieceOrderHintValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PieceOrderHintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPieceOrderHint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PieceOrderHintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PieceOrderHintValidationError{}
+
 // Validate checks the field values on NormalTaskResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3448,6 +3737,64 @@ func (m *NormalTaskResponse) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetPieceOrderHint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NormalTaskResponseValidationError{
+					field:  "PieceOrderHint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NormalTaskResponseValidationError{
+					field:  "PieceOrderHint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPieceOrderHint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NormalTaskResponseValidationError{
+				field:  "PieceOrderHint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDownloadToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NormalTaskResponseValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NormalTaskResponseValidationError{
+					field:  "DownloadToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NormalTaskResponseValidationError{
+				field:  "DownloadToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return NormalTaskResponseMultiError(errors)
 	}
@@ -4024,7 +4371,36 @@ func (m *StatPeerRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatPeerRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatPeerRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatPeerRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
 		return StatPeerRequestMultiError(errors)
 	}
 
@@ -4505,6 +4881,35 @@ func (m *StatTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatTaskRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatTaskRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return StatTaskRequestMultiError(errors)
 	}
@@ -5021,6 +5426,117 @@ func (m *Probe) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetMinRtt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "MinRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "MinRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinRtt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "MinRtt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxRtt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "MaxRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "MaxRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxRtt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "MaxRtt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStddevRtt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "StddevRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "StddevRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStddevRtt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "StddevRtt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLossRate(); val < 0 || val > 1 {
+		err := ProbeValidationError{
+			field:  "LossRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Throughput
+
+	if _, ok := ProbeProtocol_name[int32(m.GetProtocol())]; !ok {
+		err := ProbeValidationError{
+			field:  "Protocol",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ProbeMultiError(errors)
 	}
@@ -5897,6 +6413,61 @@ func (m *SyncProbesResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetProbeInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncProbesResponseValidationError{
+					field:  "ProbeInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncProbesResponseValidationError{
+					field:  "ProbeInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProbeInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncProbesResponseValidationError{
+				field:  "ProbeInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetProbeCount() != 0 {
+
+		if m.GetProbeCount() < 1 {
+			err := SyncProbesResponseValidationError{
+				field:  "ProbeCount",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := ProbeProtocol_name[int32(m.GetProbeProtocol())]; !ok {
+		err := SyncProbesResponseValidationError{
+			field:  "ProbeProtocol",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return SyncProbesResponseMultiError(errors)
 	}
@@ -5976,3 +6547,1666 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SyncProbesResponseValidationError{}
+
+// Validate checks the field values on ListProbesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListProbesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProbesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProbesRequestMultiError, or nil if none found.
+func (m *ListProbesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProbesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		err := ListProbesRequestValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListProbesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProbesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListProbesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListProbesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProbesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProbesRequestMultiError) AllErrors() []error { return m }
+
+// ListProbesRequestValidationError is the validation error returned by
+// ListProbesRequest.Validate if the designated constraints aren't met.
+type ListProbesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProbesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProbesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProbesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProbesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProbesRequestValidationError) ErrorName() string {
+	return "ListProbesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProbesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProbesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProbesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProbesRequestValidationError{}
+
+// Validate checks the field values on ListProbesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProbesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProbesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProbesResponseMultiError, or nil if none found.
+func (m *ListProbesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProbesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProbes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProbesResponseValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProbesResponseValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProbesResponseValidationError{
+					field:  fmt.Sprintf("Probes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProbesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProbesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListProbesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListProbesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProbesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProbesResponseMultiError) AllErrors() []error { return m }
+
+// ListProbesResponseValidationError is the validation error returned by
+// ListProbesResponse.Validate if the designated constraints aren't met.
+type ListProbesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProbesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProbesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProbesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProbesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProbesResponseValidationError) ErrorName() string {
+	return "ListProbesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProbesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProbesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProbesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProbesResponseValidationError{}
+
+// Validate checks the field values on StatProbeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatProbeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatProbeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatProbeRequestMultiError, or nil if none found.
+func (m *StatProbeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatProbeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSrcHostId()) < 1 {
+		err := StatProbeRequestValidationError{
+			field:  "SrcHostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestHostId()) < 1 {
+		err := StatProbeRequestValidationError{
+			field:  "DestHostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StatProbeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatProbeRequestMultiError is an error wrapping multiple validation errors
+// returned by StatProbeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StatProbeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatProbeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatProbeRequestMultiError) AllErrors() []error { return m }
+
+// StatProbeRequestValidationError is the validation error returned by
+// StatProbeRequest.Validate if the designated constraints aren't met.
+type StatProbeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatProbeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatProbeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatProbeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatProbeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatProbeRequestValidationError) ErrorName() string { return "StatProbeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatProbeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatProbeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatProbeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatProbeRequestValidationError{}
+
+// Validate checks the field values on ProbeEdge with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProbeEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProbeEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProbeEdgeMultiError, or nil
+// if none found.
+func (m *ProbeEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProbeEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSrcHostId()) < 1 {
+		err := ProbeEdgeValidationError{
+			field:  "SrcHostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestHostId()) < 1 {
+		err := ProbeEdgeValidationError{
+			field:  "DestHostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRtt() == nil {
+		err := ProbeEdgeValidationError{
+			field:  "Rtt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLossRate(); val < 0 || val > 1 {
+		err := ProbeEdgeValidationError{
+			field:  "LossRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Throughput
+
+	if m.GetProbeCount() < 0 {
+		err := ProbeEdgeValidationError{
+			field:  "ProbeCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := ProbeEdgeValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() == nil {
+		err := ProbeEdgeValidationError{
+			field:  "UpdatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProbeEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProbeEdgeMultiError is an error wrapping multiple validation errors returned
+// by ProbeEdge.ValidateAll() if the designated constraints aren't met.
+type ProbeEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProbeEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProbeEdgeMultiError) AllErrors() []error { return m }
+
+// ProbeEdgeValidationError is the validation error returned by
+// ProbeEdge.Validate if the designated constraints aren't met.
+type ProbeEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProbeEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProbeEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProbeEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProbeEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProbeEdgeValidationError) ErrorName() string { return "ProbeEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProbeEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProbeEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProbeEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProbeEdgeValidationError{}
+
+// Validate checks the field values on ListProbeEdgesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProbeEdgesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProbeEdgesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProbeEdgesRequestMultiError, or nil if none found.
+func (m *ListProbeEdgesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProbeEdgesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListProbeEdgesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProbeEdgesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProbeEdgesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProbeEdgesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProbeEdgesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProbeEdgesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProbeEdgesRequestMultiError) AllErrors() []error { return m }
+
+// ListProbeEdgesRequestValidationError is the validation error returned by
+// ListProbeEdgesRequest.Validate if the designated constraints aren't met.
+type ListProbeEdgesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProbeEdgesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProbeEdgesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProbeEdgesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProbeEdgesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProbeEdgesRequestValidationError) ErrorName() string {
+	return "ListProbeEdgesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProbeEdgesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProbeEdgesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProbeEdgesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProbeEdgesRequestValidationError{}
+
+// Validate checks the field values on ListProbeEdgesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProbeEdgesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProbeEdgesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProbeEdgesResponseMultiError, or nil if none found.
+func (m *ListProbeEdgesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProbeEdgesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProbeEdgesResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProbeEdgesResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProbeEdgesResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProbeEdgesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProbeEdgesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProbeEdgesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProbeEdgesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProbeEdgesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProbeEdgesResponseMultiError) AllErrors() []error { return m }
+
+// ListProbeEdgesResponseValidationError is the validation error returned by
+// ListProbeEdgesResponse.Validate if the designated constraints aren't met.
+type ListProbeEdgesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProbeEdgesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProbeEdgesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProbeEdgesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProbeEdgesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProbeEdgesResponseValidationError) ErrorName() string {
+	return "ListProbeEdgesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProbeEdgesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProbeEdgesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProbeEdgesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProbeEdgesResponseValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetStates()) > 0 {
+
+		for idx, item := range m.GetStates() {
+			_, _ = idx, item
+
+			if utf8.RuneCountInString(item) < 1 {
+				err := ListTasksRequestValidationError{
+					field:  fmt.Sprintf("States[%v]", idx),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	// no validation rules for IncludePieces
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListTasksRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string { return "ListTasksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+// Validate checks the field values on ListTasksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksResponseMultiError, or nil if none found.
+func (m *ListTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTasksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListTasksResponseValidationError is the validation error returned by
+// ListTasksResponse.Validate if the designated constraints aren't met.
+type ListTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksResponseValidationError) ErrorName() string {
+	return "ListTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksResponseValidationError{}
+
+// Validate checks the field values on ListTaskPeersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskPeersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskPeersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskPeersRequestMultiError, or nil if none found.
+func (m *ListTaskPeersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskPeersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := ListTaskPeersRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStates()) > 0 {
+
+		for idx, item := range m.GetStates() {
+			_, _ = idx, item
+
+			if utf8.RuneCountInString(item) < 1 {
+				err := ListTaskPeersRequestValidationError{
+					field:  fmt.Sprintf("States[%v]", idx),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	// no validation rules for IncludePieces
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListTaskPeersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTaskPeersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskPeersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaskPeersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskPeersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskPeersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskPeersRequestMultiError) AllErrors() []error { return m }
+
+// ListTaskPeersRequestValidationError is the validation error returned by
+// ListTaskPeersRequest.Validate if the designated constraints aren't met.
+type ListTaskPeersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskPeersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskPeersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskPeersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskPeersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskPeersRequestValidationError) ErrorName() string {
+	return "ListTaskPeersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskPeersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskPeersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskPeersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskPeersRequestValidationError{}
+
+// Validate checks the field values on ListTaskPeersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskPeersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskPeersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskPeersResponseMultiError, or nil if none found.
+func (m *ListTaskPeersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskPeersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPeers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskPeersResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskPeersResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskPeersResponseValidationError{
+					field:  fmt.Sprintf("Peers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTaskPeersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskPeersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaskPeersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskPeersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskPeersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskPeersResponseMultiError) AllErrors() []error { return m }
+
+// ListTaskPeersResponseValidationError is the validation error returned by
+// ListTaskPeersResponse.Validate if the designated constraints aren't met.
+type ListTaskPeersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskPeersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskPeersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskPeersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskPeersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskPeersResponseValidationError) ErrorName() string {
+	return "ListTaskPeersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskPeersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskPeersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskPeersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskPeersResponseValidationError{}
+
+// Validate checks the field values on ListHostPeersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHostPeersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHostPeersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHostPeersRequestMultiError, or nil if none found.
+func (m *ListHostPeersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHostPeersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostId()) < 1 {
+		err := ListHostPeersRequestValidationError{
+			field:  "HostId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStates()) > 0 {
+
+		for idx, item := range m.GetStates() {
+			_, _ = idx, item
+
+			if utf8.RuneCountInString(item) < 1 {
+				err := ListHostPeersRequestValidationError{
+					field:  fmt.Sprintf("States[%v]", idx),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	// no validation rules for IncludePieces
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListHostPeersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListHostPeersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHostPeersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListHostPeersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListHostPeersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHostPeersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHostPeersRequestMultiError) AllErrors() []error { return m }
+
+// ListHostPeersRequestValidationError is the validation error returned by
+// ListHostPeersRequest.Validate if the designated constraints aren't met.
+type ListHostPeersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHostPeersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHostPeersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHostPeersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHostPeersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHostPeersRequestValidationError) ErrorName() string {
+	return "ListHostPeersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHostPeersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHostPeersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHostPeersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHostPeersRequestValidationError{}
+
+// Validate checks the field values on ListHostPeersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHostPeersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHostPeersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHostPeersResponseMultiError, or nil if none found.
+func (m *ListHostPeersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHostPeersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPeers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHostPeersResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHostPeersResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHostPeersResponseValidationError{
+					field:  fmt.Sprintf("Peers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListHostPeersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHostPeersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListHostPeersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListHostPeersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHostPeersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHostPeersResponseMultiError) AllErrors() []error { return m }
+
+// ListHostPeersResponseValidationError is the validation error returned by
+// ListHostPeersResponse.Validate if the designated constraints aren't met.
+type ListHostPeersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHostPeersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHostPeersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHostPeersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHostPeersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHostPeersResponseValidationError) ErrorName() string {
+	return "ListHostPeersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHostPeersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHostPeersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHostPeersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHostPeersResponseValidationError{}
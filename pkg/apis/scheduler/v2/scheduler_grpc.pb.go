@@ -35,12 +35,24 @@ type SchedulerClient interface {
 	ExchangePeer(ctx context.Context, in *ExchangePeerRequest, opts ...grpc.CallOption) (*ExchangePeerResponse, error)
 	// Checks information of task.
 	StatTask(ctx context.Context, in *StatTaskRequest, opts ...grpc.CallOption) (*v2.Task, error)
+	// ListTasks lists tasks in scheduler by page.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// ListTaskPeers lists peers of the task by page.
+	ListTaskPeers(ctx context.Context, in *ListTaskPeersRequest, opts ...grpc.CallOption) (*ListTaskPeersResponse, error)
+	// ListHostPeers lists peers of the host by page.
+	ListHostPeers(ctx context.Context, in *ListHostPeersRequest, opts ...grpc.CallOption) (*ListHostPeersResponse, error)
 	// AnnounceHost announces host to scheduler.
 	AnnounceHost(ctx context.Context, in *AnnounceHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// LeaveHost releases host in scheduler.
 	LeaveHost(ctx context.Context, in *LeaveHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SyncProbes sync probes of the host.
 	SyncProbes(ctx context.Context, opts ...grpc.CallOption) (Scheduler_SyncProbesClient, error)
+	// ListProbes lists the latest probes of the neighbor hosts of the host.
+	ListProbes(ctx context.Context, in *ListProbesRequest, opts ...grpc.CallOption) (*ListProbesResponse, error)
+	// StatProbe checks the estimated probe between two hosts.
+	StatProbe(ctx context.Context, in *StatProbeRequest, opts ...grpc.CallOption) (*Probe, error)
+	// ListProbeEdges lists edges of the network topology graph by page.
+	ListProbeEdges(ctx context.Context, in *ListProbeEdgesRequest, opts ...grpc.CallOption) (*ListProbeEdgesResponse, error)
 }
 
 type schedulerClient struct {
@@ -118,6 +130,33 @@ func (c *schedulerClient) StatTask(ctx context.Context, in *StatTaskRequest, opt
 	return out, nil
 }
 
+func (c *schedulerClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/ListTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListTaskPeers(ctx context.Context, in *ListTaskPeersRequest, opts ...grpc.CallOption) (*ListTaskPeersResponse, error) {
+	out := new(ListTaskPeersResponse)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/ListTaskPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListHostPeers(ctx context.Context, in *ListHostPeersRequest, opts ...grpc.CallOption) (*ListHostPeersResponse, error) {
+	out := new(ListHostPeersResponse)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/ListHostPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) AnnounceHost(ctx context.Context, in *AnnounceHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/AnnounceHost", in, out, opts...)
@@ -167,6 +206,33 @@ func (x *schedulerSyncProbesClient) Recv() (*SyncProbesResponse, error) {
 	return m, nil
 }
 
+func (c *schedulerClient) ListProbes(ctx context.Context, in *ListProbesRequest, opts ...grpc.CallOption) (*ListProbesResponse, error) {
+	out := new(ListProbesResponse)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/ListProbes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) StatProbe(ctx context.Context, in *StatProbeRequest, opts ...grpc.CallOption) (*Probe, error) {
+	out := new(Probe)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/StatProbe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListProbeEdges(ctx context.Context, in *ListProbeEdgesRequest, opts ...grpc.CallOption) (*ListProbeEdgesResponse, error) {
+	out := new(ListProbeEdgesResponse)
+	err := c.cc.Invoke(ctx, "/scheduler.v2.Scheduler/ListProbeEdges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchedulerServer is the server API for Scheduler service.
 // All implementations should embed UnimplementedSchedulerServer
 // for forward compatibility
@@ -182,12 +248,24 @@ type SchedulerServer interface {
 	ExchangePeer(context.Context, *ExchangePeerRequest) (*ExchangePeerResponse, error)
 	// Checks information of task.
 	StatTask(context.Context, *StatTaskRequest) (*v2.Task, error)
+	// ListTasks lists tasks in scheduler by page.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// ListTaskPeers lists peers of the task by page.
+	ListTaskPeers(context.Context, *ListTaskPeersRequest) (*ListTaskPeersResponse, error)
+	// ListHostPeers lists peers of the host by page.
+	ListHostPeers(context.Context, *ListHostPeersRequest) (*ListHostPeersResponse, error)
 	// AnnounceHost announces host to scheduler.
 	AnnounceHost(context.Context, *AnnounceHostRequest) (*emptypb.Empty, error)
 	// LeaveHost releases host in scheduler.
 	LeaveHost(context.Context, *LeaveHostRequest) (*emptypb.Empty, error)
 	// SyncProbes sync probes of the host.
 	SyncProbes(Scheduler_SyncProbesServer) error
+	// ListProbes lists the latest probes of the neighbor hosts of the host.
+	ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error)
+	// StatProbe checks the estimated probe between two hosts.
+	StatProbe(context.Context, *StatProbeRequest) (*Probe, error)
+	// ListProbeEdges lists edges of the network topology graph by page.
+	ListProbeEdges(context.Context, *ListProbeEdgesRequest) (*ListProbeEdgesResponse, error)
 }
 
 // UnimplementedSchedulerServer should be embedded to have forward compatible implementations.
@@ -209,6 +287,15 @@ func (UnimplementedSchedulerServer) ExchangePeer(context.Context, *ExchangePeerR
 func (UnimplementedSchedulerServer) StatTask(context.Context, *StatTaskRequest) (*v2.Task, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatTask not implemented")
 }
+func (UnimplementedSchedulerServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSchedulerServer) ListTaskPeers(context.Context, *ListTaskPeersRequest) (*ListTaskPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskPeers not implemented")
+}
+func (UnimplementedSchedulerServer) ListHostPeers(context.Context, *ListHostPeersRequest) (*ListHostPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHostPeers not implemented")
+}
 func (UnimplementedSchedulerServer) AnnounceHost(context.Context, *AnnounceHostRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnounceHost not implemented")
 }
@@ -218,6 +305,15 @@ func (UnimplementedSchedulerServer) LeaveHost(context.Context, *LeaveHostRequest
 func (UnimplementedSchedulerServer) SyncProbes(Scheduler_SyncProbesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SyncProbes not implemented")
 }
+func (UnimplementedSchedulerServer) ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProbes not implemented")
+}
+func (UnimplementedSchedulerServer) StatProbe(context.Context, *StatProbeRequest) (*Probe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatProbe not implemented")
+}
+func (UnimplementedSchedulerServer) ListProbeEdges(context.Context, *ListProbeEdgesRequest) (*ListProbeEdgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProbeEdges not implemented")
+}
 
 // UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SchedulerServer will
@@ -328,6 +424,60 @@ func _Scheduler_StatTask_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListTaskPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListTaskPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/ListTaskPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListTaskPeers(ctx, req.(*ListTaskPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListHostPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHostPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListHostPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/ListHostPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListHostPeers(ctx, req.(*ListHostPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_AnnounceHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AnnounceHostRequest)
 	if err := dec(in); err != nil {
@@ -390,6 +540,60 @@ func (x *schedulerSyncProbesServer) Recv() (*SyncProbesRequest, error) {
 	return m, nil
 }
 
+func _Scheduler_ListProbes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProbesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListProbes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/ListProbes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListProbes(ctx, req.(*ListProbesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_StatProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).StatProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/StatProbe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).StatProbe(ctx, req.(*StatProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListProbeEdges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProbeEdgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListProbeEdges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.v2.Scheduler/ListProbeEdges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListProbeEdges(ctx, req.(*ListProbeEdgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Scheduler_ServiceDesc is the grpc.ServiceDesc for Scheduler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -413,6 +617,18 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatTask",
 			Handler:    _Scheduler_StatTask_Handler,
 		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _Scheduler_ListTasks_Handler,
+		},
+		{
+			MethodName: "ListTaskPeers",
+			Handler:    _Scheduler_ListTaskPeers_Handler,
+		},
+		{
+			MethodName: "ListHostPeers",
+			Handler:    _Scheduler_ListHostPeers_Handler,
+		},
 		{
 			MethodName: "AnnounceHost",
 			Handler:    _Scheduler_AnnounceHost_Handler,
@@ -421,6 +637,18 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveHost",
 			Handler:    _Scheduler_LeaveHost_Handler,
 		},
+		{
+			MethodName: "ListProbes",
+			Handler:    _Scheduler_ListProbes_Handler,
+		},
+		{
+			MethodName: "StatProbe",
+			Handler:    _Scheduler_StatProbe_Handler,
+		},
+		{
+			MethodName: "ListProbeEdges",
+			Handler:    _Scheduler_ListProbeEdges_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -35,22 +35,747 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on Identity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Identity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Identity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentityMultiError, or nil
+// if none found.
+func (m *Identity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Identity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ComponentType_name[int32(m.GetComponent())]; !ok {
+		err := IdentityValidationError{
+			field:  "Component",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ClusterId
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = IdentityValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentityMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Identity) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// IdentityMultiError is an error wrapping multiple validation errors returned
+// by Identity.ValidateAll() if the designated constraints aren't met.
+type IdentityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityMultiError) AllErrors() []error { return m }
+
+// IdentityValidationError is the validation error returned by
+// Identity.Validate if the designated constraints aren't met.
+type IdentityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityValidationError) ErrorName() string { return "IdentityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdentityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityValidationError{}
+
+// Validate checks the field values on AuthorizationRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizationRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizationRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizationRuleMultiError, or nil if none found.
+func (m *AuthorizationRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizationRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetComponents()) < 1 {
+		err := AuthorizationRuleValidationError{
+			field:  "Components",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetComponents() {
+		_, _ = idx, item
+
+		if _, ok := ComponentType_name[int32(item)]; !ok {
+			err := AuthorizationRuleValidationError{
+				field:  fmt.Sprintf("Components[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetMethods()) < 1 {
+		err := AuthorizationRuleValidationError{
+			field:  "Methods",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMethods() {
+		_, _ = idx, item
+
+		if !_AuthorizationRule_Methods_Pattern.MatchString(item) {
+			err := AuthorizationRuleValidationError{
+				field:  fmt.Sprintf("Methods[%v]", idx),
+				reason: "value does not match regex pattern \"^/[^/]+/[^/]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthorizationRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizationRuleMultiError is an error wrapping multiple validation errors
+// returned by AuthorizationRule.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizationRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizationRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizationRuleMultiError) AllErrors() []error { return m }
+
+// AuthorizationRuleValidationError is the validation error returned by
+// AuthorizationRule.Validate if the designated constraints aren't met.
+type AuthorizationRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizationRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizationRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizationRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizationRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizationRuleValidationError) ErrorName() string {
+	return "AuthorizationRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizationRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizationRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizationRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizationRuleValidationError{}
+
+var _AuthorizationRule_Methods_Pattern = regexp.MustCompile("^/[^/]+/[^/]+$")
+
+// Validate checks the field values on AuthorizationPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizationPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizationPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizationPolicyMultiError, or nil if none found.
+func (m *AuthorizationPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizationPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRules()) < 1 {
+		err := AuthorizationPolicyValidationError{
+			field:  "Rules",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthorizationPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthorizationPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthorizationPolicyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthorizationPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizationPolicyMultiError is an error wrapping multiple validation
+// errors returned by AuthorizationPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizationPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizationPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizationPolicyMultiError) AllErrors() []error { return m }
+
+// AuthorizationPolicyValidationError is the validation error returned by
+// AuthorizationPolicy.Validate if the designated constraints aren't met.
+type AuthorizationPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizationPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizationPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizationPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizationPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizationPolicyValidationError) ErrorName() string {
+	return "AuthorizationPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizationPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizationPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizationPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizationPolicyValidationError{}
+
 // Validate checks the field values on CertificateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CertificateRequest) Validate() error {
+func (m *CertificateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CertificateRequestMultiError, or nil if none found.
+func (m *CertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCsr()) < 1 {
+		err := CertificateRequestValidationError{
+			field:  "Csr",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValidityPeriod() == nil {
+		err := CertificateRequestValidationError{
+			field:  "ValidityPeriod",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateRequestValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CertificateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CertificateRequestMultiError is an error wrapping multiple validation errors
+// returned by CertificateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateRequestMultiError) AllErrors() []error { return m }
+
+// CertificateRequestValidationError is the validation error returned by
+// CertificateRequest.Validate if the designated constraints aren't met.
+type CertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateRequestValidationError) ErrorName() string {
+	return "CertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateRequestValidationError{}
+
+// Validate checks the field values on CertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CertificateResponseMultiError, or nil if none found.
+func (m *CertificateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CertificateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCertificateChain()) < 1 {
+		err := CertificateResponseValidationError{
+			field:  "CertificateChain",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CertificateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CertificateResponseMultiError is an error wrapping multiple validation
+// errors returned by CertificateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CertificateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateResponseMultiError) AllErrors() []error { return m }
+
+// CertificateResponseValidationError is the validation error returned by
+// CertificateResponse.Validate if the designated constraints aren't met.
+type CertificateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateResponseValidationError) ErrorName() string {
+	return "CertificateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CertificateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateResponseValidationError{}
+
+// Validate checks the field values on RenewCertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewCertificateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CertificateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RenewCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CertificateRequestMultiError, or nil if none found.
-func (m *CertificateRequest) ValidateAll() error {
+// RenewCertificateRequestMultiError, or nil if none found.
+func (m *RenewCertificateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CertificateRequest) validate(all bool) error {
+func (m *RenewCertificateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -58,7 +783,7 @@ func (m *CertificateRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetCsr()) < 1 {
-		err := CertificateRequestValidationError{
+		err := RenewCertificateRequestValidationError{
 			field:  "Csr",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -68,8 +793,19 @@ func (m *CertificateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetCertificateChain()) < 1 {
+		err := RenewCertificateRequestValidationError{
+			field:  "CertificateChain",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.GetValidityPeriod() == nil {
-		err := CertificateRequestValidationError{
+		err := RenewCertificateRequestValidationError{
 			field:  "ValidityPeriod",
 			reason: "value is required",
 		}
@@ -80,19 +816,236 @@ func (m *CertificateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CertificateRequestMultiError(errors)
+		return RenewCertificateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CertificateRequestMultiError is an error wrapping multiple validation errors
-// returned by CertificateRequest.ValidateAll() if the designated constraints
+// RenewCertificateRequestMultiError is an error wrapping multiple validation
+// errors returned by RenewCertificateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenewCertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewCertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewCertificateRequestMultiError) AllErrors() []error { return m }
+
+// RenewCertificateRequestValidationError is the validation error returned by
+// RenewCertificateRequest.Validate if the designated constraints aren't met.
+type RenewCertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewCertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewCertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewCertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewCertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewCertificateRequestValidationError) ErrorName() string {
+	return "RenewCertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewCertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewCertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewCertificateRequestValidationError{}
+
+// Validate checks the field values on RevokeCertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCertificateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeCertificateRequestMultiError, or nil if none found.
+func (m *RevokeCertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeCertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSerialNumber()) < 1 {
+		err := RevokeCertificateRequestValidationError{
+			field:  "SerialNumber",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RevokeCertificateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeCertificateRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeCertificateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeCertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeCertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeCertificateRequestMultiError) AllErrors() []error { return m }
+
+// RevokeCertificateRequestValidationError is the validation error returned by
+// RevokeCertificateRequest.Validate if the designated constraints aren't met.
+type RevokeCertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeCertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeCertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeCertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeCertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeCertificateRequestValidationError) ErrorName() string {
+	return "RevokeCertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeCertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeCertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeCertificateRequestValidationError{}
+
+// Validate checks the field values on GetCABundleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCABundleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCABundleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCABundleRequestMultiError, or nil if none found.
+func (m *GetCABundleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCABundleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCABundleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCABundleRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCABundleRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CertificateRequestMultiError []error
+type GetCABundleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CertificateRequestMultiError) Error() string {
+func (m GetCABundleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -101,11 +1054,11 @@ func (m CertificateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CertificateRequestMultiError) AllErrors() []error { return m }
+func (m GetCABundleRequestMultiError) AllErrors() []error { return m }
 
-// CertificateRequestValidationError is the validation error returned by
-// CertificateRequest.Validate if the designated constraints aren't met.
-type CertificateRequestValidationError struct {
+// GetCABundleRequestValidationError is the validation error returned by
+// GetCABundleRequest.Validate if the designated constraints aren't met.
+type GetCABundleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -113,24 +1066,24 @@ type CertificateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CertificateRequestValidationError) Field() string { return e.field }
+func (e GetCABundleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CertificateRequestValidationError) Reason() string { return e.reason }
+func (e GetCABundleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CertificateRequestValidationError) Cause() error { return e.cause }
+func (e GetCABundleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CertificateRequestValidationError) Key() bool { return e.key }
+func (e GetCABundleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CertificateRequestValidationError) ErrorName() string {
-	return "CertificateRequestValidationError"
+func (e GetCABundleRequestValidationError) ErrorName() string {
+	return "GetCABundleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CertificateRequestValidationError) Error() string {
+func (e GetCABundleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -142,14 +1095,14 @@ func (e CertificateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCertificateRequest.%s: %s%s",
+		"invalid %sGetCABundleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CertificateRequestValidationError{}
+var _ error = GetCABundleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -157,33 +1110,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CertificateRequestValidationError{}
+} = GetCABundleRequestValidationError{}
 
-// Validate checks the field values on CertificateResponse with the rules
+// Validate checks the field values on GetCABundleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CertificateResponse) Validate() error {
+func (m *GetCABundleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CertificateResponse with the rules
+// ValidateAll checks the field values on GetCABundleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CertificateResponseMultiError, or nil if none found.
-func (m *CertificateResponse) ValidateAll() error {
+// GetCABundleResponseMultiError, or nil if none found.
+func (m *GetCABundleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CertificateResponse) validate(all bool) error {
+func (m *GetCABundleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetCertificateChain()) < 1 {
-		err := CertificateResponseValidationError{
-			field:  "CertificateChain",
+	if len(m.GetCaCertificates()) < 1 {
+		err := GetCABundleResponseValidationError{
+			field:  "CaCertificates",
 			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
@@ -193,19 +1146,19 @@ func (m *CertificateResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CertificateResponseMultiError(errors)
+		return GetCABundleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CertificateResponseMultiError is an error wrapping multiple validation
-// errors returned by CertificateResponse.ValidateAll() if the designated
+// GetCABundleResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCABundleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CertificateResponseMultiError []error
+type GetCABundleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CertificateResponseMultiError) Error() string {
+func (m GetCABundleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -214,11 +1167,11 @@ func (m CertificateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CertificateResponseMultiError) AllErrors() []error { return m }
+func (m GetCABundleResponseMultiError) AllErrors() []error { return m }
 
-// CertificateResponseValidationError is the validation error returned by
-// CertificateResponse.Validate if the designated constraints aren't met.
-type CertificateResponseValidationError struct {
+// GetCABundleResponseValidationError is the validation error returned by
+// GetCABundleResponse.Validate if the designated constraints aren't met.
+type GetCABundleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -226,24 +1179,24 @@ type CertificateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CertificateResponseValidationError) Field() string { return e.field }
+func (e GetCABundleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CertificateResponseValidationError) Reason() string { return e.reason }
+func (e GetCABundleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CertificateResponseValidationError) Cause() error { return e.cause }
+func (e GetCABundleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CertificateResponseValidationError) Key() bool { return e.key }
+func (e GetCABundleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CertificateResponseValidationError) ErrorName() string {
-	return "CertificateResponseValidationError"
+func (e GetCABundleResponseValidationError) ErrorName() string {
+	return "GetCABundleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CertificateResponseValidationError) Error() string {
+func (e GetCABundleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -255,14 +1208,14 @@ func (e CertificateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCertificateResponse.%s: %s%s",
+		"invalid %sGetCABundleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CertificateResponseValidationError{}
+var _ error = GetCABundleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -270,4 +1223,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CertificateResponseValidationError{}
+} = GetCABundleResponseValidationError{}
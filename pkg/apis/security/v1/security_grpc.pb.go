@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -24,6 +25,12 @@ const _ = grpc.SupportPackageIsVersion7
 type CertificateClient interface {
 	// Using provided CSR, returns a signed certificate.
 	IssueCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	// Using provided CSR and certificate chain to be renewed, returns a signed certificate before expiry.
+	RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	// Revokes the certificate, the revoked certificate can not be renewed.
+	RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the CA bundle and the revoked certificates.
+	GetCABundle(ctx context.Context, in *GetCABundleRequest, opts ...grpc.CallOption) (*GetCABundleResponse, error)
 }
 
 type certificateClient struct {
@@ -43,12 +50,45 @@ func (c *certificateClient) IssueCertificate(ctx context.Context, in *Certificat
 	return out, nil
 }
 
+func (c *certificateClient) RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, "/security.Certificate/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateClient) RevokeCertificate(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/security.Certificate/RevokeCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateClient) GetCABundle(ctx context.Context, in *GetCABundleRequest, opts ...grpc.CallOption) (*GetCABundleResponse, error) {
+	out := new(GetCABundleResponse)
+	err := c.cc.Invoke(ctx, "/security.Certificate/GetCABundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CertificateServer is the server API for Certificate service.
 // All implementations should embed UnimplementedCertificateServer
 // for forward compatibility
 type CertificateServer interface {
 	// Using provided CSR, returns a signed certificate.
 	IssueCertificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	// Using provided CSR and certificate chain to be renewed, returns a signed certificate before expiry.
+	RenewCertificate(context.Context, *RenewCertificateRequest) (*CertificateResponse, error)
+	// Revokes the certificate, the revoked certificate can not be renewed.
+	RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error)
+	// Returns the CA bundle and the revoked certificates.
+	GetCABundle(context.Context, *GetCABundleRequest) (*GetCABundleResponse, error)
 }
 
 // UnimplementedCertificateServer should be embedded to have forward compatible implementations.
@@ -58,6 +98,15 @@ type UnimplementedCertificateServer struct {
 func (UnimplementedCertificateServer) IssueCertificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueCertificate not implemented")
 }
+func (UnimplementedCertificateServer) RenewCertificate(context.Context, *RenewCertificateRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
+func (UnimplementedCertificateServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedCertificateServer) GetCABundle(context.Context, *GetCABundleRequest) (*GetCABundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCABundle not implemented")
+}
 
 // UnsafeCertificateServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CertificateServer will
@@ -88,6 +137,60 @@ func _Certificate_IssueCertificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certificate_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Certificate/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServer).RenewCertificate(ctx, req.(*RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certificate_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Certificate/RevokeCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certificate_GetCABundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCABundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServer).GetCABundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/security.Certificate/GetCABundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServer).GetCABundle(ctx, req.(*GetCABundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Certificate_ServiceDesc is the grpc.ServiceDesc for Certificate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +202,18 @@ var Certificate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueCertificate",
 			Handler:    _Certificate_IssueCertificate_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Certificate_RenewCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _Certificate_RevokeCertificate_Handler,
+		},
+		{
+			MethodName: "GetCABundle",
+			Handler:    _Certificate_GetCABundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apis/security/v1/security.proto",
@@ -38,6 +38,66 @@ func (m *MockTrainerClient) EXPECT() *MockTrainerClientMockRecorder {
 	return m.recorder
 }
 
+// CreateTrainingJobs mocks base method.
+func (m *MockTrainerClient) CreateTrainingJobs(ctx context.Context, opts ...grpc.CallOption) (trainer.Trainer_CreateTrainingJobsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTrainingJobs", varargs...)
+	ret0, _ := ret[0].(trainer.Trainer_CreateTrainingJobsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrainingJobs indicates an expected call of CreateTrainingJobs.
+func (mr *MockTrainerClientMockRecorder) CreateTrainingJobs(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrainingJobs", reflect.TypeOf((*MockTrainerClient)(nil).CreateTrainingJobs), varargs...)
+}
+
+// GetTrainingJob mocks base method.
+func (m *MockTrainerClient) GetTrainingJob(ctx context.Context, in *trainer.GetTrainingJobRequest, opts ...grpc.CallOption) (*trainer.TrainingJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrainingJob", varargs...)
+	ret0, _ := ret[0].(*trainer.TrainingJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrainingJob indicates an expected call of GetTrainingJob.
+func (mr *MockTrainerClientMockRecorder) GetTrainingJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrainingJob", reflect.TypeOf((*MockTrainerClient)(nil).GetTrainingJob), varargs...)
+}
+
+// ListTrainingJobs mocks base method.
+func (m *MockTrainerClient) ListTrainingJobs(ctx context.Context, in *trainer.ListTrainingJobsRequest, opts ...grpc.CallOption) (*trainer.ListTrainingJobsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrainingJobs", varargs...)
+	ret0, _ := ret[0].(*trainer.ListTrainingJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrainingJobs indicates an expected call of ListTrainingJobs.
+func (mr *MockTrainerClientMockRecorder) ListTrainingJobs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrainingJobs", reflect.TypeOf((*MockTrainerClient)(nil).ListTrainingJobs), varargs...)
+}
+
 // Train mocks base method.
 func (m *MockTrainerClient) Train(ctx context.Context, opts ...grpc.CallOption) (trainer.Trainer_TrainClient, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +118,26 @@ func (mr *MockTrainerClientMockRecorder) Train(ctx interface{}, opts ...interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Train", reflect.TypeOf((*MockTrainerClient)(nil).Train), varargs...)
 }
 
+// UploadDataset mocks base method.
+func (m *MockTrainerClient) UploadDataset(ctx context.Context, opts ...grpc.CallOption) (trainer.Trainer_UploadDatasetClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadDataset", varargs...)
+	ret0, _ := ret[0].(trainer.Trainer_UploadDatasetClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadDataset indicates an expected call of UploadDataset.
+func (mr *MockTrainerClientMockRecorder) UploadDataset(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDataset", reflect.TypeOf((*MockTrainerClient)(nil).UploadDataset), varargs...)
+}
+
 // MockTrainer_TrainClient is a mock of Trainer_TrainClient interface.
 type MockTrainer_TrainClient struct {
 	ctrl     *gomock.Controller
@@ -195,6 +275,280 @@ func (mr *MockTrainer_TrainClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTrainer_TrainClient)(nil).Trailer))
 }
 
+// MockTrainer_CreateTrainingJobsClient is a mock of Trainer_CreateTrainingJobsClient interface.
+type MockTrainer_CreateTrainingJobsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrainer_CreateTrainingJobsClientMockRecorder
+}
+
+// MockTrainer_CreateTrainingJobsClientMockRecorder is the mock recorder for MockTrainer_CreateTrainingJobsClient.
+type MockTrainer_CreateTrainingJobsClientMockRecorder struct {
+	mock *MockTrainer_CreateTrainingJobsClient
+}
+
+// NewMockTrainer_CreateTrainingJobsClient creates a new mock instance.
+func NewMockTrainer_CreateTrainingJobsClient(ctrl *gomock.Controller) *MockTrainer_CreateTrainingJobsClient {
+	mock := &MockTrainer_CreateTrainingJobsClient{ctrl: ctrl}
+	mock.recorder = &MockTrainer_CreateTrainingJobsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrainer_CreateTrainingJobsClient) EXPECT() *MockTrainer_CreateTrainingJobsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) CloseAndRecv() (*trainer.CreateTrainingJobsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*trainer.CreateTrainingJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTrainer_CreateTrainingJobsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) Send(arg0 *trainer.TrainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTrainer_CreateTrainingJobsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTrainer_CreateTrainingJobsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTrainer_CreateTrainingJobsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTrainer_CreateTrainingJobsClient)(nil).Trailer))
+}
+
+// MockTrainer_UploadDatasetClient is a mock of Trainer_UploadDatasetClient interface.
+type MockTrainer_UploadDatasetClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrainer_UploadDatasetClientMockRecorder
+}
+
+// MockTrainer_UploadDatasetClientMockRecorder is the mock recorder for MockTrainer_UploadDatasetClient.
+type MockTrainer_UploadDatasetClientMockRecorder struct {
+	mock *MockTrainer_UploadDatasetClient
+}
+
+// NewMockTrainer_UploadDatasetClient creates a new mock instance.
+func NewMockTrainer_UploadDatasetClient(ctrl *gomock.Controller) *MockTrainer_UploadDatasetClient {
+	mock := &MockTrainer_UploadDatasetClient{ctrl: ctrl}
+	mock.recorder = &MockTrainer_UploadDatasetClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrainer_UploadDatasetClient) EXPECT() *MockTrainer_UploadDatasetClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTrainer_UploadDatasetClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTrainer_UploadDatasetClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTrainer_UploadDatasetClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTrainer_UploadDatasetClient) Recv() (*trainer.UploadDatasetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*trainer.UploadDatasetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTrainer_UploadDatasetClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTrainer_UploadDatasetClient) Send(arg0 *trainer.UploadDatasetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTrainer_UploadDatasetClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTrainer_UploadDatasetClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTrainer_UploadDatasetClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTrainer_UploadDatasetClient)(nil).Trailer))
+}
+
 // MockTrainerServer is a mock of TrainerServer interface.
 type MockTrainerServer struct {
 	ctrl     *gomock.Controller
@@ -218,6 +572,50 @@ func (m *MockTrainerServer) EXPECT() *MockTrainerServerMockRecorder {
 	return m.recorder
 }
 
+// CreateTrainingJobs mocks base method.
+func (m *MockTrainerServer) CreateTrainingJobs(arg0 trainer.Trainer_CreateTrainingJobsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrainingJobs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTrainingJobs indicates an expected call of CreateTrainingJobs.
+func (mr *MockTrainerServerMockRecorder) CreateTrainingJobs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrainingJobs", reflect.TypeOf((*MockTrainerServer)(nil).CreateTrainingJobs), arg0)
+}
+
+// GetTrainingJob mocks base method.
+func (m *MockTrainerServer) GetTrainingJob(arg0 context.Context, arg1 *trainer.GetTrainingJobRequest) (*trainer.TrainingJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrainingJob", arg0, arg1)
+	ret0, _ := ret[0].(*trainer.TrainingJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrainingJob indicates an expected call of GetTrainingJob.
+func (mr *MockTrainerServerMockRecorder) GetTrainingJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrainingJob", reflect.TypeOf((*MockTrainerServer)(nil).GetTrainingJob), arg0, arg1)
+}
+
+// ListTrainingJobs mocks base method.
+func (m *MockTrainerServer) ListTrainingJobs(arg0 context.Context, arg1 *trainer.ListTrainingJobsRequest) (*trainer.ListTrainingJobsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrainingJobs", arg0, arg1)
+	ret0, _ := ret[0].(*trainer.ListTrainingJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrainingJobs indicates an expected call of ListTrainingJobs.
+func (mr *MockTrainerServerMockRecorder) ListTrainingJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrainingJobs", reflect.TypeOf((*MockTrainerServer)(nil).ListTrainingJobs), arg0, arg1)
+}
+
 // Train mocks base method.
 func (m *MockTrainerServer) Train(arg0 trainer.Trainer_TrainServer) error {
 	m.ctrl.T.Helper()
@@ -232,6 +630,20 @@ func (mr *MockTrainerServerMockRecorder) Train(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Train", reflect.TypeOf((*MockTrainerServer)(nil).Train), arg0)
 }
 
+// UploadDataset mocks base method.
+func (m *MockTrainerServer) UploadDataset(arg0 trainer.Trainer_UploadDatasetServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadDataset", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadDataset indicates an expected call of UploadDataset.
+func (mr *MockTrainerServerMockRecorder) UploadDataset(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDataset", reflect.TypeOf((*MockTrainerServer)(nil).UploadDataset), arg0)
+}
+
 // MockUnsafeTrainerServer is a mock of UnsafeTrainerServer interface.
 type MockUnsafeTrainerServer struct {
 	ctrl     *gomock.Controller
@@ -400,3 +812,271 @@ func (mr *MockTrainer_TrainServerMockRecorder) SetTrailer(arg0 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTrainer_TrainServer)(nil).SetTrailer), arg0)
 }
+
+// MockTrainer_CreateTrainingJobsServer is a mock of Trainer_CreateTrainingJobsServer interface.
+type MockTrainer_CreateTrainingJobsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrainer_CreateTrainingJobsServerMockRecorder
+}
+
+// MockTrainer_CreateTrainingJobsServerMockRecorder is the mock recorder for MockTrainer_CreateTrainingJobsServer.
+type MockTrainer_CreateTrainingJobsServerMockRecorder struct {
+	mock *MockTrainer_CreateTrainingJobsServer
+}
+
+// NewMockTrainer_CreateTrainingJobsServer creates a new mock instance.
+func NewMockTrainer_CreateTrainingJobsServer(ctrl *gomock.Controller) *MockTrainer_CreateTrainingJobsServer {
+	mock := &MockTrainer_CreateTrainingJobsServer{ctrl: ctrl}
+	mock.recorder = &MockTrainer_CreateTrainingJobsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrainer_CreateTrainingJobsServer) EXPECT() *MockTrainer_CreateTrainingJobsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) Recv() (*trainer.TrainRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*trainer.TrainRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTrainer_CreateTrainingJobsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) SendAndClose(arg0 *trainer.CreateTrainingJobsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTrainer_CreateTrainingJobsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTrainer_CreateTrainingJobsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTrainer_CreateTrainingJobsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTrainer_CreateTrainingJobsServer)(nil).SetTrailer), arg0)
+}
+
+// MockTrainer_UploadDatasetServer is a mock of Trainer_UploadDatasetServer interface.
+type MockTrainer_UploadDatasetServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrainer_UploadDatasetServerMockRecorder
+}
+
+// MockTrainer_UploadDatasetServerMockRecorder is the mock recorder for MockTrainer_UploadDatasetServer.
+type MockTrainer_UploadDatasetServerMockRecorder struct {
+	mock *MockTrainer_UploadDatasetServer
+}
+
+// NewMockTrainer_UploadDatasetServer creates a new mock instance.
+func NewMockTrainer_UploadDatasetServer(ctrl *gomock.Controller) *MockTrainer_UploadDatasetServer {
+	mock := &MockTrainer_UploadDatasetServer{ctrl: ctrl}
+	mock.recorder = &MockTrainer_UploadDatasetServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrainer_UploadDatasetServer) EXPECT() *MockTrainer_UploadDatasetServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTrainer_UploadDatasetServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockTrainer_UploadDatasetServer) Recv() (*trainer.UploadDatasetRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*trainer.UploadDatasetRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTrainer_UploadDatasetServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTrainer_UploadDatasetServer) Send(arg0 *trainer.UploadDatasetResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTrainer_UploadDatasetServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTrainer_UploadDatasetServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTrainer_UploadDatasetServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTrainer_UploadDatasetServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTrainer_UploadDatasetServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTrainer_UploadDatasetServer)(nil).SetTrailer), arg0)
+}
@@ -22,10 +22,14 @@
 package trainer
 
 import (
+	v2 "d7y.io/api/v2/pkg/apis/common/v2"
+	v21 "d7y.io/api/v2/pkg/apis/manager/v2"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -37,18 +41,175 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// TrainGNNRequest represents to train GNN model request of TrainRequest.
-type TrainGNNRequest struct {
+// DatasetType represents type of dataset.
+type DatasetType int32
+
+const (
+	// Dataset of DownloadRecord, it is used to train MLP model.
+	DatasetType_DOWNLOAD_DATASET DatasetType = 0
+	// Dataset of NetworkTopologyRecord, it is used to train GNN model.
+	DatasetType_NETWORK_TOPOLOGY_DATASET DatasetType = 1
+)
+
+// Enum value maps for DatasetType.
+var (
+	DatasetType_name = map[int32]string{
+		0: "DOWNLOAD_DATASET",
+		1: "NETWORK_TOPOLOGY_DATASET",
+	}
+	DatasetType_value = map[string]int32{
+		"DOWNLOAD_DATASET":         0,
+		"NETWORK_TOPOLOGY_DATASET": 1,
+	}
+)
+
+func (x DatasetType) Enum() *DatasetType {
+	p := new(DatasetType)
+	*p = x
+	return p
+}
+
+func (x DatasetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatasetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_apis_trainer_v1_trainer_proto_enumTypes[0].Descriptor()
+}
+
+func (DatasetType) Type() protoreflect.EnumType {
+	return &file_pkg_apis_trainer_v1_trainer_proto_enumTypes[0]
+}
+
+func (x DatasetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatasetType.Descriptor instead.
+func (DatasetType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{0}
+}
+
+// DownloadState represents state of download.
+type DownloadState int32
+
+const (
+	// Download is finished.
+	DownloadState_FINISHED_DOWNLOAD DownloadState = 0
+	// Download is failed.
+	DownloadState_FAILED_DOWNLOAD DownloadState = 1
+)
+
+// Enum value maps for DownloadState.
+var (
+	DownloadState_name = map[int32]string{
+		0: "FINISHED_DOWNLOAD",
+		1: "FAILED_DOWNLOAD",
+	}
+	DownloadState_value = map[string]int32{
+		"FINISHED_DOWNLOAD": 0,
+		"FAILED_DOWNLOAD":   1,
+	}
+)
+
+func (x DownloadState) Enum() *DownloadState {
+	p := new(DownloadState)
+	*p = x
+	return p
+}
+
+func (x DownloadState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_apis_trainer_v1_trainer_proto_enumTypes[1].Descriptor()
+}
+
+func (DownloadState) Type() protoreflect.EnumType {
+	return &file_pkg_apis_trainer_v1_trainer_proto_enumTypes[1]
+}
+
+func (x DownloadState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadState.Descriptor instead.
+func (DownloadState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{1}
+}
+
+// TrainingJobState represents state of training job.
+type TrainingJobState int32
+
+const (
+	// Training job is waiting to be trained.
+	TrainingJobState_PENDING_JOB TrainingJobState = 0
+	// Training job is training the model.
+	TrainingJobState_RUNNING_JOB TrainingJobState = 1
+	// Training job is finished, and the model is created in the manager.
+	TrainingJobState_SUCCEEDED_JOB TrainingJobState = 2
+	// Training job is failed.
+	TrainingJobState_FAILED_JOB TrainingJobState = 3
+)
+
+// Enum value maps for TrainingJobState.
+var (
+	TrainingJobState_name = map[int32]string{
+		0: "PENDING_JOB",
+		1: "RUNNING_JOB",
+		2: "SUCCEEDED_JOB",
+		3: "FAILED_JOB",
+	}
+	TrainingJobState_value = map[string]int32{
+		"PENDING_JOB":   0,
+		"RUNNING_JOB":   1,
+		"SUCCEEDED_JOB": 2,
+		"FAILED_JOB":    3,
+	}
+)
+
+func (x TrainingJobState) Enum() *TrainingJobState {
+	p := new(TrainingJobState)
+	*p = x
+	return p
+}
+
+func (x TrainingJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrainingJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_apis_trainer_v1_trainer_proto_enumTypes[2].Descriptor()
+}
+
+func (TrainingJobState) Type() protoreflect.EnumType {
+	return &file_pkg_apis_trainer_v1_trainer_proto_enumTypes[2]
+}
+
+func (x TrainingJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrainingJobState.Descriptor instead.
+func (TrainingJobState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{2}
+}
+
+// DatasetHeader represents header of dataset, it is the first message of dataset.
+type DatasetHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Dataset of training GNN.
-	Dataset []byte `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// Schema version of the records.
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Dataset type.
+	Type DatasetType `protobuf:"varint,2,opt,name=type,proto3,enum=trainer.v1.DatasetType" json:"type,omitempty"`
 }
 
-func (x *TrainGNNRequest) Reset() {
-	*x = TrainGNNRequest{}
+func (x *DatasetHeader) Reset() {
+	*x = DatasetHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,13 +217,13 @@ func (x *TrainGNNRequest) Reset() {
 	}
 }
 
-func (x *TrainGNNRequest) String() string {
+func (x *DatasetHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrainGNNRequest) ProtoMessage() {}
+func (*DatasetHeader) ProtoMessage() {}
 
-func (x *TrainGNNRequest) ProtoReflect() protoreflect.Message {
+func (x *DatasetHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -74,30 +235,45 @@ func (x *TrainGNNRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrainGNNRequest.ProtoReflect.Descriptor instead.
-func (*TrainGNNRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DatasetHeader.ProtoReflect.Descriptor instead.
+func (*DatasetHeader) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *TrainGNNRequest) GetDataset() []byte {
+func (x *DatasetHeader) GetSchemaVersion() uint32 {
 	if x != nil {
-		return x.Dataset
+		return x.SchemaVersion
 	}
-	return nil
+	return 0
 }
 
-// TrainMLPRequest represents to train MLP model request of TrainRequest.
-type TrainMLPRequest struct {
+func (x *DatasetHeader) GetType() DatasetType {
+	if x != nil {
+		return x.Type
+	}
+	return DatasetType_DOWNLOAD_DATASET
+}
+
+// PieceRecord represents record of piece downloaded by the child peer.
+type PieceRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Dataset of training MLP.
-	Dataset []byte `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// Piece number.
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Parent peer id, empty parent id represents the piece is downloaded from the source.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Piece length.
+	Length uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// Traffic type.
+	TrafficType v2.TrafficType `protobuf:"varint,4,opt,name=traffic_type,json=trafficType,proto3,enum=common.v2.TrafficType" json:"traffic_type,omitempty"`
+	// Downloading piece costs time.
+	Cost *durationpb.Duration `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
 }
 
-func (x *TrainMLPRequest) Reset() {
-	*x = TrainMLPRequest{}
+func (x *PieceRecord) Reset() {
+	*x = PieceRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -105,13 +281,13 @@ func (x *TrainMLPRequest) Reset() {
 	}
 }
 
-func (x *TrainMLPRequest) String() string {
+func (x *PieceRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrainMLPRequest) ProtoMessage() {}
+func (*PieceRecord) ProtoMessage() {}
 
-func (x *TrainMLPRequest) ProtoReflect() protoreflect.Message {
+func (x *PieceRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -123,37 +299,60 @@ func (x *TrainMLPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrainMLPRequest.ProtoReflect.Descriptor instead.
-func (*TrainMLPRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PieceRecord.ProtoReflect.Descriptor instead.
+func (*PieceRecord) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *TrainMLPRequest) GetDataset() []byte {
+func (x *PieceRecord) GetNumber() int32 {
 	if x != nil {
-		return x.Dataset
+		return x.Number
+	}
+	return 0
+}
+
+func (x *PieceRecord) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *PieceRecord) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PieceRecord) GetTrafficType() v2.TrafficType {
+	if x != nil {
+		return x.TrafficType
+	}
+	return v2.TrafficType(0)
+}
+
+func (x *PieceRecord) GetCost() *durationpb.Duration {
+	if x != nil {
+		return x.Cost
 	}
 	return nil
 }
 
-// TrainRequest represents request of Train.
-type TrainRequest struct {
+// ParentRecord represents record of parent peer of DownloadRecord.
+type ParentRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Scheduler hostname.
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	// Scheduler ip.
-	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	// Types that are assignable to Request:
-	//
-	//	*TrainRequest_TrainGnnRequest
-	//	*TrainRequest_TrainMlpRequest
-	Request isTrainRequest_Request `protobuf_oneof:"request"`
+	// Parent peer id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Parent host features.
+	Host *v2.Host `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
 }
 
-func (x *TrainRequest) Reset() {
-	*x = TrainRequest{}
+func (x *ParentRecord) Reset() {
+	*x = ParentRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -161,13 +360,13 @@ func (x *TrainRequest) Reset() {
 	}
 }
 
-func (x *TrainRequest) String() string {
+func (x *ParentRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrainRequest) ProtoMessage() {}
+func (*ParentRecord) ProtoMessage() {}
 
-func (x *TrainRequest) ProtoReflect() protoreflect.Message {
+func (x *ParentRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,143 +378,1989 @@ func (x *TrainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrainRequest.ProtoReflect.Descriptor instead.
-func (*TrainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParentRecord.ProtoReflect.Descriptor instead.
+func (*ParentRecord) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TrainRequest) GetHostname() string {
+func (x *ParentRecord) GetId() string {
 	if x != nil {
-		return x.Hostname
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TrainRequest) GetIp() string {
+func (x *ParentRecord) GetHost() *v2.Host {
 	if x != nil {
-		return x.Ip
+		return x.Host
+	}
+	return nil
+}
+
+// DownloadRecord represents record of download of DOWNLOAD_DATASET.
+type DownloadRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Task id.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Task type.
+	TaskType v2.TaskType `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=common.v2.TaskType" json:"task_type,omitempty"`
+	// Task content length, -1 represents the content length is unknown.
+	ContentLength int64 `protobuf:"varint,3,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	// Task piece count, -1 represents the piece count is unknown.
+	PieceCount int32 `protobuf:"varint,4,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+	// Child peer id.
+	PeerId string `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// Child host features.
+	Host *v2.Host `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	// Parents of the child peer.
+	Parents []*ParentRecord `protobuf:"bytes,7,rep,name=parents,proto3" json:"parents,omitempty"`
+	// Pieces downloaded by the child peer.
+	Pieces []*PieceRecord `protobuf:"bytes,8,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	// Download state.
+	State DownloadState `protobuf:"varint,9,opt,name=state,proto3,enum=trainer.v1.DownloadState" json:"state,omitempty"`
+	// The description of the download failed.
+	Description string `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	// Download costs time.
+	Cost *durationpb.Duration `protobuf:"bytes,11,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Download create time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DownloadRecord) Reset() {
+	*x = DownloadRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRecord) ProtoMessage() {}
+
+func (x *DownloadRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRecord.ProtoReflect.Descriptor instead.
+func (*DownloadRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DownloadRecord) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
 	}
 	return ""
 }
 
-func (m *TrainRequest) GetRequest() isTrainRequest_Request {
-	if m != nil {
-		return m.Request
+func (x *DownloadRecord) GetTaskType() v2.TaskType {
+	if x != nil {
+		return x.TaskType
 	}
-	return nil
+	return v2.TaskType(0)
 }
 
-func (x *TrainRequest) GetTrainGnnRequest() *TrainGNNRequest {
-	if x, ok := x.GetRequest().(*TrainRequest_TrainGnnRequest); ok {
-		return x.TrainGnnRequest
+func (x *DownloadRecord) GetContentLength() int64 {
+	if x != nil {
+		return x.ContentLength
+	}
+	return 0
+}
+
+func (x *DownloadRecord) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+func (x *DownloadRecord) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetHost() *v2.Host {
+	if x != nil {
+		return x.Host
 	}
 	return nil
 }
 
-func (x *TrainRequest) GetTrainMlpRequest() *TrainMLPRequest {
-	if x, ok := x.GetRequest().(*TrainRequest_TrainMlpRequest); ok {
-		return x.TrainMlpRequest
+func (x *DownloadRecord) GetParents() []*ParentRecord {
+	if x != nil {
+		return x.Parents
 	}
 	return nil
 }
 
-type isTrainRequest_Request interface {
-	isTrainRequest_Request()
+func (x *DownloadRecord) GetPieces() []*PieceRecord {
+	if x != nil {
+		return x.Pieces
+	}
+	return nil
 }
 
-type TrainRequest_TrainGnnRequest struct {
-	TrainGnnRequest *TrainGNNRequest `protobuf:"bytes,3,opt,name=train_gnn_request,json=trainGnnRequest,proto3,oneof"`
+func (x *DownloadRecord) GetState() DownloadState {
+	if x != nil {
+		return x.State
+	}
+	return DownloadState_FINISHED_DOWNLOAD
 }
 
-type TrainRequest_TrainMlpRequest struct {
-	TrainMlpRequest *TrainMLPRequest `protobuf:"bytes,4,opt,name=train_mlp_request,json=trainMlpRequest,proto3,oneof"`
+func (x *DownloadRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*TrainRequest_TrainGnnRequest) isTrainRequest_Request() {}
+func (x *DownloadRecord) GetCost() *durationpb.Duration {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
 
-func (*TrainRequest_TrainMlpRequest) isTrainRequest_Request() {}
+func (x *DownloadRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-var File_pkg_apis_trainer_v1_trainer_proto protoreflect.FileDescriptor
+// NetworkTopologyRecord represents record of probe edge of NETWORK_TOPOLOGY_DATASET,
+// refer to scheduler.v2.ProbeEdge.
+type NetworkTopologyRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_apis_trainer_v1_trainer_proto_rawDesc = []byte{
-	0x0a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x4e,
-	0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02,
-	0x10, 0x01, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x34, 0x0a, 0x0f, 0x54,
-	0x72, 0x61, 0x69, 0x6e, 0x4d, 0x4c, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68,
-	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70,
-	0x12, 0x49, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x67, 0x6e, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x4e,
-	0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x47, 0x6e, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x6c, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x4c, 0x50, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6c, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x32, 0x46, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x12, 0x3b, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x42, 0x2b,
-	0x5a, 0x29, 0x64, 0x37, 0x79, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// Source host features.
+	SrcHost *v2.Host `protobuf:"bytes,1,opt,name=src_host,json=srcHost,proto3" json:"src_host,omitempty"`
+	// Destination host features.
+	DestHost *v2.Host `protobuf:"bytes,2,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	// Average round-trip time of the probes.
+	AverageRtt *durationpb.Duration `protobuf:"bytes,3,opt,name=average_rtt,json=averageRtt,proto3" json:"average_rtt,omitempty"`
+	// Minimum round-trip time of the probes.
+	MinRtt *durationpb.Duration `protobuf:"bytes,4,opt,name=min_rtt,json=minRtt,proto3" json:"min_rtt,omitempty"`
+	// Maximum round-trip time of the probes.
+	MaxRtt *durationpb.Duration `protobuf:"bytes,5,opt,name=max_rtt,json=maxRtt,proto3" json:"max_rtt,omitempty"`
+	// Ratio of lost packets of the probes, range is [0, 1].
+	LossRate float64 `protobuf:"fixed64,6,opt,name=loss_rate,json=lossRate,proto3" json:"loss_rate,omitempty"`
+	// Throughput of the probes in bytes per second.
+	Throughput uint64 `protobuf:"varint,7,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	// Count of the probes.
+	ProbeCount int64 `protobuf:"varint,8,opt,name=probe_count,json=probeCount,proto3" json:"probe_count,omitempty"`
+	// Record create time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-var (
-	file_pkg_apis_trainer_v1_trainer_proto_rawDescOnce sync.Once
-	file_pkg_apis_trainer_v1_trainer_proto_rawDescData = file_pkg_apis_trainer_v1_trainer_proto_rawDesc
-)
+func (x *NetworkTopologyRecord) Reset() {
+	*x = NetworkTopologyRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP() []byte {
-	file_pkg_apis_trainer_v1_trainer_proto_rawDescOnce.Do(func() {
-		file_pkg_apis_trainer_v1_trainer_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_apis_trainer_v1_trainer_proto_rawDescData)
-	})
-	return file_pkg_apis_trainer_v1_trainer_proto_rawDescData
+func (x *NetworkTopologyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pkg_apis_trainer_v1_trainer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_pkg_apis_trainer_v1_trainer_proto_goTypes = []interface{}{
-	(*TrainGNNRequest)(nil), // 0: trainer.v1.TrainGNNRequest
-	(*TrainMLPRequest)(nil), // 1: trainer.v1.TrainMLPRequest
-	(*TrainRequest)(nil),    // 2: trainer.v1.TrainRequest
-	(*emptypb.Empty)(nil),   // 3: google.protobuf.Empty
+func (*NetworkTopologyRecord) ProtoMessage() {}
+
+func (x *NetworkTopologyRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pkg_apis_trainer_v1_trainer_proto_depIdxs = []int32{
-	0, // 0: trainer.v1.TrainRequest.train_gnn_request:type_name -> trainer.v1.TrainGNNRequest
-	1, // 1: trainer.v1.TrainRequest.train_mlp_request:type_name -> trainer.v1.TrainMLPRequest
-	2, // 2: trainer.v1.Trainer.Train:input_type -> trainer.v1.TrainRequest
-	3, // 3: trainer.v1.Trainer.Train:output_type -> google.protobuf.Empty
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use NetworkTopologyRecord.ProtoReflect.Descriptor instead.
+func (*NetworkTopologyRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_pkg_apis_trainer_v1_trainer_proto_init() }
-func file_pkg_apis_trainer_v1_trainer_proto_init() {
-	if File_pkg_apis_trainer_v1_trainer_proto != nil {
-		return
+func (x *NetworkTopologyRecord) GetSrcHost() *v2.Host {
+	if x != nil {
+		return x.SrcHost
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainGNNRequest); i {
+	return nil
+}
+
+func (x *NetworkTopologyRecord) GetDestHost() *v2.Host {
+	if x != nil {
+		return x.DestHost
+	}
+	return nil
+}
+
+func (x *NetworkTopologyRecord) GetAverageRtt() *durationpb.Duration {
+	if x != nil {
+		return x.AverageRtt
+	}
+	return nil
+}
+
+func (x *NetworkTopologyRecord) GetMinRtt() *durationpb.Duration {
+	if x != nil {
+		return x.MinRtt
+	}
+	return nil
+}
+
+func (x *NetworkTopologyRecord) GetMaxRtt() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRtt
+	}
+	return nil
+}
+
+func (x *NetworkTopologyRecord) GetLossRate() float64 {
+	if x != nil {
+		return x.LossRate
+	}
+	return 0
+}
+
+func (x *NetworkTopologyRecord) GetThroughput() uint64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *NetworkTopologyRecord) GetProbeCount() int64 {
+	if x != nil {
+		return x.ProbeCount
+	}
+	return 0
+}
+
+func (x *NetworkTopologyRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// TrainGNNRequest represents to train GNN model request of TrainRequest, exactly one of
+// dataset and dataset_id must be set, and the trainer rejects the request which sets both
+// or neither of them, refer to dataset.TrainDataset of d7y.io/api/v2/pkg/dataset.
+type TrainGNNRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chunk of NETWORK_TOPOLOGY_DATASET, the chunks of the stream are concatenated
+	// as the dataset which is encoded by d7y.io/api/v2/pkg/dataset,
+	// it must be empty if dataset_id is set.
+	Dataset []byte `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// ID of the committed NETWORK_TOPOLOGY_DATASET uploaded by UploadDataset,
+	// it must be empty if dataset is set.
+	DatasetId string `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *TrainGNNRequest) Reset() {
+	*x = TrainGNNRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainGNNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainGNNRequest) ProtoMessage() {}
+
+func (x *TrainGNNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainGNNRequest.ProtoReflect.Descriptor instead.
+func (*TrainGNNRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrainGNNRequest) GetDataset() []byte {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *TrainGNNRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+// TrainMLPRequest represents to train MLP model request of TrainRequest, exactly one of
+// dataset and dataset_id must be set, and the trainer rejects the request which sets both
+// or neither of them, refer to dataset.TrainDataset of d7y.io/api/v2/pkg/dataset.
+type TrainMLPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chunk of DOWNLOAD_DATASET, the chunks of the stream are concatenated
+	// as the dataset which is encoded by d7y.io/api/v2/pkg/dataset,
+	// it must be empty if dataset_id is set.
+	Dataset []byte `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// ID of the committed DOWNLOAD_DATASET uploaded by UploadDataset,
+	// it must be empty if dataset is set.
+	DatasetId string `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *TrainMLPRequest) Reset() {
+	*x = TrainMLPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainMLPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainMLPRequest) ProtoMessage() {}
+
+func (x *TrainMLPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainMLPRequest.ProtoReflect.Descriptor instead.
+func (*TrainMLPRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrainMLPRequest) GetDataset() []byte {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *TrainMLPRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+// StartUploadDatasetRequest represents start upload dataset request of UploadDatasetRequest,
+// it is the first request of the stream.
+type StartUploadDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dataset id generated by the scheduler, the upload is resumed if the dataset id exists.
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	// Dataset type.
+	Type DatasetType `protobuf:"varint,2,opt,name=type,proto3,enum=trainer.v1.DatasetType" json:"type,omitempty"`
+}
+
+func (x *StartUploadDatasetRequest) Reset() {
+	*x = StartUploadDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartUploadDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUploadDatasetRequest) ProtoMessage() {}
+
+func (x *StartUploadDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUploadDatasetRequest.ProtoReflect.Descriptor instead.
+func (*StartUploadDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartUploadDatasetRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *StartUploadDatasetRequest) GetType() DatasetType {
+	if x != nil {
+		return x.Type
+	}
+	return DatasetType_DOWNLOAD_DATASET
+}
+
+// UploadDatasetChunkRequest represents upload dataset chunk request of UploadDatasetRequest.
+type UploadDatasetChunkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the chunk, it is increased by one from the next sequence
+	// of UploadDatasetStartedResponse.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Offset of the chunk in the dataset, it must be equal to the end offset of the previous chunk.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Chunk data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadDatasetChunkRequest) Reset() {
+	*x = UploadDatasetChunkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDatasetChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDatasetChunkRequest) ProtoMessage() {}
+
+func (x *UploadDatasetChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDatasetChunkRequest.ProtoReflect.Descriptor instead.
+func (*UploadDatasetChunkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadDatasetChunkRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UploadDatasetChunkRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadDatasetChunkRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CommitDatasetRequest represents commit dataset request of UploadDatasetRequest,
+// it is the last request of the stream.
+type CommitDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total size of the dataset.
+	Size uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// Digest of the dataset, for example sha256:xxx.
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *CommitDatasetRequest) Reset() {
+	*x = CommitDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitDatasetRequest) ProtoMessage() {}
+
+func (x *CommitDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitDatasetRequest.ProtoReflect.Descriptor instead.
+func (*CommitDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommitDatasetRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CommitDatasetRequest) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// UploadDatasetRequest represents request of UploadDataset.
+type UploadDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scheduler hostname.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Scheduler ip.
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*UploadDatasetRequest_StartUploadDatasetRequest
+	//	*UploadDatasetRequest_UploadDatasetChunkRequest
+	//	*UploadDatasetRequest_CommitDatasetRequest
+	Request isUploadDatasetRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *UploadDatasetRequest) Reset() {
+	*x = UploadDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDatasetRequest) ProtoMessage() {}
+
+func (x *UploadDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDatasetRequest.ProtoReflect.Descriptor instead.
+func (*UploadDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadDatasetRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *UploadDatasetRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (m *UploadDatasetRequest) GetRequest() isUploadDatasetRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *UploadDatasetRequest) GetStartUploadDatasetRequest() *StartUploadDatasetRequest {
+	if x, ok := x.GetRequest().(*UploadDatasetRequest_StartUploadDatasetRequest); ok {
+		return x.StartUploadDatasetRequest
+	}
+	return nil
+}
+
+func (x *UploadDatasetRequest) GetUploadDatasetChunkRequest() *UploadDatasetChunkRequest {
+	if x, ok := x.GetRequest().(*UploadDatasetRequest_UploadDatasetChunkRequest); ok {
+		return x.UploadDatasetChunkRequest
+	}
+	return nil
+}
+
+func (x *UploadDatasetRequest) GetCommitDatasetRequest() *CommitDatasetRequest {
+	if x, ok := x.GetRequest().(*UploadDatasetRequest_CommitDatasetRequest); ok {
+		return x.CommitDatasetRequest
+	}
+	return nil
+}
+
+type isUploadDatasetRequest_Request interface {
+	isUploadDatasetRequest_Request()
+}
+
+type UploadDatasetRequest_StartUploadDatasetRequest struct {
+	StartUploadDatasetRequest *StartUploadDatasetRequest `protobuf:"bytes,3,opt,name=start_upload_dataset_request,json=startUploadDatasetRequest,proto3,oneof"`
+}
+
+type UploadDatasetRequest_UploadDatasetChunkRequest struct {
+	UploadDatasetChunkRequest *UploadDatasetChunkRequest `protobuf:"bytes,4,opt,name=upload_dataset_chunk_request,json=uploadDatasetChunkRequest,proto3,oneof"`
+}
+
+type UploadDatasetRequest_CommitDatasetRequest struct {
+	CommitDatasetRequest *CommitDatasetRequest `protobuf:"bytes,5,opt,name=commit_dataset_request,json=commitDatasetRequest,proto3,oneof"`
+}
+
+func (*UploadDatasetRequest_StartUploadDatasetRequest) isUploadDatasetRequest_Request() {}
+
+func (*UploadDatasetRequest_UploadDatasetChunkRequest) isUploadDatasetRequest_Request() {}
+
+func (*UploadDatasetRequest_CommitDatasetRequest) isUploadDatasetRequest_Request() {}
+
+// UploadDatasetStartedResponse represents upload dataset started response of UploadDatasetResponse,
+// the scheduler resumes the upload from the acknowledged offset.
+type UploadDatasetStartedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Acknowledged offset of the dataset, zero represents the new dataset.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Next sequence number of the chunk.
+	NextSequence uint64 `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+}
+
+func (x *UploadDatasetStartedResponse) Reset() {
+	*x = UploadDatasetStartedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDatasetStartedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDatasetStartedResponse) ProtoMessage() {}
+
+func (x *UploadDatasetStartedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDatasetStartedResponse.ProtoReflect.Descriptor instead.
+func (*UploadDatasetStartedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadDatasetStartedResponse) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadDatasetStartedResponse) GetNextSequence() uint64 {
+	if x != nil {
+		return x.NextSequence
+	}
+	return 0
+}
+
+// UploadDatasetChunkAckResponse represents upload dataset chunk ack response of UploadDatasetResponse,
+// it acknowledges that the chunks are persisted by the trainer.
+type UploadDatasetChunkAckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the last persisted chunk.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Acknowledged offset of the dataset, it is the end offset of the last persisted chunk.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *UploadDatasetChunkAckResponse) Reset() {
+	*x = UploadDatasetChunkAckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDatasetChunkAckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDatasetChunkAckResponse) ProtoMessage() {}
+
+func (x *UploadDatasetChunkAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDatasetChunkAckResponse.ProtoReflect.Descriptor instead.
+func (*UploadDatasetChunkAckResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UploadDatasetChunkAckResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UploadDatasetChunkAckResponse) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// DatasetCommittedResponse represents dataset committed response of UploadDatasetResponse.
+type DatasetCommittedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total size of the dataset.
+	Size uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// Digest of the dataset.
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *DatasetCommittedResponse) Reset() {
+	*x = DatasetCommittedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatasetCommittedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasetCommittedResponse) ProtoMessage() {}
+
+func (x *DatasetCommittedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasetCommittedResponse.ProtoReflect.Descriptor instead.
+func (*DatasetCommittedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DatasetCommittedResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DatasetCommittedResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+// UploadDatasetResponse represents response of UploadDataset.
+type UploadDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*UploadDatasetResponse_UploadDatasetStartedResponse
+	//	*UploadDatasetResponse_UploadDatasetChunkAckResponse
+	//	*UploadDatasetResponse_DatasetCommittedResponse
+	Response isUploadDatasetResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *UploadDatasetResponse) Reset() {
+	*x = UploadDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDatasetResponse) ProtoMessage() {}
+
+func (x *UploadDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDatasetResponse.ProtoReflect.Descriptor instead.
+func (*UploadDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *UploadDatasetResponse) GetResponse() isUploadDatasetResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UploadDatasetResponse) GetUploadDatasetStartedResponse() *UploadDatasetStartedResponse {
+	if x, ok := x.GetResponse().(*UploadDatasetResponse_UploadDatasetStartedResponse); ok {
+		return x.UploadDatasetStartedResponse
+	}
+	return nil
+}
+
+func (x *UploadDatasetResponse) GetUploadDatasetChunkAckResponse() *UploadDatasetChunkAckResponse {
+	if x, ok := x.GetResponse().(*UploadDatasetResponse_UploadDatasetChunkAckResponse); ok {
+		return x.UploadDatasetChunkAckResponse
+	}
+	return nil
+}
+
+func (x *UploadDatasetResponse) GetDatasetCommittedResponse() *DatasetCommittedResponse {
+	if x, ok := x.GetResponse().(*UploadDatasetResponse_DatasetCommittedResponse); ok {
+		return x.DatasetCommittedResponse
+	}
+	return nil
+}
+
+type isUploadDatasetResponse_Response interface {
+	isUploadDatasetResponse_Response()
+}
+
+type UploadDatasetResponse_UploadDatasetStartedResponse struct {
+	UploadDatasetStartedResponse *UploadDatasetStartedResponse `protobuf:"bytes,1,opt,name=upload_dataset_started_response,json=uploadDatasetStartedResponse,proto3,oneof"`
+}
+
+type UploadDatasetResponse_UploadDatasetChunkAckResponse struct {
+	UploadDatasetChunkAckResponse *UploadDatasetChunkAckResponse `protobuf:"bytes,2,opt,name=upload_dataset_chunk_ack_response,json=uploadDatasetChunkAckResponse,proto3,oneof"`
+}
+
+type UploadDatasetResponse_DatasetCommittedResponse struct {
+	DatasetCommittedResponse *DatasetCommittedResponse `protobuf:"bytes,3,opt,name=dataset_committed_response,json=datasetCommittedResponse,proto3,oneof"`
+}
+
+func (*UploadDatasetResponse_UploadDatasetStartedResponse) isUploadDatasetResponse_Response() {}
+
+func (*UploadDatasetResponse_UploadDatasetChunkAckResponse) isUploadDatasetResponse_Response() {}
+
+func (*UploadDatasetResponse_DatasetCommittedResponse) isUploadDatasetResponse_Response() {}
+
+// TrainRequest represents request of Train and CreateTrainingJobs.
+type TrainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scheduler hostname.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Scheduler ip.
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*TrainRequest_TrainGnnRequest
+	//	*TrainRequest_TrainMlpRequest
+	Request isTrainRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *TrainRequest) Reset() {
+	*x = TrainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainRequest) ProtoMessage() {}
+
+func (x *TrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainRequest.ProtoReflect.Descriptor instead.
+func (*TrainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrainRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *TrainRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (m *TrainRequest) GetRequest() isTrainRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *TrainRequest) GetTrainGnnRequest() *TrainGNNRequest {
+	if x, ok := x.GetRequest().(*TrainRequest_TrainGnnRequest); ok {
+		return x.TrainGnnRequest
+	}
+	return nil
+}
+
+func (x *TrainRequest) GetTrainMlpRequest() *TrainMLPRequest {
+	if x, ok := x.GetRequest().(*TrainRequest_TrainMlpRequest); ok {
+		return x.TrainMlpRequest
+	}
+	return nil
+}
+
+type isTrainRequest_Request interface {
+	isTrainRequest_Request()
+}
+
+type TrainRequest_TrainGnnRequest struct {
+	TrainGnnRequest *TrainGNNRequest `protobuf:"bytes,3,opt,name=train_gnn_request,json=trainGnnRequest,proto3,oneof"`
+}
+
+type TrainRequest_TrainMlpRequest struct {
+	TrainMlpRequest *TrainMLPRequest `protobuf:"bytes,4,opt,name=train_mlp_request,json=trainMlpRequest,proto3,oneof"`
+}
+
+func (*TrainRequest_TrainGnnRequest) isTrainRequest_Request() {}
+
+func (*TrainRequest_TrainMlpRequest) isTrainRequest_Request() {}
+
+// TrainingJob represents job of training model.
+type TrainingJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Training job id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type of the trained model, GNN model is trained by TrainGNNRequest and MLP model
+	// is trained by TrainMLPRequest.
+	ModelType v21.ModelType `protobuf:"varint,2,opt,name=model_type,json=modelType,proto3,enum=manager.v2.ModelType" json:"model_type,omitempty"`
+	// Training job state.
+	State TrainingJobState `protobuf:"varint,3,opt,name=state,proto3,enum=trainer.v1.TrainingJobState" json:"state,omitempty"`
+	// Scheduler hostname.
+	Hostname string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Scheduler ip.
+	Ip string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Current epoch of training.
+	Epoch uint32 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Total epochs of training.
+	TotalEpochs uint32 `protobuf:"varint,7,opt,name=total_epochs,json=totalEpochs,proto3" json:"total_epochs,omitempty"`
+	// Training loss of the current epoch.
+	Loss float64 `protobuf:"fixed64,8,opt,name=loss,proto3" json:"loss,omitempty"`
+	// Evaluation of the trained model, it is set when the job is succeeded.
+	//
+	// Types that are assignable to Evaluation:
+	//
+	//	*TrainingJob_GnnEvaluation
+	//	*TrainingJob_MlpEvaluation
+	Evaluation isTrainingJob_Evaluation `protobuf_oneof:"evaluation"`
+	// Name of the model created in the manager, refer to manager.v2.Model.
+	ModelName string `protobuf:"bytes,11,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	// Version of the model created in the manager.
+	ModelVersion uint64 `protobuf:"varint,12,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	// The description of the training job failed.
+	Description string `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	// Training job create time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Training job update time.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *TrainingJob) Reset() {
+	*x = TrainingJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingJob) ProtoMessage() {}
+
+func (x *TrainingJob) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainingJob.ProtoReflect.Descriptor instead.
+func (*TrainingJob) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TrainingJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrainingJob) GetModelType() v21.ModelType {
+	if x != nil {
+		return x.ModelType
+	}
+	return v21.ModelType(0)
+}
+
+func (x *TrainingJob) GetState() TrainingJobState {
+	if x != nil {
+		return x.State
+	}
+	return TrainingJobState_PENDING_JOB
+}
+
+func (x *TrainingJob) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *TrainingJob) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *TrainingJob) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TrainingJob) GetTotalEpochs() uint32 {
+	if x != nil {
+		return x.TotalEpochs
+	}
+	return 0
+}
+
+func (x *TrainingJob) GetLoss() float64 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (m *TrainingJob) GetEvaluation() isTrainingJob_Evaluation {
+	if m != nil {
+		return m.Evaluation
+	}
+	return nil
+}
+
+func (x *TrainingJob) GetGnnEvaluation() *v21.GNNEvaluation {
+	if x, ok := x.GetEvaluation().(*TrainingJob_GnnEvaluation); ok {
+		return x.GnnEvaluation
+	}
+	return nil
+}
+
+func (x *TrainingJob) GetMlpEvaluation() *v21.MLPEvaluation {
+	if x, ok := x.GetEvaluation().(*TrainingJob_MlpEvaluation); ok {
+		return x.MlpEvaluation
+	}
+	return nil
+}
+
+func (x *TrainingJob) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *TrainingJob) GetModelVersion() uint64 {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return 0
+}
+
+func (x *TrainingJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TrainingJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TrainingJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type isTrainingJob_Evaluation interface {
+	isTrainingJob_Evaluation()
+}
+
+type TrainingJob_GnnEvaluation struct {
+	GnnEvaluation *v21.GNNEvaluation `protobuf:"bytes,9,opt,name=gnn_evaluation,json=gnnEvaluation,proto3,oneof"`
+}
+
+type TrainingJob_MlpEvaluation struct {
+	MlpEvaluation *v21.MLPEvaluation `protobuf:"bytes,10,opt,name=mlp_evaluation,json=mlpEvaluation,proto3,oneof"`
+}
+
+func (*TrainingJob_GnnEvaluation) isTrainingJob_Evaluation() {}
+
+func (*TrainingJob_MlpEvaluation) isTrainingJob_Evaluation() {}
+
+// CreateTrainingJobsResponse represents response of CreateTrainingJobs.
+type CreateTrainingJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Training jobs created by the datasets, one training job is created for each model type.
+	Jobs []*TrainingJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *CreateTrainingJobsResponse) Reset() {
+	*x = CreateTrainingJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTrainingJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrainingJobsResponse) ProtoMessage() {}
+
+func (x *CreateTrainingJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrainingJobsResponse.ProtoReflect.Descriptor instead.
+func (*CreateTrainingJobsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateTrainingJobsResponse) GetJobs() []*TrainingJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// GetTrainingJobRequest represents request of GetTrainingJob.
+type GetTrainingJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Training job id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTrainingJobRequest) Reset() {
+	*x = GetTrainingJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrainingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingJobRequest) ProtoMessage() {}
+
+func (x *GetTrainingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainingJobRequest.ProtoReflect.Descriptor instead.
+func (*GetTrainingJobRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTrainingJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListTrainingJobsRequest represents request of ListTrainingJobs.
+type ListTrainingJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scheduler hostname used to filter training jobs, empty hostname represents all schedulers.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Training job states used to filter training jobs, empty states represents all training jobs.
+	States []TrainingJobState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=trainer.v1.TrainingJobState" json:"states,omitempty"`
+	// Maximum number of training jobs to return, the server may return fewer.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token returned by the previous ListTrainingJobs,
+	// empty page token represents the first page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTrainingJobsRequest) Reset() {
+	*x = ListTrainingJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrainingJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrainingJobsRequest) ProtoMessage() {}
+
+func (x *ListTrainingJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrainingJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrainingJobsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTrainingJobsRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ListTrainingJobsRequest) GetStates() []TrainingJobState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListTrainingJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrainingJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTrainingJobsResponse represents response of ListTrainingJobs.
+type ListTrainingJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Training jobs in descending order of create time.
+	Jobs []*TrainingJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// Page token of the next page, empty page token represents there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTrainingJobsResponse) Reset() {
+	*x = ListTrainingJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrainingJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrainingJobsResponse) ProtoMessage() {}
+
+func (x *ListTrainingJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_trainer_v1_trainer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrainingJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrainingJobsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTrainingJobsResponse) GetJobs() []*TrainingJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListTrainingJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_pkg_apis_trainer_v1_trainer_proto protoreflect.FileDescriptor
+
+var file_pkg_apis_trainer_v1_trainer_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x0d, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x0b, 0x50, 0x69, 0x65, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x43, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22,
+	0x56, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0xeb, 0x04, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x09,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x10, 0xfa, 0x42, 0x0d, 0x22, 0x0b, 0x28, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x1a, 0x0b, 0x28, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x0a, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x69, 0x65,
+	0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x06, 0x70, 0x69, 0x65, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf8, 0x03, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x34, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x72,
+	0x63, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x0b, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x74, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x6d, 0x69, 0x6e, 0x52, 0x74, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x74, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x74, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17,
+	0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x6c, 0x6f, 0x73, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x64, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x4e, 0x4e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x10, 0x01, 0x70, 0x01, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42,
+	0x0a, 0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x08, 0xd0, 0x01, 0x01, 0x52, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x4d,
+	0x4c, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x7a,
+	0x04, 0x10, 0x01, 0x70, 0x01, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x2c,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x08, 0xd0, 0x01,
+	0x01, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x19,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x19, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x7a,
+	0x07, 0x10, 0x01, 0x18, 0x80, 0x80, 0x80, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x63,
+	0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72,
+	0x1a, 0x32, 0x18, 0x5e, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x3a, 0x5b, 0x41, 0x2d, 0x46, 0x61,
+	0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x68, 0x0a, 0x1c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x1c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x19, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58,
+	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x5b, 0x0a, 0x1c, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x53, 0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x67, 0x0a, 0x18, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72,
+	0x1a, 0x32, 0x18, 0x5e, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x3a, 0x5b, 0x41, 0x2d, 0x46, 0x61,
+	0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
+	0x1f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x1c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x75, 0x0a, 0x21, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1a, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x18, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xf2,
+	0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x49, 0x0a,
+	0x11, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x67, 0x6e, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x4e, 0x4e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x6e,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x6d, 0x6c, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x4c, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x03,
+	0xf8, 0x42, 0x01, 0x22, 0xd1, 0x05, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x4a, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0a,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x12, 0x22, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x67, 0x6e, 0x6e, 0x5f, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x4e, 0x4e, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x67, 0x6e, 0x6e, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0e, 0x6d, 0x6c, 0x70,
+	0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x4c, 0x50, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d,
+	0x6d, 0x6c, 0x70, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x30, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6,
+	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x92, 0x01, 0x09, 0x22, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0c, 0xfa, 0x42, 0x09, 0x1a, 0x07, 0x18, 0x90, 0x4e, 0x28, 0x01, 0x40, 0x01, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x41, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x4f, 0x57, 0x4e, 0x4c,
+	0x4f, 0x41, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47,
+	0x59, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x10, 0x01, 0x2a, 0x3b, 0x0a, 0x0d, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x44, 0x4f,
+	0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x01, 0x2a, 0x57, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4a, 0x4f, 0x42, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4a, 0x4f, 0x42, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x4a, 0x4f, 0x42, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4a, 0x4f, 0x42, 0x10,
+	0x03, 0x32, 0xa7, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a,
+	0x05, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x58, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x58, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4c,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62,
+	0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x12, 0x5d, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x64,
+	0x37, 0x79, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x76, 0x31,
+	0x3b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_apis_trainer_v1_trainer_proto_rawDescOnce sync.Once
+	file_pkg_apis_trainer_v1_trainer_proto_rawDescData = file_pkg_apis_trainer_v1_trainer_proto_rawDesc
+)
+
+func file_pkg_apis_trainer_v1_trainer_proto_rawDescGZIP() []byte {
+	file_pkg_apis_trainer_v1_trainer_proto_rawDescOnce.Do(func() {
+		file_pkg_apis_trainer_v1_trainer_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_apis_trainer_v1_trainer_proto_rawDescData)
+	})
+	return file_pkg_apis_trainer_v1_trainer_proto_rawDescData
+}
+
+var file_pkg_apis_trainer_v1_trainer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_apis_trainer_v1_trainer_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_pkg_apis_trainer_v1_trainer_proto_goTypes = []interface{}{
+	(DatasetType)(0),                      // 0: trainer.v1.DatasetType
+	(DownloadState)(0),                    // 1: trainer.v1.DownloadState
+	(TrainingJobState)(0),                 // 2: trainer.v1.TrainingJobState
+	(*DatasetHeader)(nil),                 // 3: trainer.v1.DatasetHeader
+	(*PieceRecord)(nil),                   // 4: trainer.v1.PieceRecord
+	(*ParentRecord)(nil),                  // 5: trainer.v1.ParentRecord
+	(*DownloadRecord)(nil),                // 6: trainer.v1.DownloadRecord
+	(*NetworkTopologyRecord)(nil),         // 7: trainer.v1.NetworkTopologyRecord
+	(*TrainGNNRequest)(nil),               // 8: trainer.v1.TrainGNNRequest
+	(*TrainMLPRequest)(nil),               // 9: trainer.v1.TrainMLPRequest
+	(*StartUploadDatasetRequest)(nil),     // 10: trainer.v1.StartUploadDatasetRequest
+	(*UploadDatasetChunkRequest)(nil),     // 11: trainer.v1.UploadDatasetChunkRequest
+	(*CommitDatasetRequest)(nil),          // 12: trainer.v1.CommitDatasetRequest
+	(*UploadDatasetRequest)(nil),          // 13: trainer.v1.UploadDatasetRequest
+	(*UploadDatasetStartedResponse)(nil),  // 14: trainer.v1.UploadDatasetStartedResponse
+	(*UploadDatasetChunkAckResponse)(nil), // 15: trainer.v1.UploadDatasetChunkAckResponse
+	(*DatasetCommittedResponse)(nil),      // 16: trainer.v1.DatasetCommittedResponse
+	(*UploadDatasetResponse)(nil),         // 17: trainer.v1.UploadDatasetResponse
+	(*TrainRequest)(nil),                  // 18: trainer.v1.TrainRequest
+	(*TrainingJob)(nil),                   // 19: trainer.v1.TrainingJob
+	(*CreateTrainingJobsResponse)(nil),    // 20: trainer.v1.CreateTrainingJobsResponse
+	(*GetTrainingJobRequest)(nil),         // 21: trainer.v1.GetTrainingJobRequest
+	(*ListTrainingJobsRequest)(nil),       // 22: trainer.v1.ListTrainingJobsRequest
+	(*ListTrainingJobsResponse)(nil),      // 23: trainer.v1.ListTrainingJobsResponse
+	(v2.TrafficType)(0),                   // 24: common.v2.TrafficType
+	(*durationpb.Duration)(nil),           // 25: google.protobuf.Duration
+	(*v2.Host)(nil),                       // 26: common.v2.Host
+	(v2.TaskType)(0),                      // 27: common.v2.TaskType
+	(*timestamppb.Timestamp)(nil),         // 28: google.protobuf.Timestamp
+	(v21.ModelType)(0),                    // 29: manager.v2.ModelType
+	(*v21.GNNEvaluation)(nil),             // 30: manager.v2.GNNEvaluation
+	(*v21.MLPEvaluation)(nil),             // 31: manager.v2.MLPEvaluation
+	(*emptypb.Empty)(nil),                 // 32: google.protobuf.Empty
+}
+var file_pkg_apis_trainer_v1_trainer_proto_depIdxs = []int32{
+	0,  // 0: trainer.v1.DatasetHeader.type:type_name -> trainer.v1.DatasetType
+	24, // 1: trainer.v1.PieceRecord.traffic_type:type_name -> common.v2.TrafficType
+	25, // 2: trainer.v1.PieceRecord.cost:type_name -> google.protobuf.Duration
+	26, // 3: trainer.v1.ParentRecord.host:type_name -> common.v2.Host
+	27, // 4: trainer.v1.DownloadRecord.task_type:type_name -> common.v2.TaskType
+	26, // 5: trainer.v1.DownloadRecord.host:type_name -> common.v2.Host
+	5,  // 6: trainer.v1.DownloadRecord.parents:type_name -> trainer.v1.ParentRecord
+	4,  // 7: trainer.v1.DownloadRecord.pieces:type_name -> trainer.v1.PieceRecord
+	1,  // 8: trainer.v1.DownloadRecord.state:type_name -> trainer.v1.DownloadState
+	25, // 9: trainer.v1.DownloadRecord.cost:type_name -> google.protobuf.Duration
+	28, // 10: trainer.v1.DownloadRecord.created_at:type_name -> google.protobuf.Timestamp
+	26, // 11: trainer.v1.NetworkTopologyRecord.src_host:type_name -> common.v2.Host
+	26, // 12: trainer.v1.NetworkTopologyRecord.dest_host:type_name -> common.v2.Host
+	25, // 13: trainer.v1.NetworkTopologyRecord.average_rtt:type_name -> google.protobuf.Duration
+	25, // 14: trainer.v1.NetworkTopologyRecord.min_rtt:type_name -> google.protobuf.Duration
+	25, // 15: trainer.v1.NetworkTopologyRecord.max_rtt:type_name -> google.protobuf.Duration
+	28, // 16: trainer.v1.NetworkTopologyRecord.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: trainer.v1.StartUploadDatasetRequest.type:type_name -> trainer.v1.DatasetType
+	10, // 18: trainer.v1.UploadDatasetRequest.start_upload_dataset_request:type_name -> trainer.v1.StartUploadDatasetRequest
+	11, // 19: trainer.v1.UploadDatasetRequest.upload_dataset_chunk_request:type_name -> trainer.v1.UploadDatasetChunkRequest
+	12, // 20: trainer.v1.UploadDatasetRequest.commit_dataset_request:type_name -> trainer.v1.CommitDatasetRequest
+	14, // 21: trainer.v1.UploadDatasetResponse.upload_dataset_started_response:type_name -> trainer.v1.UploadDatasetStartedResponse
+	15, // 22: trainer.v1.UploadDatasetResponse.upload_dataset_chunk_ack_response:type_name -> trainer.v1.UploadDatasetChunkAckResponse
+	16, // 23: trainer.v1.UploadDatasetResponse.dataset_committed_response:type_name -> trainer.v1.DatasetCommittedResponse
+	8,  // 24: trainer.v1.TrainRequest.train_gnn_request:type_name -> trainer.v1.TrainGNNRequest
+	9,  // 25: trainer.v1.TrainRequest.train_mlp_request:type_name -> trainer.v1.TrainMLPRequest
+	29, // 26: trainer.v1.TrainingJob.model_type:type_name -> manager.v2.ModelType
+	2,  // 27: trainer.v1.TrainingJob.state:type_name -> trainer.v1.TrainingJobState
+	30, // 28: trainer.v1.TrainingJob.gnn_evaluation:type_name -> manager.v2.GNNEvaluation
+	31, // 29: trainer.v1.TrainingJob.mlp_evaluation:type_name -> manager.v2.MLPEvaluation
+	28, // 30: trainer.v1.TrainingJob.created_at:type_name -> google.protobuf.Timestamp
+	28, // 31: trainer.v1.TrainingJob.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 32: trainer.v1.CreateTrainingJobsResponse.jobs:type_name -> trainer.v1.TrainingJob
+	2,  // 33: trainer.v1.ListTrainingJobsRequest.states:type_name -> trainer.v1.TrainingJobState
+	19, // 34: trainer.v1.ListTrainingJobsResponse.jobs:type_name -> trainer.v1.TrainingJob
+	18, // 35: trainer.v1.Trainer.Train:input_type -> trainer.v1.TrainRequest
+	18, // 36: trainer.v1.Trainer.CreateTrainingJobs:input_type -> trainer.v1.TrainRequest
+	13, // 37: trainer.v1.Trainer.UploadDataset:input_type -> trainer.v1.UploadDatasetRequest
+	21, // 38: trainer.v1.Trainer.GetTrainingJob:input_type -> trainer.v1.GetTrainingJobRequest
+	22, // 39: trainer.v1.Trainer.ListTrainingJobs:input_type -> trainer.v1.ListTrainingJobsRequest
+	32, // 40: trainer.v1.Trainer.Train:output_type -> google.protobuf.Empty
+	20, // 41: trainer.v1.Trainer.CreateTrainingJobs:output_type -> trainer.v1.CreateTrainingJobsResponse
+	17, // 42: trainer.v1.Trainer.UploadDataset:output_type -> trainer.v1.UploadDatasetResponse
+	19, // 43: trainer.v1.Trainer.GetTrainingJob:output_type -> trainer.v1.TrainingJob
+	23, // 44: trainer.v1.Trainer.ListTrainingJobs:output_type -> trainer.v1.ListTrainingJobsResponse
+	40, // [40:45] is the sub-list for method output_type
+	35, // [35:40] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_pkg_apis_trainer_v1_trainer_proto_init() }
+func file_pkg_apis_trainer_v1_trainer_proto_init() {
+	if File_pkg_apis_trainer_v1_trainer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatasetHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -327,7 +2372,7 @@ func file_pkg_apis_trainer_v1_trainer_proto_init() {
 			}
 		}
 		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrainMLPRequest); i {
+			switch v := v.(*PieceRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -339,6 +2384,162 @@ func file_pkg_apis_trainer_v1_trainer_proto_init() {
 			}
 		}
 		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParentRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkTopologyRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainGNNRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainMLPRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartUploadDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDatasetChunkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDatasetStartedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDatasetChunkAckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatasetCommittedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDatasetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrainRequest); i {
 			case 0:
 				return &v.state
@@ -350,23 +2551,98 @@ func file_pkg_apis_trainer_v1_trainer_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTrainingJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrainingJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrainingJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_trainer_v1_trainer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrainingJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_apis_trainer_v1_trainer_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*UploadDatasetRequest_StartUploadDatasetRequest)(nil),
+		(*UploadDatasetRequest_UploadDatasetChunkRequest)(nil),
+		(*UploadDatasetRequest_CommitDatasetRequest)(nil),
 	}
-	file_pkg_apis_trainer_v1_trainer_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_pkg_apis_trainer_v1_trainer_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*UploadDatasetResponse_UploadDatasetStartedResponse)(nil),
+		(*UploadDatasetResponse_UploadDatasetChunkAckResponse)(nil),
+		(*UploadDatasetResponse_DatasetCommittedResponse)(nil),
+	}
+	file_pkg_apis_trainer_v1_trainer_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*TrainRequest_TrainGnnRequest)(nil),
 		(*TrainRequest_TrainMlpRequest)(nil),
 	}
+	file_pkg_apis_trainer_v1_trainer_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*TrainingJob_GnnEvaluation)(nil),
+		(*TrainingJob_MlpEvaluation)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_apis_trainer_v1_trainer_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_apis_trainer_v1_trainer_proto_goTypes,
 		DependencyIndexes: file_pkg_apis_trainer_v1_trainer_proto_depIdxs,
+		EnumInfos:         file_pkg_apis_trainer_v1_trainer_proto_enumTypes,
 		MessageInfos:      file_pkg_apis_trainer_v1_trainer_proto_msgTypes,
 	}.Build()
 	File_pkg_apis_trainer_v1_trainer_proto = out.File
@@ -17,6 +17,10 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	common "d7y.io/api/v2/pkg/apis/common/v2"
+
+	manager "d7y.io/api/v2/pkg/apis/manager/v2"
 )
 
 // ensure the imports are used
@@ -33,34 +37,49 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = common.TrafficType(0)
+
+	_ = manager.ModelType(0)
 )
 
-// Validate checks the field values on TrainGNNRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TrainGNNRequest) Validate() error {
+// Validate checks the field values on DatasetHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatasetHeader) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TrainGNNRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TrainGNNRequestMultiError, or nil if none found.
-func (m *TrainGNNRequest) ValidateAll() error {
+// ValidateAll checks the field values on DatasetHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatasetHeaderMultiError, or
+// nil if none found.
+func (m *DatasetHeader) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TrainGNNRequest) validate(all bool) error {
+func (m *DatasetHeader) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetDataset()) < 1 {
-		err := TrainGNNRequestValidationError{
-			field:  "Dataset",
-			reason: "value length must be at least 1 bytes",
+	if m.GetSchemaVersion() < 1 {
+		err := DatasetHeaderValidationError{
+			field:  "SchemaVersion",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DatasetType_name[int32(m.GetType())]; !ok {
+		err := DatasetHeaderValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -69,19 +88,19 @@ func (m *TrainGNNRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TrainGNNRequestMultiError(errors)
+		return DatasetHeaderMultiError(errors)
 	}
 
 	return nil
 }
 
-// TrainGNNRequestMultiError is an error wrapping multiple validation errors
-// returned by TrainGNNRequest.ValidateAll() if the designated constraints
+// DatasetHeaderMultiError is an error wrapping multiple validation errors
+// returned by DatasetHeader.ValidateAll() if the designated constraints
 // aren't met.
-type TrainGNNRequestMultiError []error
+type DatasetHeaderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TrainGNNRequestMultiError) Error() string {
+func (m DatasetHeaderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -90,11 +109,11 @@ func (m TrainGNNRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TrainGNNRequestMultiError) AllErrors() []error { return m }
+func (m DatasetHeaderMultiError) AllErrors() []error { return m }
 
-// TrainGNNRequestValidationError is the validation error returned by
-// TrainGNNRequest.Validate if the designated constraints aren't met.
-type TrainGNNRequestValidationError struct {
+// DatasetHeaderValidationError is the validation error returned by
+// DatasetHeader.Validate if the designated constraints aren't met.
+type DatasetHeaderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -102,22 +121,22 @@ type TrainGNNRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TrainGNNRequestValidationError) Field() string { return e.field }
+func (e DatasetHeaderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TrainGNNRequestValidationError) Reason() string { return e.reason }
+func (e DatasetHeaderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TrainGNNRequestValidationError) Cause() error { return e.cause }
+func (e DatasetHeaderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TrainGNNRequestValidationError) Key() bool { return e.key }
+func (e DatasetHeaderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TrainGNNRequestValidationError) ErrorName() string { return "TrainGNNRequestValidationError" }
+func (e DatasetHeaderValidationError) ErrorName() string { return "DatasetHeaderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TrainGNNRequestValidationError) Error() string {
+func (e DatasetHeaderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -129,14 +148,14 @@ func (e TrainGNNRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTrainGNNRequest.%s: %s%s",
+		"invalid %sDatasetHeader.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TrainGNNRequestValidationError{}
+var _ error = DatasetHeaderValidationError{}
 
 var _ interface {
 	Field() string
@@ -144,34 +163,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TrainGNNRequestValidationError{}
+} = DatasetHeaderValidationError{}
 
-// Validate checks the field values on TrainMLPRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TrainMLPRequest) Validate() error {
+// Validate checks the field values on PieceRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PieceRecord) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TrainMLPRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TrainMLPRequestMultiError, or nil if none found.
-func (m *TrainMLPRequest) ValidateAll() error {
+// ValidateAll checks the field values on PieceRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PieceRecordMultiError, or
+// nil if none found.
+func (m *PieceRecord) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TrainMLPRequest) validate(all bool) error {
+func (m *PieceRecord) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetDataset()) < 1 {
-		err := TrainMLPRequestValidationError{
-			field:  "Dataset",
-			reason: "value length must be at least 1 bytes",
+	if m.GetNumber() < 0 {
+		err := PieceRecordValidationError{
+			field:  "Number",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ParentId
+
+	if m.GetLength() <= 0 {
+		err := PieceRecordValidationError{
+			field:  "Length",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := common.TrafficType_name[int32(m.GetTrafficType())]; !ok {
+		err := PieceRecordValidationError{
+			field:  "TrafficType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCost() == nil {
+		err := PieceRecordValidationError{
+			field:  "Cost",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -180,19 +234,18 @@ func (m *TrainMLPRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TrainMLPRequestMultiError(errors)
+		return PieceRecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// TrainMLPRequestMultiError is an error wrapping multiple validation errors
-// returned by TrainMLPRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TrainMLPRequestMultiError []error
+// PieceRecordMultiError is an error wrapping multiple validation errors
+// returned by PieceRecord.ValidateAll() if the designated constraints aren't met.
+type PieceRecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TrainMLPRequestMultiError) Error() string {
+func (m PieceRecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -201,11 +254,11 @@ func (m TrainMLPRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TrainMLPRequestMultiError) AllErrors() []error { return m }
+func (m PieceRecordMultiError) AllErrors() []error { return m }
 
-// TrainMLPRequestValidationError is the validation error returned by
-// TrainMLPRequest.Validate if the designated constraints aren't met.
-type TrainMLPRequestValidationError struct {
+// PieceRecordValidationError is the validation error returned by
+// PieceRecord.Validate if the designated constraints aren't met.
+type PieceRecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -213,22 +266,22 @@ type TrainMLPRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TrainMLPRequestValidationError) Field() string { return e.field }
+func (e PieceRecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TrainMLPRequestValidationError) Reason() string { return e.reason }
+func (e PieceRecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TrainMLPRequestValidationError) Cause() error { return e.cause }
+func (e PieceRecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TrainMLPRequestValidationError) Key() bool { return e.key }
+func (e PieceRecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TrainMLPRequestValidationError) ErrorName() string { return "TrainMLPRequestValidationError" }
+func (e PieceRecordValidationError) ErrorName() string { return "PieceRecordValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TrainMLPRequestValidationError) Error() string {
+func (e PieceRecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -240,14 +293,14 @@ func (e TrainMLPRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTrainMLPRequest.%s: %s%s",
+		"invalid %sPieceRecord.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TrainMLPRequestValidationError{}
+var _ error = PieceRecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -255,33 +308,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TrainMLPRequestValidationError{}
+} = PieceRecordValidationError{}
 
-// Validate checks the field values on TrainRequest with the rules defined in
+// Validate checks the field values on ParentRecord with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TrainRequest) Validate() error {
+func (m *ParentRecord) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TrainRequest with the rules defined
+// ValidateAll checks the field values on ParentRecord with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TrainRequestMultiError, or
+// result is a list of violation errors wrapped in ParentRecordMultiError, or
 // nil if none found.
-func (m *TrainRequest) ValidateAll() error {
+func (m *ParentRecord) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TrainRequest) validate(all bool) error {
+func (m *ParentRecord) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetHostname()) < 1 {
-		err := TrainRequestValidationError{
-			field:  "Hostname",
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ParentRecordValidationError{
+			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -290,10 +343,10 @@ func (m *TrainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if ip := net.ParseIP(m.GetIp()); ip == nil {
-		err := TrainRequestValidationError{
-			field:  "Ip",
-			reason: "value must be a valid IP address",
+	if m.GetHost() == nil {
+		err := ParentRecordValidationError{
+			field:  "Host",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -301,119 +354,48 @@ func (m *TrainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	oneofRequestPresent := false
-	switch v := m.Request.(type) {
-	case *TrainRequest_TrainGnnRequest:
-		if v == nil {
-			err := TrainRequestValidationError{
-				field:  "Request",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		oneofRequestPresent = true
-
-		if all {
-			switch v := interface{}(m.GetTrainGnnRequest()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TrainRequestValidationError{
-						field:  "TrainGnnRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TrainRequestValidationError{
-						field:  "TrainGnnRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTrainGnnRequest()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TrainRequestValidationError{
-					field:  "TrainGnnRequest",
+	if all {
+		switch v := interface{}(m.GetHost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ParentRecordValidationError{
+					field:  "Host",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	case *TrainRequest_TrainMlpRequest:
-		if v == nil {
-			err := TrainRequestValidationError{
-				field:  "Request",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		oneofRequestPresent = true
-
-		if all {
-			switch v := interface{}(m.GetTrainMlpRequest()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TrainRequestValidationError{
-						field:  "TrainMlpRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TrainRequestValidationError{
-						field:  "TrainMlpRequest",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(m.GetTrainMlpRequest()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return TrainRequestValidationError{
-					field:  "TrainMlpRequest",
+				errors = append(errors, ParentRecordValidationError{
+					field:  "Host",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
-	}
-	if !oneofRequestPresent {
-		err := TrainRequestValidationError{
-			field:  "Request",
-			reason: "value is required",
-		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ParentRecordValidationError{
+				field:  "Host",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return TrainRequestMultiError(errors)
+		return ParentRecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// TrainRequestMultiError is an error wrapping multiple validation errors
-// returned by TrainRequest.ValidateAll() if the designated constraints aren't met.
-type TrainRequestMultiError []error
+// ParentRecordMultiError is an error wrapping multiple validation errors
+// returned by ParentRecord.ValidateAll() if the designated constraints aren't met.
+type ParentRecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TrainRequestMultiError) Error() string {
+func (m ParentRecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -422,11 +404,11 @@ func (m TrainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TrainRequestMultiError) AllErrors() []error { return m }
+func (m ParentRecordMultiError) AllErrors() []error { return m }
 
-// TrainRequestValidationError is the validation error returned by
-// TrainRequest.Validate if the designated constraints aren't met.
-type TrainRequestValidationError struct {
+// ParentRecordValidationError is the validation error returned by
+// ParentRecord.Validate if the designated constraints aren't met.
+type ParentRecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -434,22 +416,22 @@ type TrainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TrainRequestValidationError) Field() string { return e.field }
+func (e ParentRecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TrainRequestValidationError) Reason() string { return e.reason }
+func (e ParentRecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TrainRequestValidationError) Cause() error { return e.cause }
+func (e ParentRecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TrainRequestValidationError) Key() bool { return e.key }
+func (e ParentRecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TrainRequestValidationError) ErrorName() string { return "TrainRequestValidationError" }
+func (e ParentRecordValidationError) ErrorName() string { return "ParentRecordValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TrainRequestValidationError) Error() string {
+func (e ParentRecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -461,14 +443,14 @@ func (e TrainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTrainRequest.%s: %s%s",
+		"invalid %sParentRecord.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TrainRequestValidationError{}
+var _ error = ParentRecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -476,4 +458,3104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TrainRequestValidationError{}
+} = ParentRecordValidationError{}
+
+// Validate checks the field values on DownloadRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DownloadRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DownloadRecordMultiError,
+// or nil if none found.
+func (m *DownloadRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DownloadRecordValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := common.TaskType_name[int32(m.GetTaskType())]; !ok {
+		err := DownloadRecordValidationError{
+			field:  "TaskType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContentLength() < -1 {
+		err := DownloadRecordValidationError{
+			field:  "ContentLength",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPieceCount() < -1 {
+		err := DownloadRecordValidationError{
+			field:  "PieceCount",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPeerId()) < 1 {
+		err := DownloadRecordValidationError{
+			field:  "PeerId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHost() == nil {
+		err := DownloadRecordValidationError{
+			field:  "Host",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetHost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadRecordValidationError{
+					field:  "Host",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadRecordValidationError{
+					field:  "Host",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadRecordValidationError{
+				field:  "Host",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadRecordValidationError{
+						field:  fmt.Sprintf("Parents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadRecordValidationError{
+						field:  fmt.Sprintf("Parents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadRecordValidationError{
+					field:  fmt.Sprintf("Parents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPieces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadRecordValidationError{
+						field:  fmt.Sprintf("Pieces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadRecordValidationError{
+						field:  fmt.Sprintf("Pieces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadRecordValidationError{
+					field:  fmt.Sprintf("Pieces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := DownloadState_name[int32(m.GetState())]; !ok {
+		err := DownloadRecordValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if m.GetCost() == nil {
+		err := DownloadRecordValidationError{
+			field:  "Cost",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := DownloadRecordValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadRecordMultiError is an error wrapping multiple validation errors
+// returned by DownloadRecord.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadRecordMultiError) AllErrors() []error { return m }
+
+// DownloadRecordValidationError is the validation error returned by
+// DownloadRecord.Validate if the designated constraints aren't met.
+type DownloadRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadRecordValidationError) ErrorName() string { return "DownloadRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadRecordValidationError{}
+
+// Validate checks the field values on NetworkTopologyRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NetworkTopologyRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NetworkTopologyRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NetworkTopologyRecordMultiError, or nil if none found.
+func (m *NetworkTopologyRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NetworkTopologyRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSrcHost() == nil {
+		err := NetworkTopologyRecordValidationError{
+			field:  "SrcHost",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSrcHost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "SrcHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "SrcHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSrcHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkTopologyRecordValidationError{
+				field:  "SrcHost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetDestHost() == nil {
+		err := NetworkTopologyRecordValidationError{
+			field:  "DestHost",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDestHost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "DestHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "DestHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDestHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkTopologyRecordValidationError{
+				field:  "DestHost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAverageRtt() == nil {
+		err := NetworkTopologyRecordValidationError{
+			field:  "AverageRtt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinRtt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "MinRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "MinRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinRtt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkTopologyRecordValidationError{
+				field:  "MinRtt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxRtt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "MaxRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NetworkTopologyRecordValidationError{
+					field:  "MaxRtt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxRtt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NetworkTopologyRecordValidationError{
+				field:  "MaxRtt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLossRate(); val < 0 || val > 1 {
+		err := NetworkTopologyRecordValidationError{
+			field:  "LossRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Throughput
+
+	if m.GetProbeCount() < 1 {
+		err := NetworkTopologyRecordValidationError{
+			field:  "ProbeCount",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := NetworkTopologyRecordValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NetworkTopologyRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// NetworkTopologyRecordMultiError is an error wrapping multiple validation
+// errors returned by NetworkTopologyRecord.ValidateAll() if the designated
+// constraints aren't met.
+type NetworkTopologyRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NetworkTopologyRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NetworkTopologyRecordMultiError) AllErrors() []error { return m }
+
+// NetworkTopologyRecordValidationError is the validation error returned by
+// NetworkTopologyRecord.Validate if the designated constraints aren't met.
+type NetworkTopologyRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NetworkTopologyRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NetworkTopologyRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NetworkTopologyRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NetworkTopologyRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NetworkTopologyRecordValidationError) ErrorName() string {
+	return "NetworkTopologyRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NetworkTopologyRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNetworkTopologyRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NetworkTopologyRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NetworkTopologyRecordValidationError{}
+
+// Validate checks the field values on TrainGNNRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TrainGNNRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrainGNNRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrainGNNRequestMultiError, or nil if none found.
+func (m *TrainGNNRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrainGNNRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDataset()) > 0 {
+
+		if len(m.GetDataset()) < 1 {
+			err := TrainGNNRequestValidationError{
+				field:  "Dataset",
+				reason: "value length must be at least 1 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDatasetId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetDatasetId()); l < 1 || l > 1024 {
+			err := TrainGNNRequestValidationError{
+				field:  "DatasetId",
+				reason: "value length must be between 1 and 1024 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TrainGNNRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrainGNNRequestMultiError is an error wrapping multiple validation errors
+// returned by TrainGNNRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TrainGNNRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrainGNNRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrainGNNRequestMultiError) AllErrors() []error { return m }
+
+// TrainGNNRequestValidationError is the validation error returned by
+// TrainGNNRequest.Validate if the designated constraints aren't met.
+type TrainGNNRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrainGNNRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrainGNNRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrainGNNRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrainGNNRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrainGNNRequestValidationError) ErrorName() string { return "TrainGNNRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrainGNNRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrainGNNRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrainGNNRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrainGNNRequestValidationError{}
+
+// Validate checks the field values on TrainMLPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TrainMLPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrainMLPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrainMLPRequestMultiError, or nil if none found.
+func (m *TrainMLPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrainMLPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDataset()) > 0 {
+
+		if len(m.GetDataset()) < 1 {
+			err := TrainMLPRequestValidationError{
+				field:  "Dataset",
+				reason: "value length must be at least 1 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDatasetId() != "" {
+
+		if l := utf8.RuneCountInString(m.GetDatasetId()); l < 1 || l > 1024 {
+			err := TrainMLPRequestValidationError{
+				field:  "DatasetId",
+				reason: "value length must be between 1 and 1024 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TrainMLPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrainMLPRequestMultiError is an error wrapping multiple validation errors
+// returned by TrainMLPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TrainMLPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrainMLPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrainMLPRequestMultiError) AllErrors() []error { return m }
+
+// TrainMLPRequestValidationError is the validation error returned by
+// TrainMLPRequest.Validate if the designated constraints aren't met.
+type TrainMLPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrainMLPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrainMLPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrainMLPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrainMLPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrainMLPRequestValidationError) ErrorName() string { return "TrainMLPRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrainMLPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrainMLPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrainMLPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrainMLPRequestValidationError{}
+
+// Validate checks the field values on StartUploadDatasetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartUploadDatasetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartUploadDatasetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartUploadDatasetRequestMultiError, or nil if none found.
+func (m *StartUploadDatasetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartUploadDatasetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetDatasetId()); l < 1 || l > 1024 {
+		err := StartUploadDatasetRequestValidationError{
+			field:  "DatasetId",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DatasetType_name[int32(m.GetType())]; !ok {
+		err := StartUploadDatasetRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartUploadDatasetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartUploadDatasetRequestMultiError is an error wrapping multiple validation
+// errors returned by StartUploadDatasetRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StartUploadDatasetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartUploadDatasetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartUploadDatasetRequestMultiError) AllErrors() []error { return m }
+
+// StartUploadDatasetRequestValidationError is the validation error returned by
+// StartUploadDatasetRequest.Validate if the designated constraints aren't met.
+type StartUploadDatasetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartUploadDatasetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartUploadDatasetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartUploadDatasetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartUploadDatasetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartUploadDatasetRequestValidationError) ErrorName() string {
+	return "StartUploadDatasetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartUploadDatasetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartUploadDatasetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartUploadDatasetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartUploadDatasetRequestValidationError{}
+
+// Validate checks the field values on UploadDatasetChunkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDatasetChunkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDatasetChunkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDatasetChunkRequestMultiError, or nil if none found.
+func (m *UploadDatasetChunkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDatasetChunkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Offset
+
+	if l := len(m.GetData()); l < 1 || l > 4194304 {
+		err := UploadDatasetChunkRequestValidationError{
+			field:  "Data",
+			reason: "value length must be between 1 and 4194304 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadDatasetChunkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDatasetChunkRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadDatasetChunkRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UploadDatasetChunkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDatasetChunkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDatasetChunkRequestMultiError) AllErrors() []error { return m }
+
+// UploadDatasetChunkRequestValidationError is the validation error returned by
+// UploadDatasetChunkRequest.Validate if the designated constraints aren't met.
+type UploadDatasetChunkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDatasetChunkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDatasetChunkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDatasetChunkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDatasetChunkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDatasetChunkRequestValidationError) ErrorName() string {
+	return "UploadDatasetChunkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDatasetChunkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDatasetChunkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDatasetChunkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDatasetChunkRequestValidationError{}
+
+// Validate checks the field values on CommitDatasetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommitDatasetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitDatasetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitDatasetRequestMultiError, or nil if none found.
+func (m *CommitDatasetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitDatasetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if !_CommitDatasetRequest_Digest_Pattern.MatchString(m.GetDigest()) {
+		err := CommitDatasetRequestValidationError{
+			field:  "Digest",
+			reason: "value does not match regex pattern \"^sha256:[A-Fa-f0-9]{64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitDatasetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitDatasetRequestMultiError is an error wrapping multiple validation
+// errors returned by CommitDatasetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommitDatasetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitDatasetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitDatasetRequestMultiError) AllErrors() []error { return m }
+
+// CommitDatasetRequestValidationError is the validation error returned by
+// CommitDatasetRequest.Validate if the designated constraints aren't met.
+type CommitDatasetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitDatasetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitDatasetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitDatasetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitDatasetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitDatasetRequestValidationError) ErrorName() string {
+	return "CommitDatasetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommitDatasetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommitDatasetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitDatasetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitDatasetRequestValidationError{}
+
+var _CommitDatasetRequest_Digest_Pattern = regexp.MustCompile("^sha256:[A-Fa-f0-9]{64}$")
+
+// Validate checks the field values on UploadDatasetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDatasetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDatasetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDatasetRequestMultiError, or nil if none found.
+func (m *UploadDatasetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDatasetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostname()) < 1 {
+		err := UploadDatasetRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := UploadDatasetRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *UploadDatasetRequest_StartUploadDatasetRequest:
+		if v == nil {
+			err := UploadDatasetRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetStartUploadDatasetRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "StartUploadDatasetRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "StartUploadDatasetRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartUploadDatasetRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetRequestValidationError{
+					field:  "StartUploadDatasetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadDatasetRequest_UploadDatasetChunkRequest:
+		if v == nil {
+			err := UploadDatasetRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetUploadDatasetChunkRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "UploadDatasetChunkRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "UploadDatasetChunkRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUploadDatasetChunkRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetRequestValidationError{
+					field:  "UploadDatasetChunkRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadDatasetRequest_CommitDatasetRequest:
+		if v == nil {
+			err := UploadDatasetRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetCommitDatasetRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "CommitDatasetRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetRequestValidationError{
+						field:  "CommitDatasetRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCommitDatasetRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetRequestValidationError{
+					field:  "CommitDatasetRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := UploadDatasetRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadDatasetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDatasetRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadDatasetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadDatasetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDatasetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDatasetRequestMultiError) AllErrors() []error { return m }
+
+// UploadDatasetRequestValidationError is the validation error returned by
+// UploadDatasetRequest.Validate if the designated constraints aren't met.
+type UploadDatasetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDatasetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDatasetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDatasetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDatasetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDatasetRequestValidationError) ErrorName() string {
+	return "UploadDatasetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDatasetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDatasetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDatasetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDatasetRequestValidationError{}
+
+// Validate checks the field values on UploadDatasetStartedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDatasetStartedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDatasetStartedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDatasetStartedResponseMultiError, or nil if none found.
+func (m *UploadDatasetStartedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDatasetStartedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for NextSequence
+
+	if len(errors) > 0 {
+		return UploadDatasetStartedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDatasetStartedResponseMultiError is an error wrapping multiple
+// validation errors returned by UploadDatasetStartedResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UploadDatasetStartedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDatasetStartedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDatasetStartedResponseMultiError) AllErrors() []error { return m }
+
+// UploadDatasetStartedResponseValidationError is the validation error returned
+// by UploadDatasetStartedResponse.Validate if the designated constraints
+// aren't met.
+type UploadDatasetStartedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDatasetStartedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDatasetStartedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDatasetStartedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDatasetStartedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDatasetStartedResponseValidationError) ErrorName() string {
+	return "UploadDatasetStartedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDatasetStartedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDatasetStartedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDatasetStartedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDatasetStartedResponseValidationError{}
+
+// Validate checks the field values on UploadDatasetChunkAckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDatasetChunkAckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDatasetChunkAckResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadDatasetChunkAckResponseMultiError, or nil if none found.
+func (m *UploadDatasetChunkAckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDatasetChunkAckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return UploadDatasetChunkAckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDatasetChunkAckResponseMultiError is an error wrapping multiple
+// validation errors returned by UploadDatasetChunkAckResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UploadDatasetChunkAckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDatasetChunkAckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDatasetChunkAckResponseMultiError) AllErrors() []error { return m }
+
+// UploadDatasetChunkAckResponseValidationError is the validation error
+// returned by UploadDatasetChunkAckResponse.Validate if the designated
+// constraints aren't met.
+type UploadDatasetChunkAckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDatasetChunkAckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDatasetChunkAckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDatasetChunkAckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDatasetChunkAckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDatasetChunkAckResponseValidationError) ErrorName() string {
+	return "UploadDatasetChunkAckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDatasetChunkAckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDatasetChunkAckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDatasetChunkAckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDatasetChunkAckResponseValidationError{}
+
+// Validate checks the field values on DatasetCommittedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DatasetCommittedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatasetCommittedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DatasetCommittedResponseMultiError, or nil if none found.
+func (m *DatasetCommittedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatasetCommittedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	if !_DatasetCommittedResponse_Digest_Pattern.MatchString(m.GetDigest()) {
+		err := DatasetCommittedResponseValidationError{
+			field:  "Digest",
+			reason: "value does not match regex pattern \"^sha256:[A-Fa-f0-9]{64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DatasetCommittedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatasetCommittedResponseMultiError is an error wrapping multiple validation
+// errors returned by DatasetCommittedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DatasetCommittedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatasetCommittedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatasetCommittedResponseMultiError) AllErrors() []error { return m }
+
+// DatasetCommittedResponseValidationError is the validation error returned by
+// DatasetCommittedResponse.Validate if the designated constraints aren't met.
+type DatasetCommittedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatasetCommittedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatasetCommittedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatasetCommittedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatasetCommittedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatasetCommittedResponseValidationError) ErrorName() string {
+	return "DatasetCommittedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DatasetCommittedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatasetCommittedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatasetCommittedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatasetCommittedResponseValidationError{}
+
+var _DatasetCommittedResponse_Digest_Pattern = regexp.MustCompile("^sha256:[A-Fa-f0-9]{64}$")
+
+// Validate checks the field values on UploadDatasetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadDatasetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadDatasetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadDatasetResponseMultiError, or nil if none found.
+func (m *UploadDatasetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadDatasetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofResponsePresent := false
+	switch v := m.Response.(type) {
+	case *UploadDatasetResponse_UploadDatasetStartedResponse:
+		if v == nil {
+			err := UploadDatasetResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetUploadDatasetStartedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "UploadDatasetStartedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "UploadDatasetStartedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUploadDatasetStartedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetResponseValidationError{
+					field:  "UploadDatasetStartedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadDatasetResponse_UploadDatasetChunkAckResponse:
+		if v == nil {
+			err := UploadDatasetResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetUploadDatasetChunkAckResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "UploadDatasetChunkAckResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "UploadDatasetChunkAckResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUploadDatasetChunkAckResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetResponseValidationError{
+					field:  "UploadDatasetChunkAckResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadDatasetResponse_DatasetCommittedResponse:
+		if v == nil {
+			err := UploadDatasetResponseValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofResponsePresent = true
+
+		if all {
+			switch v := interface{}(m.GetDatasetCommittedResponse()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "DatasetCommittedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadDatasetResponseValidationError{
+						field:  "DatasetCommittedResponse",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDatasetCommittedResponse()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadDatasetResponseValidationError{
+					field:  "DatasetCommittedResponse",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofResponsePresent {
+		err := UploadDatasetResponseValidationError{
+			field:  "Response",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadDatasetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadDatasetResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadDatasetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadDatasetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadDatasetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadDatasetResponseMultiError) AllErrors() []error { return m }
+
+// UploadDatasetResponseValidationError is the validation error returned by
+// UploadDatasetResponse.Validate if the designated constraints aren't met.
+type UploadDatasetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadDatasetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadDatasetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadDatasetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadDatasetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadDatasetResponseValidationError) ErrorName() string {
+	return "UploadDatasetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadDatasetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadDatasetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadDatasetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadDatasetResponseValidationError{}
+
+// Validate checks the field values on TrainRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrainRequestMultiError, or
+// nil if none found.
+func (m *TrainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHostname()) < 1 {
+		err := TrainRequestValidationError{
+			field:  "Hostname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := TrainRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *TrainRequest_TrainGnnRequest:
+		if v == nil {
+			err := TrainRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetTrainGnnRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrainRequestValidationError{
+						field:  "TrainGnnRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrainRequestValidationError{
+						field:  "TrainGnnRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTrainGnnRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrainRequestValidationError{
+					field:  "TrainGnnRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrainRequest_TrainMlpRequest:
+		if v == nil {
+			err := TrainRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetTrainMlpRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrainRequestValidationError{
+						field:  "TrainMlpRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrainRequestValidationError{
+						field:  "TrainMlpRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTrainMlpRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrainRequestValidationError{
+					field:  "TrainMlpRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := TrainRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TrainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrainRequestMultiError is an error wrapping multiple validation errors
+// returned by TrainRequest.ValidateAll() if the designated constraints aren't met.
+type TrainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrainRequestMultiError) AllErrors() []error { return m }
+
+// TrainRequestValidationError is the validation error returned by
+// TrainRequest.Validate if the designated constraints aren't met.
+type TrainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrainRequestValidationError) ErrorName() string { return "TrainRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrainRequestValidationError{}
+
+// Validate checks the field values on TrainingJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrainingJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrainingJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrainingJobMultiError, or
+// nil if none found.
+func (m *TrainingJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrainingJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := TrainingJobValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := manager.ModelType_name[int32(m.GetModelType())]; !ok {
+		err := TrainingJobValidationError{
+			field:  "ModelType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TrainingJobState_name[int32(m.GetState())]; !ok {
+		err := TrainingJobValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHostname()) < 1 {
+		err := TrainingJobValidationError{
+			field:  "Hostname",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := TrainingJobValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Epoch
+
+	// no validation rules for TotalEpochs
+
+	if m.GetLoss() < 0 {
+		err := TrainingJobValidationError{
+			field:  "Loss",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModelName() != "" {
+
+		if utf8.RuneCountInString(m.GetModelName()) < 1 {
+			err := TrainingJobValidationError{
+				field:  "ModelName",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ModelVersion
+
+	// no validation rules for Description
+
+	if m.GetCreatedAt() == nil {
+		err := TrainingJobValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() == nil {
+		err := TrainingJobValidationError{
+			field:  "UpdatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Evaluation.(type) {
+	case *TrainingJob_GnnEvaluation:
+		if v == nil {
+			err := TrainingJobValidationError{
+				field:  "Evaluation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGnnEvaluation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrainingJobValidationError{
+						field:  "GnnEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrainingJobValidationError{
+						field:  "GnnEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGnnEvaluation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrainingJobValidationError{
+					field:  "GnnEvaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrainingJob_MlpEvaluation:
+		if v == nil {
+			err := TrainingJobValidationError{
+				field:  "Evaluation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMlpEvaluation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrainingJobValidationError{
+						field:  "MlpEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrainingJobValidationError{
+						field:  "MlpEvaluation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMlpEvaluation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrainingJobValidationError{
+					field:  "MlpEvaluation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TrainingJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrainingJobMultiError is an error wrapping multiple validation errors
+// returned by TrainingJob.ValidateAll() if the designated constraints aren't met.
+type TrainingJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrainingJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrainingJobMultiError) AllErrors() []error { return m }
+
+// TrainingJobValidationError is the validation error returned by
+// TrainingJob.Validate if the designated constraints aren't met.
+type TrainingJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrainingJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrainingJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrainingJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrainingJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrainingJobValidationError) ErrorName() string { return "TrainingJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrainingJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrainingJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrainingJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrainingJobValidationError{}
+
+// Validate checks the field values on CreateTrainingJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTrainingJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTrainingJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTrainingJobsResponseMultiError, or nil if none found.
+func (m *CreateTrainingJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTrainingJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetJobs()) < 1 {
+		err := CreateTrainingJobsResponseValidationError{
+			field:  "Jobs",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTrainingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTrainingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTrainingJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateTrainingJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTrainingJobsResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTrainingJobsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTrainingJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTrainingJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTrainingJobsResponseMultiError) AllErrors() []error { return m }
+
+// CreateTrainingJobsResponseValidationError is the validation error returned
+// by CreateTrainingJobsResponse.Validate if the designated constraints aren't met.
+type CreateTrainingJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTrainingJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTrainingJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTrainingJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTrainingJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTrainingJobsResponseValidationError) ErrorName() string {
+	return "CreateTrainingJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTrainingJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTrainingJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTrainingJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTrainingJobsResponseValidationError{}
+
+// Validate checks the field values on GetTrainingJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTrainingJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTrainingJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTrainingJobRequestMultiError, or nil if none found.
+func (m *GetTrainingJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTrainingJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetTrainingJobRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTrainingJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTrainingJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTrainingJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTrainingJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTrainingJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTrainingJobRequestMultiError) AllErrors() []error { return m }
+
+// GetTrainingJobRequestValidationError is the validation error returned by
+// GetTrainingJobRequest.Validate if the designated constraints aren't met.
+type GetTrainingJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTrainingJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTrainingJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTrainingJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTrainingJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTrainingJobRequestValidationError) ErrorName() string {
+	return "GetTrainingJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTrainingJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTrainingJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTrainingJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTrainingJobRequestValidationError{}
+
+// Validate checks the field values on ListTrainingJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrainingJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrainingJobsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrainingJobsRequestMultiError, or nil if none found.
+func (m *ListTrainingJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrainingJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hostname
+
+	if len(m.GetStates()) > 0 {
+
+		for idx, item := range m.GetStates() {
+			_, _ = idx, item
+
+			if _, ok := TrainingJobState_name[int32(item)]; !ok {
+				err := ListTrainingJobsRequestValidationError{
+					field:  fmt.Sprintf("States[%v]", idx),
+					reason: "value must be one of the defined enum values",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 10000 {
+			err := ListTrainingJobsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 10000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListTrainingJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrainingJobsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrainingJobsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrainingJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrainingJobsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrainingJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListTrainingJobsRequestValidationError is the validation error returned by
+// ListTrainingJobsRequest.Validate if the designated constraints aren't met.
+type ListTrainingJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrainingJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrainingJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrainingJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrainingJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrainingJobsRequestValidationError) ErrorName() string {
+	return "ListTrainingJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrainingJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrainingJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrainingJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrainingJobsRequestValidationError{}
+
+// Validate checks the field values on ListTrainingJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrainingJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrainingJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrainingJobsResponseMultiError, or nil if none found.
+func (m *ListTrainingJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrainingJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrainingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrainingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrainingJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTrainingJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrainingJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTrainingJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrainingJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrainingJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrainingJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListTrainingJobsResponseValidationError is the validation error returned by
+// ListTrainingJobsResponse.Validate if the designated constraints aren't met.
+type ListTrainingJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrainingJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrainingJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrainingJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrainingJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrainingJobsResponseValidationError) ErrorName() string {
+	return "ListTrainingJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrainingJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrainingJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrainingJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrainingJobsResponseValidationError{}
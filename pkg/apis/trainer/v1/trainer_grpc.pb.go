@@ -25,6 +25,18 @@ const _ = grpc.SupportPackageIsVersion7
 type TrainerClient interface {
 	// Train trains models of scheduler using dataset.
 	Train(ctx context.Context, opts ...grpc.CallOption) (Trainer_TrainClient, error)
+	// CreateTrainingJobs creates the training jobs of the dataset, and returns the training jobs
+	// before they are finished, the state of the training jobs is tracked by GetTrainingJob.
+	CreateTrainingJobs(ctx context.Context, opts ...grpc.CallOption) (Trainer_CreateTrainingJobsClient, error)
+	// UploadDataset uploads dataset in chunks, the chunks are acknowledged after they are persisted,
+	// and the broken upload is resumed from the acknowledged offset by the same dataset id.
+	// The dataset is committed if the size and the digest are matched, otherwise it returns
+	// DataLoss code and the dataset is discarded.
+	UploadDataset(ctx context.Context, opts ...grpc.CallOption) (Trainer_UploadDatasetClient, error)
+	// GetTrainingJob returns the training job.
+	GetTrainingJob(ctx context.Context, in *GetTrainingJobRequest, opts ...grpc.CallOption) (*TrainingJob, error)
+	// ListTrainingJobs lists the training jobs.
+	ListTrainingJobs(ctx context.Context, in *ListTrainingJobsRequest, opts ...grpc.CallOption) (*ListTrainingJobsResponse, error)
 }
 
 type trainerClient struct {
@@ -69,12 +81,107 @@ func (x *trainerTrainClient) CloseAndRecv() (*emptypb.Empty, error) {
 	return m, nil
 }
 
+func (c *trainerClient) CreateTrainingJobs(ctx context.Context, opts ...grpc.CallOption) (Trainer_CreateTrainingJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trainer_ServiceDesc.Streams[1], "/trainer.v1.Trainer/CreateTrainingJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trainerCreateTrainingJobsClient{stream}
+	return x, nil
+}
+
+type Trainer_CreateTrainingJobsClient interface {
+	Send(*TrainRequest) error
+	CloseAndRecv() (*CreateTrainingJobsResponse, error)
+	grpc.ClientStream
+}
+
+type trainerCreateTrainingJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainerCreateTrainingJobsClient) Send(m *TrainRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *trainerCreateTrainingJobsClient) CloseAndRecv() (*CreateTrainingJobsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateTrainingJobsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainerClient) UploadDataset(ctx context.Context, opts ...grpc.CallOption) (Trainer_UploadDatasetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trainer_ServiceDesc.Streams[2], "/trainer.v1.Trainer/UploadDataset", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trainerUploadDatasetClient{stream}
+	return x, nil
+}
+
+type Trainer_UploadDatasetClient interface {
+	Send(*UploadDatasetRequest) error
+	Recv() (*UploadDatasetResponse, error)
+	grpc.ClientStream
+}
+
+type trainerUploadDatasetClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainerUploadDatasetClient) Send(m *UploadDatasetRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *trainerUploadDatasetClient) Recv() (*UploadDatasetResponse, error) {
+	m := new(UploadDatasetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainerClient) GetTrainingJob(ctx context.Context, in *GetTrainingJobRequest, opts ...grpc.CallOption) (*TrainingJob, error) {
+	out := new(TrainingJob)
+	err := c.cc.Invoke(ctx, "/trainer.v1.Trainer/GetTrainingJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) ListTrainingJobs(ctx context.Context, in *ListTrainingJobsRequest, opts ...grpc.CallOption) (*ListTrainingJobsResponse, error) {
+	out := new(ListTrainingJobsResponse)
+	err := c.cc.Invoke(ctx, "/trainer.v1.Trainer/ListTrainingJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrainerServer is the server API for Trainer service.
 // All implementations should embed UnimplementedTrainerServer
 // for forward compatibility
 type TrainerServer interface {
 	// Train trains models of scheduler using dataset.
 	Train(Trainer_TrainServer) error
+	// CreateTrainingJobs creates the training jobs of the dataset, and returns the training jobs
+	// before they are finished, the state of the training jobs is tracked by GetTrainingJob.
+	CreateTrainingJobs(Trainer_CreateTrainingJobsServer) error
+	// UploadDataset uploads dataset in chunks, the chunks are acknowledged after they are persisted,
+	// and the broken upload is resumed from the acknowledged offset by the same dataset id.
+	// The dataset is committed if the size and the digest are matched, otherwise it returns
+	// DataLoss code and the dataset is discarded.
+	UploadDataset(Trainer_UploadDatasetServer) error
+	// GetTrainingJob returns the training job.
+	GetTrainingJob(context.Context, *GetTrainingJobRequest) (*TrainingJob, error)
+	// ListTrainingJobs lists the training jobs.
+	ListTrainingJobs(context.Context, *ListTrainingJobsRequest) (*ListTrainingJobsResponse, error)
 }
 
 // UnimplementedTrainerServer should be embedded to have forward compatible implementations.
@@ -84,6 +191,18 @@ type UnimplementedTrainerServer struct {
 func (UnimplementedTrainerServer) Train(Trainer_TrainServer) error {
 	return status.Errorf(codes.Unimplemented, "method Train not implemented")
 }
+func (UnimplementedTrainerServer) CreateTrainingJobs(Trainer_CreateTrainingJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateTrainingJobs not implemented")
+}
+func (UnimplementedTrainerServer) UploadDataset(Trainer_UploadDatasetServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadDataset not implemented")
+}
+func (UnimplementedTrainerServer) GetTrainingJob(context.Context, *GetTrainingJobRequest) (*TrainingJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrainingJob not implemented")
+}
+func (UnimplementedTrainerServer) ListTrainingJobs(context.Context, *ListTrainingJobsRequest) (*ListTrainingJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrainingJobs not implemented")
+}
 
 // UnsafeTrainerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TrainerServer will
@@ -122,19 +241,127 @@ func (x *trainerTrainServer) Recv() (*TrainRequest, error) {
 	return m, nil
 }
 
+func _Trainer_CreateTrainingJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TrainerServer).CreateTrainingJobs(&trainerCreateTrainingJobsServer{stream})
+}
+
+type Trainer_CreateTrainingJobsServer interface {
+	SendAndClose(*CreateTrainingJobsResponse) error
+	Recv() (*TrainRequest, error)
+	grpc.ServerStream
+}
+
+type trainerCreateTrainingJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trainerCreateTrainingJobsServer) SendAndClose(m *CreateTrainingJobsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *trainerCreateTrainingJobsServer) Recv() (*TrainRequest, error) {
+	m := new(TrainRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Trainer_UploadDataset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TrainerServer).UploadDataset(&trainerUploadDatasetServer{stream})
+}
+
+type Trainer_UploadDatasetServer interface {
+	Send(*UploadDatasetResponse) error
+	Recv() (*UploadDatasetRequest, error)
+	grpc.ServerStream
+}
+
+type trainerUploadDatasetServer struct {
+	grpc.ServerStream
+}
+
+func (x *trainerUploadDatasetServer) Send(m *UploadDatasetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *trainerUploadDatasetServer) Recv() (*UploadDatasetRequest, error) {
+	m := new(UploadDatasetRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Trainer_GetTrainingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrainingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).GetTrainingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trainer.v1.Trainer/GetTrainingJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).GetTrainingJob(ctx, req.(*GetTrainingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_ListTrainingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrainingJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).ListTrainingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trainer.v1.Trainer/ListTrainingJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).ListTrainingJobs(ctx, req.(*ListTrainingJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Trainer_ServiceDesc is the grpc.ServiceDesc for Trainer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Trainer_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "trainer.v1.Trainer",
 	HandlerType: (*TrainerServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTrainingJob",
+			Handler:    _Trainer_GetTrainingJob_Handler,
+		},
+		{
+			MethodName: "ListTrainingJobs",
+			Handler:    _Trainer_ListTrainingJobs_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Train",
 			Handler:       _Trainer_Train_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CreateTrainingJobs",
+			Handler:       _Trainer_CreateTrainingJobs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadDataset",
+			Handler:       _Trainer_UploadDataset_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "pkg/apis/trainer/v1/trainer.proto",
 }
@@ -0,0 +1,403 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package bitmap implements the run-length encoded set of piece numbers,
+// it is the compact representation of the piece_bitmap fields in dfdaemon.v2.
+//
+// The encoded bitmap is a version byte followed by the uvarint count of runs,
+// and every run is encoded as the uvarint gap from the end of the previous run
+// and the uvarint length of the run minus one. For example, the pieces
+// {0, 1, 2, 3, 10, 11} are encoded as [1, 2, 0, 3, 6, 1].
+package bitmap
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+)
+
+// Version is the version of the bitmap encoding.
+const Version byte = 1
+
+var (
+	// ErrInvalidVersion is returned when the version of the encoded bitmap is not supported.
+	ErrInvalidVersion = errors.New("invalid bitmap version")
+
+	// ErrInvalidEncoding is returned when the encoded bitmap is malformed.
+	ErrInvalidEncoding = errors.New("invalid bitmap encoding")
+
+	// ErrInvalidInterestedPieces is returned when the interested pieces request does not set
+	// exactly one of piece_numbers and piece_bitmap.
+	ErrInvalidInterestedPieces = errors.New("exactly one of piece_numbers and piece_bitmap must be set")
+)
+
+// run is the continuous piece numbers from first to last, both are inclusive.
+type run struct {
+	first uint32
+	last  uint32
+}
+
+// Bitmap is the set of piece numbers, which are stored as the sorted and disjoint runs.
+// The zero value is an empty bitmap ready to use.
+type Bitmap struct {
+	runs []run
+}
+
+// New returns the bitmap of the piece numbers.
+func New(numbers ...uint32) *Bitmap {
+	b := &Bitmap{}
+	for _, number := range numbers {
+		b.Add(number)
+	}
+
+	return b
+}
+
+// Decode returns the bitmap of the encoded data.
+func Decode(data []byte) (*Bitmap, error) {
+	b := &Bitmap{}
+	if err := b.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// InterestedPieces returns the bitmap of the interested pieces request, exactly one of
+// piece_numbers and piece_bitmap of the request must be set.
+func InterestedPieces(req *dfdaemonv2.InterestedPiecesRequest) (*Bitmap, error) {
+	numbers, data := req.GetPieceNumbers(), req.GetPieceBitmap()
+	switch {
+	case len(numbers) > 0 && len(data) == 0:
+		return New(numbers...), nil
+	case len(numbers) == 0 && len(data) > 0:
+		return Decode(data)
+	default:
+		return nil, ErrInvalidInterestedPieces
+	}
+}
+
+// Encode returns the encoded data of the bitmap.
+func (b *Bitmap) Encode() []byte {
+	data := make([]byte, 0, 1+binary.MaxVarintLen32*(1+2*len(b.runs)))
+	data = append(data, Version)
+	data = binary.AppendUvarint(data, uint64(len(b.runs)))
+
+	var next uint64
+	for _, r := range b.runs {
+		data = binary.AppendUvarint(data, uint64(r.first)-next)
+		data = binary.AppendUvarint(data, uint64(r.last-r.first))
+		next = uint64(r.last) + 1
+	}
+
+	return data
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b *Bitmap) MarshalBinary() ([]byte, error) {
+	return b.Encode(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (b *Bitmap) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: empty data", ErrInvalidEncoding)
+	}
+
+	if data[0] != Version {
+		return fmt.Errorf("%w: %d", ErrInvalidVersion, data[0])
+	}
+	data = data[1:]
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("%w: invalid count of runs", ErrInvalidEncoding)
+	}
+	data = data[n:]
+
+	// Every run takes two bytes at least, it prevents allocating huge memory for malformed data.
+	if count > uint64(len(data))/2 {
+		return fmt.Errorf("%w: count of runs %d exceeds data length %d", ErrInvalidEncoding, count, len(data))
+	}
+
+	runs := make([]run, 0, count)
+	var next uint64
+	for i := uint64(0); i < count; i++ {
+		gap, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("%w: invalid gap of run %d", ErrInvalidEncoding, i)
+		}
+		data = data[n:]
+
+		length, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("%w: invalid length of run %d", ErrInvalidEncoding, i)
+		}
+		data = data[n:]
+
+		// The gap of the first run can be zero, the others must be positive,
+		// otherwise the adjacent runs should be merged.
+		if i > 0 && gap == 0 {
+			return fmt.Errorf("%w: adjacent runs are not merged", ErrInvalidEncoding)
+		}
+
+		first := next + gap
+		last := first + length
+		if first < next || last < first || last > math.MaxUint32 {
+			return fmt.Errorf("%w: run %d overflows", ErrInvalidEncoding, i)
+		}
+
+		runs = append(runs, run{first: uint32(first), last: uint32(last)})
+		next = last + 1
+	}
+
+	if len(data) != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidEncoding, len(data))
+	}
+
+	b.runs = runs
+	return nil
+}
+
+// Add adds the piece number to the bitmap.
+func (b *Bitmap) Add(number uint32) {
+	b.AddRange(number, number)
+}
+
+// AddRange adds the piece numbers from first to last to the bitmap, both are inclusive.
+func (b *Bitmap) AddRange(first, last uint32) {
+	if first > last {
+		return
+	}
+
+	// Appending in ascending order is the common case of downloading pieces.
+	if n := len(b.runs); n == 0 || uint64(b.runs[n-1].last)+1 < uint64(first) {
+		b.runs = append(b.runs, run{first: first, last: last})
+		return
+	}
+
+	// Find the runs which overlap with or are adjacent to [first, last], and merge them.
+	i := sort.Search(len(b.runs), func(i int) bool {
+		return uint64(b.runs[i].last)+1 >= uint64(first)
+	})
+	j := i
+	for j < len(b.runs) && uint64(b.runs[j].first) <= uint64(last)+1 {
+		if b.runs[j].first < first {
+			first = b.runs[j].first
+		}
+
+		if b.runs[j].last > last {
+			last = b.runs[j].last
+		}
+		j++
+	}
+
+	if i == j {
+		b.runs = append(b.runs, run{})
+		copy(b.runs[i+1:], b.runs[i:])
+		b.runs[i] = run{first: first, last: last}
+		return
+	}
+
+	b.runs[i] = run{first: first, last: last}
+	b.runs = append(b.runs[:i+1], b.runs[j:]...)
+}
+
+// Remove removes the piece number from the bitmap.
+func (b *Bitmap) Remove(number uint32) {
+	i := b.search(number)
+	if i < 0 {
+		return
+	}
+
+	r := b.runs[i]
+	switch {
+	case r.first == r.last:
+		b.runs = append(b.runs[:i], b.runs[i+1:]...)
+	case number == r.first:
+		b.runs[i].first++
+	case number == r.last:
+		b.runs[i].last--
+	default:
+		b.runs = append(b.runs, run{})
+		copy(b.runs[i+2:], b.runs[i+1:])
+		b.runs[i] = run{first: r.first, last: number - 1}
+		b.runs[i+1] = run{first: number + 1, last: r.last}
+	}
+}
+
+// Contains returns whether the piece number is in the bitmap.
+func (b *Bitmap) Contains(number uint32) bool {
+	return b.search(number) >= 0
+}
+
+// search returns the index of the run which contains the piece number, or -1 if not found.
+func (b *Bitmap) search(number uint32) int {
+	i := sort.Search(len(b.runs), func(i int) bool {
+		return b.runs[i].last >= number
+	})
+
+	if i < len(b.runs) && b.runs[i].first <= number {
+		return i
+	}
+
+	return -1
+}
+
+// Count returns the count of piece numbers in the bitmap.
+func (b *Bitmap) Count() uint64 {
+	var count uint64
+	for _, r := range b.runs {
+		count += uint64(r.last-r.first) + 1
+	}
+
+	return count
+}
+
+// IsEmpty returns whether the bitmap has no piece numbers.
+func (b *Bitmap) IsEmpty() bool {
+	return len(b.runs) == 0
+}
+
+// Range calls f for every piece number in ascending order, it stops if f returns false.
+func (b *Bitmap) Range(f func(number uint32) bool) {
+	for _, r := range b.runs {
+		for number := uint64(r.first); number <= uint64(r.last); number++ {
+			if !f(uint32(number)) {
+				return
+			}
+		}
+	}
+}
+
+// Numbers returns the piece numbers of the bitmap in ascending order.
+func (b *Bitmap) Numbers() []uint32 {
+	numbers := make([]uint32, 0, b.Count())
+	b.Range(func(number uint32) bool {
+		numbers = append(numbers, number)
+		return true
+	})
+
+	return numbers
+}
+
+// Clone returns the copy of the bitmap.
+func (b *Bitmap) Clone() *Bitmap {
+	return &Bitmap{runs: append([]run(nil), b.runs...)}
+}
+
+// Equal returns whether the bitmaps have the same piece numbers.
+func (b *Bitmap) Equal(other *Bitmap) bool {
+	if len(b.runs) != len(other.runs) {
+		return false
+	}
+
+	for i := range b.runs {
+		if b.runs[i] != other.runs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Union returns the bitmap of the piece numbers which are in a or b.
+func Union(a, b *Bitmap) *Bitmap {
+	result := &Bitmap{runs: make([]run, 0, len(a.runs)+len(b.runs))}
+	i, j := 0, 0
+	for i < len(a.runs) || j < len(b.runs) {
+		var r run
+		if j >= len(b.runs) || (i < len(a.runs) && a.runs[i].first <= b.runs[j].first) {
+			r = a.runs[i]
+			i++
+		} else {
+			r = b.runs[j]
+			j++
+		}
+
+		// The runs are visited in ascending order of first, so only the last run may be merged.
+		if n := len(result.runs); n > 0 && uint64(result.runs[n-1].last)+1 >= uint64(r.first) {
+			if r.last > result.runs[n-1].last {
+				result.runs[n-1].last = r.last
+			}
+			continue
+		}
+
+		result.runs = append(result.runs, r)
+	}
+
+	return result
+}
+
+// Intersection returns the bitmap of the piece numbers which are in both a and b.
+func Intersection(a, b *Bitmap) *Bitmap {
+	result := &Bitmap{}
+	i, j := 0, 0
+	for i < len(a.runs) && j < len(b.runs) {
+		first, last := a.runs[i].first, a.runs[i].last
+		if b.runs[j].first > first {
+			first = b.runs[j].first
+		}
+
+		if b.runs[j].last < last {
+			last = b.runs[j].last
+		}
+
+		if first <= last {
+			result.runs = append(result.runs, run{first: first, last: last})
+		}
+
+		// Advance the run which ends first, the other one may overlap with the next run.
+		if a.runs[i].last < b.runs[j].last {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return result
+}
+
+// Difference returns the bitmap of the piece numbers which are in a but not in b,
+// for example, the pieces which are available in the parent but not downloaded by the child.
+func Difference(a, b *Bitmap) *Bitmap {
+	result := &Bitmap{}
+	j := 0
+	for _, r := range a.runs {
+		first := uint64(r.first)
+		for j < len(b.runs) && b.runs[j].last < r.first {
+			j++
+		}
+
+		for k := j; k < len(b.runs) && b.runs[k].first <= r.last; k++ {
+			if uint64(b.runs[k].first) > first {
+				result.runs = append(result.runs, run{first: uint32(first), last: b.runs[k].first - 1})
+			}
+
+			first = uint64(b.runs[k].last) + 1
+		}
+
+		if first <= uint64(r.last) {
+			result.runs = append(result.runs, run{first: uint32(first), last: r.last})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,304 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bitmap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"testing"
+
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+)
+
+// numbersOf returns the piece numbers of the fuzzed data, every two bytes is a piece number,
+// so the numbers are dense enough to form runs.
+func numbersOf(data []byte) []uint32 {
+	numbers := make([]uint32, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		numbers = append(numbers, uint32(binary.LittleEndian.Uint16(data[i:])))
+	}
+
+	return numbers
+}
+
+// setOf returns the set of the piece numbers.
+func setOf(numbers []uint32) map[uint32]struct{} {
+	set := make(map[uint32]struct{}, len(numbers))
+	for _, number := range numbers {
+		set[number] = struct{}{}
+	}
+
+	return set
+}
+
+// sortedOf returns the sorted piece numbers of the set.
+func sortedOf(set map[uint32]struct{}) []uint32 {
+	numbers := make([]uint32, 0, len(set))
+	for number := range set {
+		numbers = append(numbers, number)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	return numbers
+}
+
+// equalNumbers returns whether the piece numbers are equal.
+func equalNumbers(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkRuns fails the test if the runs of the bitmap are not sorted, disjoint and merged.
+func checkRuns(t *testing.T, b *Bitmap) {
+	t.Helper()
+	for i, r := range b.runs {
+		if r.first > r.last {
+			t.Fatalf("run %d is reversed: %+v", i, r)
+		}
+
+		if i > 0 && uint64(b.runs[i-1].last)+1 >= uint64(r.first) {
+			t.Fatalf("run %d is not merged with the previous run: %+v, %+v", i, b.runs[i-1], r)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []uint32
+		want    []byte
+	}{
+		{
+			name: "empty",
+			want: []byte{Version, 0},
+		},
+		{
+			name:    "runs",
+			numbers: []uint32{0, 1, 2, 3, 10, 11},
+			want:    []byte{Version, 2, 0, 3, 6, 1},
+		},
+		{
+			name:    "unordered",
+			numbers: []uint32{11, 3, 10, 0, 2, 1, 2},
+			want:    []byte{Version, 2, 0, 3, 6, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := New(tc.numbers...).Encode(); !bytes.Equal(got, tc.want) {
+				t.Fatalf("Encode() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "empty", data: nil, err: ErrInvalidEncoding},
+		{name: "version", data: []byte{2, 0}, err: ErrInvalidVersion},
+		{name: "truncated count", data: []byte{Version, 0x80}, err: ErrInvalidEncoding},
+		{name: "huge count", data: []byte{Version, 0xff, 0xff, 0xff, 0xff, 0x0f}, err: ErrInvalidEncoding},
+		{name: "truncated run", data: []byte{Version, 1, 0x80, 0x80}, err: ErrInvalidEncoding},
+		{name: "adjacent runs", data: []byte{Version, 2, 0, 1, 0, 1}, err: ErrInvalidEncoding},
+		{name: "overflow", data: []byte{Version, 1, 0xff, 0xff, 0xff, 0xff, 0x0f, 1}, err: ErrInvalidEncoding},
+		{name: "trailing bytes", data: []byte{Version, 0, 0}, err: ErrInvalidEncoding},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Decode(tc.data); !errors.Is(err, tc.err) {
+				t.Fatalf("Decode(%v) error = %v, want %v", tc.data, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestInterestedPieces(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *dfdaemonv2.InterestedPiecesRequest
+		want []uint32
+		err  error
+	}{
+		{
+			name: "piece numbers",
+			req:  &dfdaemonv2.InterestedPiecesRequest{PieceNumbers: []uint32{3, 1, 2}},
+			want: []uint32{1, 2, 3},
+		},
+		{
+			name: "piece bitmap",
+			req:  &dfdaemonv2.InterestedPiecesRequest{PieceBitmap: New(0, 1, 5).Encode()},
+			want: []uint32{0, 1, 5},
+		},
+		{
+			name: "both",
+			req:  &dfdaemonv2.InterestedPiecesRequest{PieceNumbers: []uint32{1}, PieceBitmap: New(1).Encode()},
+			err:  ErrInvalidInterestedPieces,
+		},
+		{
+			name: "neither",
+			req:  &dfdaemonv2.InterestedPiecesRequest{},
+			err:  ErrInvalidInterestedPieces,
+		},
+		{
+			name: "malformed bitmap",
+			req:  &dfdaemonv2.InterestedPiecesRequest{PieceBitmap: []byte{Version, 1}},
+			err:  ErrInvalidEncoding,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := InterestedPieces(tc.req)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("InterestedPieces() error = %v, want %v", err, tc.err)
+			}
+
+			if err == nil && !equalNumbers(b.Numbers(), tc.want) {
+				t.Fatalf("InterestedPieces() = %v, want %v", b.Numbers(), tc.want)
+			}
+		})
+	}
+}
+
+func FuzzEncodeDecode(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 1, 0, 2, 0, 3, 0, 10, 0, 11, 0})
+	f.Add([]byte{0xff, 0xff, 0, 0, 0xfe, 0xff, 1, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		numbers := numbersOf(data)
+		b := New(numbers...)
+		checkRuns(t, b)
+
+		want := sortedOf(setOf(numbers))
+		if got := b.Numbers(); !equalNumbers(got, want) {
+			t.Fatalf("Numbers() = %v, want %v", got, want)
+		}
+
+		if b.Count() != uint64(len(want)) {
+			t.Fatalf("Count() = %d, want %d", b.Count(), len(want))
+		}
+
+		decoded, err := Decode(b.Encode())
+		if err != nil {
+			t.Fatalf("Decode(Encode()) error = %v", err)
+		}
+
+		if !decoded.Equal(b) {
+			t.Fatalf("Decode(Encode()) = %v, want %v", decoded.Numbers(), want)
+		}
+
+		for _, number := range numbers {
+			b.Remove(number)
+			if b.Contains(number) {
+				t.Fatalf("Contains(%d) after Remove", number)
+			}
+			checkRuns(t, b)
+		}
+
+		if !b.IsEmpty() {
+			t.Fatalf("bitmap is not empty after removing all numbers: %v", b.Numbers())
+		}
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{Version, 0})
+	f.Add([]byte{Version, 2, 0, 3, 6, 1})
+	f.Add([]byte{Version, 1, 0xff, 0xff, 0xff, 0xff, 0x0f, 0})
+	f.Add([]byte{Version, 0xff, 0xff, 0xff, 0xff, 0x0f})
+	f.Add([]byte{2, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		b, err := Decode(data)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidEncoding) && !errors.Is(err, ErrInvalidVersion) {
+				t.Fatalf("Decode(%v) returns unexpected error %v", data, err)
+			}
+			return
+		}
+		checkRuns(t, b)
+
+		// The uvarints may be encoded in more bytes than needed, so compare the decoded bitmaps
+		// instead of the data.
+		decoded, err := Decode(b.Encode())
+		if err != nil {
+			t.Fatalf("Decode(Encode()) error = %v", err)
+		}
+
+		if !decoded.Equal(b) {
+			t.Fatalf("Decode(Encode()) is not equal to Decode(%v)", data)
+		}
+	})
+}
+
+func FuzzSetOperations(f *testing.F) {
+	f.Add([]byte{0, 0, 1, 0, 5, 0}, []byte{1, 0, 2, 0, 6, 0})
+	f.Add([]byte{}, []byte{3, 0})
+	f.Add([]byte{0xff, 0xff, 0, 0}, []byte{0xfe, 0xff, 0, 0})
+
+	f.Fuzz(func(t *testing.T, x, y []byte) {
+		a, b := New(numbersOf(x)...), New(numbersOf(y)...)
+		as, bs := setOf(numbersOf(x)), setOf(numbersOf(y))
+
+		union, intersection, difference := make(map[uint32]struct{}), make(map[uint32]struct{}), make(map[uint32]struct{})
+		for number := range as {
+			union[number] = struct{}{}
+			if _, ok := bs[number]; ok {
+				intersection[number] = struct{}{}
+			} else {
+				difference[number] = struct{}{}
+			}
+		}
+
+		for number := range bs {
+			union[number] = struct{}{}
+		}
+
+		for _, op := range []struct {
+			name string
+			got  *Bitmap
+			want map[uint32]struct{}
+		}{
+			{name: "Union", got: Union(a, b), want: union},
+			{name: "Intersection", got: Intersection(a, b), want: intersection},
+			{name: "Difference", got: Difference(a, b), want: difference},
+		} {
+			checkRuns(t, op.got)
+			if want := sortedOf(op.want); !equalNumbers(op.got.Numbers(), want) {
+				t.Fatalf("%s() = %v, want %v", op.name, op.got.Numbers(), want)
+			}
+		}
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dataset encodes the training datasets of trainer.v1.
+//
+// The dataset is a trainer.v1.DatasetHeader followed by the records of the dataset type,
+// every message is encoded as the uvarint length of the message followed by the message,
+// the DOWNLOAD_DATASET contains trainer.v1.DownloadRecord and the NETWORK_TOPOLOGY_DATASET
+// contains trainer.v1.NetworkTopologyRecord. The dataset can be split into any chunks
+// of trainer.v1.TrainRequest, and the trainer concatenates the chunks to decode it.
+// The large dataset should be uploaded by Uploader, which resumes the broken upload, and the
+// trainer.v1.TrainRequest refers to the committed dataset by the dataset id.
+package dataset
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+
+	trainerv1 "d7y.io/api/v2/pkg/apis/trainer/v1"
+)
+
+const (
+	// SchemaVersion is the schema version of the records written by Writer.
+	SchemaVersion uint32 = 1
+
+	// MaxRecordSize is the maximum size of the encoded record.
+	MaxRecordSize = 64 * 1024 * 1024
+)
+
+var (
+	// ErrUnsupportedSchemaVersion is returned when the schema version of the dataset is not supported.
+	ErrUnsupportedSchemaVersion = errors.New("unsupported dataset schema version")
+
+	// ErrDatasetTypeMismatch is returned when the record does not match the dataset type.
+	ErrDatasetTypeMismatch = errors.New("dataset type mismatch")
+)
+
+// Writer writes the records of the dataset, it is not safe for concurrent use.
+type Writer struct {
+	// w is the buffered writer of the dataset.
+	w *bufio.Writer
+
+	// datasetType is the dataset type of the header.
+	datasetType trainerv1.DatasetType
+}
+
+// NewWriter returns the writer of the dataset type, and writes the header of the dataset.
+func NewWriter(w io.Writer, datasetType trainerv1.DatasetType) (*Writer, error) {
+	header := &trainerv1.DatasetHeader{
+		SchemaVersion: SchemaVersion,
+		Type:          datasetType,
+	}
+
+	if err := header.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid dataset header: %w", err)
+	}
+
+	dw := &Writer{w: bufio.NewWriter(w), datasetType: datasetType}
+	if _, err := protodelim.MarshalTo(dw.w, header); err != nil {
+		return nil, err
+	}
+
+	return dw, nil
+}
+
+// WriteDownloadRecord writes the download record to the DOWNLOAD_DATASET.
+func (w *Writer) WriteDownloadRecord(record *trainerv1.DownloadRecord) error {
+	if w.datasetType != trainerv1.DatasetType_DOWNLOAD_DATASET {
+		return ErrDatasetTypeMismatch
+	}
+
+	if err := record.Validate(); err != nil {
+		return fmt.Errorf("invalid download record: %w", err)
+	}
+
+	return w.write(record)
+}
+
+// WriteNetworkTopologyRecord writes the network topology record to the NETWORK_TOPOLOGY_DATASET.
+func (w *Writer) WriteNetworkTopologyRecord(record *trainerv1.NetworkTopologyRecord) error {
+	if w.datasetType != trainerv1.DatasetType_NETWORK_TOPOLOGY_DATASET {
+		return ErrDatasetTypeMismatch
+	}
+
+	if err := record.Validate(); err != nil {
+		return fmt.Errorf("invalid network topology record: %w", err)
+	}
+
+	return w.write(record)
+}
+
+// write writes the length-delimited record.
+func (w *Writer) write(record proto.Message) error {
+	if size := proto.Size(record); size > MaxRecordSize {
+		return fmt.Errorf("record size %d exceeds %d", size, MaxRecordSize)
+	}
+
+	_, err := protodelim.MarshalTo(w.w, record)
+	return err
+}
+
+// Flush writes the buffered records to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Reader reads the records of the dataset, it is not safe for concurrent use.
+type Reader struct {
+	// r is the buffered reader of the dataset.
+	r *bufio.Reader
+
+	// header is the header of the dataset.
+	header *trainerv1.DatasetHeader
+}
+
+// NewReader returns the reader of the dataset, and reads the header of the dataset.
+func NewReader(r io.Reader) (*Reader, error) {
+	dr := &Reader{r: bufio.NewReader(r), header: &trainerv1.DatasetHeader{}}
+	if err := dr.read(dr.header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		return nil, err
+	}
+
+	if err := dr.header.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid dataset header: %w", err)
+	}
+
+	if dr.header.GetSchemaVersion() != SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSchemaVersion, dr.header.GetSchemaVersion())
+	}
+
+	return dr, nil
+}
+
+// Header returns the header of the dataset.
+func (r *Reader) Header() *trainerv1.DatasetHeader {
+	return r.header
+}
+
+// ReadDownloadRecord reads the next download record of the DOWNLOAD_DATASET,
+// it returns io.EOF if there are no more records.
+func (r *Reader) ReadDownloadRecord() (*trainerv1.DownloadRecord, error) {
+	if r.header.GetType() != trainerv1.DatasetType_DOWNLOAD_DATASET {
+		return nil, ErrDatasetTypeMismatch
+	}
+
+	record := &trainerv1.DownloadRecord{}
+	if err := r.read(record); err != nil {
+		return nil, err
+	}
+
+	if err := record.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download record: %w", err)
+	}
+
+	return record, nil
+}
+
+// ReadNetworkTopologyRecord reads the next network topology record of the NETWORK_TOPOLOGY_DATASET,
+// it returns io.EOF if there are no more records.
+func (r *Reader) ReadNetworkTopologyRecord() (*trainerv1.NetworkTopologyRecord, error) {
+	if r.header.GetType() != trainerv1.DatasetType_NETWORK_TOPOLOGY_DATASET {
+		return nil, ErrDatasetTypeMismatch
+	}
+
+	record := &trainerv1.NetworkTopologyRecord{}
+	if err := r.read(record); err != nil {
+		return nil, err
+	}
+
+	if err := record.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid network topology record: %w", err)
+	}
+
+	return record, nil
+}
+
+// read reads the length-delimited message.
+func (r *Reader) read(m proto.Message) error {
+	return protodelim.UnmarshalOptions{MaxSize: MaxRecordSize}.UnmarshalFrom(r.r, m)
+}
@@ -0,0 +1,265 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	trainerv1 "d7y.io/api/v2/pkg/apis/trainer/v1"
+)
+
+// testHost returns the valid host features of the host id.
+func testHost(id string) *commonv2.Host {
+	return &commonv2.Host{
+		Id:           id,
+		Hostname:     id,
+		Ip:           "127.0.0.1",
+		Port:         8002,
+		DownloadPort: 8001,
+	}
+}
+
+// testDownloadRecord returns the valid download record of the task id.
+func testDownloadRecord(taskID string) *trainerv1.DownloadRecord {
+	return &trainerv1.DownloadRecord{
+		TaskId:        taskID,
+		ContentLength: 100,
+		PieceCount:    1,
+		PeerId:        "peer",
+		Host:          testHost("child"),
+		Parents:       []*trainerv1.ParentRecord{{Id: "parent", Host: testHost("parent")}},
+		Pieces: []*trainerv1.PieceRecord{
+			{Number: 0, ParentId: "parent", Length: 100, Cost: durationpb.New(time.Millisecond)},
+		},
+		Cost:      durationpb.New(time.Second),
+		CreatedAt: timestamppb.Now(),
+	}
+}
+
+// testNetworkTopologyRecord returns the valid network topology record of the source host id.
+func testNetworkTopologyRecord(srcHostID string) *trainerv1.NetworkTopologyRecord {
+	return &trainerv1.NetworkTopologyRecord{
+		SrcHost:    testHost(srcHostID),
+		DestHost:   testHost("dest"),
+		AverageRtt: durationpb.New(time.Millisecond),
+		LossRate:   0.1,
+		ProbeCount: 10,
+		CreatedAt:  timestamppb.Now(),
+	}
+}
+
+func TestDownloadDataset(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, trainerv1.DatasetType_DOWNLOAD_DATASET)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	records := []*trainerv1.DownloadRecord{testDownloadRecord("foo"), testDownloadRecord("bar")}
+	for _, record := range records {
+		if err := w.WriteDownloadRecord(record); err != nil {
+			t.Fatalf("WriteDownloadRecord() error = %v", err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	if header := r.Header(); header.GetSchemaVersion() != SchemaVersion || header.GetType() != trainerv1.DatasetType_DOWNLOAD_DATASET {
+		t.Fatalf("Header() = %v", header)
+	}
+
+	for _, want := range records {
+		record, err := r.ReadDownloadRecord()
+		if err != nil {
+			t.Fatalf("ReadDownloadRecord() error = %v", err)
+		}
+
+		if !proto.Equal(record, want) {
+			t.Fatalf("ReadDownloadRecord() = %v, want %v", record, want)
+		}
+	}
+
+	if _, err := r.ReadDownloadRecord(); !errors.Is(err, io.EOF) {
+		t.Fatalf("ReadDownloadRecord() at the end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestNetworkTopologyDataset(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, trainerv1.DatasetType_NETWORK_TOPOLOGY_DATASET)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	record := testNetworkTopologyRecord("src")
+	if err := w.WriteNetworkTopologyRecord(record); err != nil {
+		t.Fatalf("WriteNetworkTopologyRecord() error = %v", err)
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	// The dataset is concatenated by the chunks.
+	data := buf.Bytes()
+	chunks := [][]byte{data[:3], data[3:10], data[10:]}
+	r, err := NewReader(bytes.NewReader(bytes.Join(chunks, nil)))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	got, err := r.ReadNetworkTopologyRecord()
+	if err != nil {
+		t.Fatalf("ReadNetworkTopologyRecord() error = %v", err)
+	}
+
+	if !proto.Equal(got, record) {
+		t.Fatalf("ReadNetworkTopologyRecord() = %v, want %v", got, record)
+	}
+
+	if _, err := r.ReadNetworkTopologyRecord(); !errors.Is(err, io.EOF) {
+		t.Fatalf("ReadNetworkTopologyRecord() at the end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestDatasetTypeMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, trainerv1.DatasetType_DOWNLOAD_DATASET)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	if err := w.WriteNetworkTopologyRecord(testNetworkTopologyRecord("src")); !errors.Is(err, ErrDatasetTypeMismatch) {
+		t.Fatalf("WriteNetworkTopologyRecord() error = %v, want %v", err, ErrDatasetTypeMismatch)
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	if _, err := r.ReadNetworkTopologyRecord(); !errors.Is(err, ErrDatasetTypeMismatch) {
+		t.Fatalf("ReadNetworkTopologyRecord() error = %v, want %v", err, ErrDatasetTypeMismatch)
+	}
+}
+
+func TestWriteInvalidRecord(t *testing.T) {
+	w, err := NewWriter(io.Discard, trainerv1.DatasetType_DOWNLOAD_DATASET)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	record := testDownloadRecord("foo")
+	record.Host = nil
+	if err := w.WriteDownloadRecord(record); err == nil {
+		t.Fatal("WriteDownloadRecord() of record without host error = nil, want error")
+	}
+}
+
+func TestNewReaderInvalidHeader(t *testing.T) {
+	encode := func(header *trainerv1.DatasetHeader) []byte {
+		var buf bytes.Buffer
+		if _, err := protodelim.MarshalTo(&buf, header); err != nil {
+			t.Fatalf("MarshalTo() error = %v", err)
+		}
+
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{
+			name: "empty dataset",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{
+			name: "unsupported schema version",
+			data: encode(&trainerv1.DatasetHeader{SchemaVersion: SchemaVersion + 1}),
+			err:  ErrUnsupportedSchemaVersion,
+		},
+		{
+			name: "invalid schema version",
+			data: encode(&trainerv1.DatasetHeader{}),
+		},
+		{
+			name: "truncated header",
+			data: encode(&trainerv1.DatasetHeader{SchemaVersion: SchemaVersion})[:2],
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewReader(bytes.NewReader(tc.data))
+			if err == nil {
+				t.Fatal("NewReader() error = nil, want error")
+			}
+
+			if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Fatalf("NewReader() error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestReadTruncatedRecord(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, trainerv1.DatasetType_DOWNLOAD_DATASET)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	if err := w.WriteDownloadRecord(testDownloadRecord("foo")); err != nil {
+		t.Fatalf("WriteDownloadRecord() error = %v", err)
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	if _, err := r.ReadDownloadRecord(); err == nil || errors.Is(err, io.EOF) {
+		t.Fatalf("ReadDownloadRecord() of truncated record error = %v, want unexpected EOF", err)
+	}
+}
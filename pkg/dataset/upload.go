@@ -0,0 +1,367 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	trainerv1 "d7y.io/api/v2/pkg/apis/trainer/v1"
+)
+
+const (
+	// DefaultChunkSize is the default size of the uploaded chunk.
+	DefaultChunkSize = 1024 * 1024
+
+	// MaxChunkSize is the maximum size of the uploaded chunk, refer to trainer.v1.UploadDatasetChunkRequest.
+	MaxChunkSize = 4 * 1024 * 1024
+
+	// DefaultMaxAttempts is the default maximum attempts of uploading the dataset.
+	DefaultMaxAttempts = 5
+
+	// minRetryInterval is the minimum interval of resuming the broken upload.
+	minRetryInterval = time.Second
+
+	// maxRetryInterval is the maximum interval of resuming the broken upload.
+	maxRetryInterval = 30 * time.Second
+)
+
+// ErrInvalidTrainDataset is returned when the train request sets both or neither of the dataset and the dataset id.
+var ErrInvalidTrainDataset = errors.New("exactly one of dataset and dataset_id must be set")
+
+// TrainDataset returns the dataset chunk or the dataset id of the GNN or MLP train request,
+// exactly one of them is not empty.
+func TrainDataset(req *trainerv1.TrainRequest) ([]byte, string, error) {
+	var (
+		data      []byte
+		datasetID string
+	)
+
+	switch r := req.GetRequest().(type) {
+	case *trainerv1.TrainRequest_TrainGnnRequest:
+		data, datasetID = r.TrainGnnRequest.GetDataset(), r.TrainGnnRequest.GetDatasetId()
+	case *trainerv1.TrainRequest_TrainMlpRequest:
+		data, datasetID = r.TrainMlpRequest.GetDataset(), r.TrainMlpRequest.GetDatasetId()
+	default:
+		return nil, "", fmt.Errorf("unexpected request %T of training", req.GetRequest())
+	}
+
+	if (len(data) > 0) == (datasetID != "") {
+		return nil, "", ErrInvalidTrainDataset
+	}
+
+	return data, datasetID, nil
+}
+
+// Uploader uploads the datasets by trainer.v1.Trainer/UploadDataset, and resumes
+// the broken upload from the acknowledged offset.
+type Uploader struct {
+	// client is the client of trainer.v1.Trainer.
+	client trainerv1.TrainerClient
+
+	// hostname is the hostname of the scheduler.
+	hostname string
+
+	// ip is the ip of the scheduler.
+	ip string
+
+	// chunkSize is the size of the uploaded chunk.
+	chunkSize int
+
+	// maxAttempts is the maximum attempts of uploading the dataset.
+	maxAttempts int
+}
+
+// UploaderOption is a functional option for configuring the Uploader.
+type UploaderOption func(u *Uploader)
+
+// WithChunkSize sets the size of the uploaded chunk, the non-positive size is replaced by
+// DefaultChunkSize, and the size is limited to MaxChunkSize.
+func WithChunkSize(chunkSize int) UploaderOption {
+	return func(u *Uploader) {
+		switch {
+		case chunkSize <= 0:
+			u.chunkSize = DefaultChunkSize
+		case chunkSize > MaxChunkSize:
+			u.chunkSize = MaxChunkSize
+		default:
+			u.chunkSize = chunkSize
+		}
+	}
+}
+
+// WithMaxAttempts sets the maximum attempts of uploading the dataset.
+func WithMaxAttempts(maxAttempts int) UploaderOption {
+	return func(u *Uploader) {
+		u.maxAttempts = maxAttempts
+	}
+}
+
+// NewUploader returns a new Uploader of the scheduler.
+func NewUploader(client trainerv1.TrainerClient, hostname, ip string, options ...UploaderOption) *Uploader {
+	u := &Uploader{
+		client:      client,
+		hostname:    hostname,
+		ip:          ip,
+		chunkSize:   DefaultChunkSize,
+		maxAttempts: DefaultMaxAttempts,
+	}
+
+	for _, opt := range options {
+		opt(u)
+	}
+
+	return u
+}
+
+// Upload uploads the dataset of the reader and commits it, the upload is retried
+// when the trainer is unavailable, and it is resumed from the acknowledged offset.
+func (u *Uploader) Upload(ctx context.Context, datasetID string, datasetType trainerv1.DatasetType, r io.ReadSeeker) (*trainerv1.DatasetCommittedResponse, error) {
+	commit, err := digest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := minRetryInterval
+	for attempt := 1; ; attempt++ {
+		resp, err := u.upload(ctx, datasetID, datasetType, r, commit)
+		if err == nil {
+			return resp, nil
+		}
+
+		if attempt >= u.maxAttempts || !retryable(err) {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if interval *= 2; interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+// upload uploads the dataset from the acknowledged offset in one stream.
+func (u *Uploader) upload(ctx context.Context, datasetID string, datasetType trainerv1.DatasetType, r io.ReadSeeker, commit *trainerv1.CommitDatasetRequest) (*trainerv1.DatasetCommittedResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := u.client.UploadDataset(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&trainerv1.UploadDatasetRequest{
+		Hostname: u.hostname,
+		Ip:       u.ip,
+		Request: &trainerv1.UploadDatasetRequest_StartUploadDatasetRequest{
+			StartUploadDatasetRequest: &trainerv1.StartUploadDatasetRequest{
+				DatasetId: datasetID,
+				Type:      datasetType,
+			},
+		},
+	}); err != nil {
+		return nil, recvError(stream, err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	started := resp.GetUploadDatasetStartedResponse()
+	if started == nil {
+		return nil, fmt.Errorf("unexpected response %T of starting upload", resp.GetResponse())
+	}
+
+	offset, sequence := started.GetOffset(), started.GetNextSequence()
+	if offset > commit.GetSize() {
+		return nil, fmt.Errorf("acknowledged offset %d exceeds dataset size %d", offset, commit.GetSize())
+	}
+
+	if _, err := r.Seek(int64(offset), io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	// Receive and check the acknowledgements until the dataset is committed, the sent offset
+	// is the end offset of the chunks which are sent, and the acknowledged offset can not exceed it.
+	var sent atomic.Uint64
+	sent.Store(offset)
+	done := make(chan error, 1)
+	var committed *trainerv1.DatasetCommittedResponse
+	go func(acked uint64) {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				done <- err
+				return
+			}
+
+			switch r := resp.GetResponse().(type) {
+			case *trainerv1.UploadDatasetResponse_UploadDatasetChunkAckResponse:
+				ack := r.UploadDatasetChunkAckResponse
+				if ack.GetOffset() < acked || ack.GetOffset() > sent.Load() {
+					done <- fmt.Errorf("invalid acknowledged offset %d of sequence %d, acknowledged offset is %d and sent offset is %d",
+						ack.GetOffset(), ack.GetSequence(), acked, sent.Load())
+					return
+				}
+
+				acked = ack.GetOffset()
+			case *trainerv1.UploadDatasetResponse_DatasetCommittedResponse:
+				committed = r.DatasetCommittedResponse
+				done <- nil
+				return
+			default:
+				done <- fmt.Errorf("unexpected response %T of uploading chunks", resp.GetResponse())
+				return
+			}
+		}
+	}(offset)
+
+	for offset < commit.GetSize() {
+		// Stop uploading if the trainer rejects the upload or acknowledges the invalid offset.
+		select {
+		case err := <-done:
+			if err == nil {
+				err = errors.New("dataset is committed before all chunks are uploaded")
+			}
+
+			return nil, err
+		default:
+		}
+
+		size := uint64(u.chunkSize)
+		if remaining := commit.GetSize() - offset; remaining < size {
+			size = remaining
+		}
+
+		// The sent message can not be modified, so every chunk has its own buffer.
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		sent.Store(offset + size)
+		if err := stream.Send(&trainerv1.UploadDatasetRequest{
+			Hostname: u.hostname,
+			Ip:       u.ip,
+			Request: &trainerv1.UploadDatasetRequest_UploadDatasetChunkRequest{
+				UploadDatasetChunkRequest: &trainerv1.UploadDatasetChunkRequest{
+					Sequence: sequence,
+					Offset:   offset,
+					Data:     data,
+				},
+			},
+		}); err != nil {
+			return nil, waitError(done, err)
+		}
+
+		offset += size
+		sequence++
+	}
+
+	if err := stream.Send(&trainerv1.UploadDatasetRequest{
+		Hostname: u.hostname,
+		Ip:       u.ip,
+		Request: &trainerv1.UploadDatasetRequest_CommitDatasetRequest{
+			CommitDatasetRequest: commit,
+		},
+	}); err != nil {
+		return nil, waitError(done, err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	if err := <-done; err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, status.Error(codes.Unavailable, "upload stream is closed before the dataset is committed")
+		}
+
+		return nil, err
+	}
+
+	return committed, nil
+}
+
+// digest returns the commit request of the size and the digest of the dataset.
+func digest(r io.ReadSeeker) (*trainerv1.CommitDatasetRequest, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	size, err := io.Copy(h, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trainerv1.CommitDatasetRequest{
+		Size:   uint64(size),
+		Digest: "sha256:" + hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// recvError returns the status error of the stream if sending returns io.EOF.
+func recvError(stream trainerv1.Trainer_UploadDatasetClient, err error) error {
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+
+	return status.Error(codes.Unavailable, "upload stream is closed")
+}
+
+// waitError returns the status error received by the receiving goroutine if sending returns io.EOF.
+func waitError(done <-chan error, err error) error {
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if err := <-done; err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	return status.Error(codes.Unavailable, "upload stream is closed")
+}
+
+// retryable returns whether the broken upload can be resumed.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
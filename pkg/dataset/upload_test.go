@@ -0,0 +1,350 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dataset
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	trainerv1 "d7y.io/api/v2/pkg/apis/trainer/v1"
+)
+
+// bufSize is the buffer size of the bufconn listener.
+const bufSize = 1024 * 1024
+
+// testData is the dataset of the upload tests, it is uploaded in three chunks of size 4.
+var testData = []byte("0123456789")
+
+// fakeTrainer persists the uploaded chunks in memory.
+type fakeTrainer struct {
+	trainerv1.UnimplementedTrainerServer
+
+	// mu protects the following fields.
+	mu sync.Mutex
+
+	// datasets is the persisted data by dataset id.
+	datasets map[string][]byte
+
+	// sequences is the next sequence by dataset id.
+	sequences map[string]uint64
+
+	// offsets is the offsets of the received chunks.
+	offsets []uint64
+
+	// streams is the count of the upload streams.
+	streams int
+
+	// failAfter breaks the first stream with Unavailable after persisting the chunks if it is positive.
+	failAfter int
+
+	// invalidAck acknowledges the offset which exceeds the sent offset.
+	invalidAck bool
+}
+
+// newTestUploader serves the fake trainer by bufconn, and returns the uploader of the trainer.
+func newTestUploader(t *testing.T, trainer *fakeTrainer, options ...UploaderOption) *Uploader {
+	t.Helper()
+	s := grpc.NewServer()
+	trainerv1.RegisterTrainerServer(s, trainer)
+
+	lis := bufconn.Listen(bufSize)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+	return NewUploader(trainerv1.NewTrainerClient(conn), "scheduler", "127.0.0.1", append([]UploaderOption{WithChunkSize(4)}, options...)...)
+}
+
+// UploadDataset persists the chunks and acknowledges them one by one.
+func (f *fakeTrainer) UploadDataset(stream trainerv1.Trainer_UploadDatasetServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	start := req.GetStartUploadDatasetRequest()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "upload is not started")
+	}
+
+	id := start.GetDatasetId()
+	f.mu.Lock()
+	f.streams++
+	first := f.streams == 1
+	offset, sequence := uint64(len(f.datasets[id])), f.sequences[id]
+	f.mu.Unlock()
+
+	if err := stream.Send(&trainerv1.UploadDatasetResponse{
+		Response: &trainerv1.UploadDatasetResponse_UploadDatasetStartedResponse{
+			UploadDatasetStartedResponse: &trainerv1.UploadDatasetStartedResponse{Offset: offset, NextSequence: sequence},
+		},
+	}); err != nil {
+		return err
+	}
+
+	for received := 1; ; received++ {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch r := req.GetRequest().(type) {
+		case *trainerv1.UploadDatasetRequest_UploadDatasetChunkRequest:
+			chunk := r.UploadDatasetChunkRequest
+			f.mu.Lock()
+			if chunk.GetOffset() != uint64(len(f.datasets[id])) || chunk.GetSequence() != f.sequences[id] {
+				f.mu.Unlock()
+				return status.Errorf(codes.FailedPrecondition, "unexpected chunk of offset %d and sequence %d", chunk.GetOffset(), chunk.GetSequence())
+			}
+
+			f.datasets[id] = append(f.datasets[id], chunk.GetData()...)
+			f.sequences[id]++
+			f.offsets = append(f.offsets, chunk.GetOffset())
+			acked := uint64(len(f.datasets[id]))
+			f.mu.Unlock()
+
+			if first && received == f.failAfter {
+				return status.Error(codes.Unavailable, "trainer is restarting")
+			}
+
+			if f.invalidAck {
+				acked += uint64(len(testData))
+			}
+
+			if err := stream.Send(&trainerv1.UploadDatasetResponse{
+				Response: &trainerv1.UploadDatasetResponse_UploadDatasetChunkAckResponse{
+					UploadDatasetChunkAckResponse: &trainerv1.UploadDatasetChunkAckResponse{Sequence: chunk.GetSequence(), Offset: acked},
+				},
+			}); err != nil {
+				return err
+			}
+		case *trainerv1.UploadDatasetRequest_CommitDatasetRequest:
+			commit := r.CommitDatasetRequest
+			f.mu.Lock()
+			digest := sha256.Sum256(f.datasets[id])
+			size := uint64(len(f.datasets[id]))
+			f.mu.Unlock()
+
+			if size != commit.GetSize() || "sha256:"+hex.EncodeToString(digest[:]) != commit.GetDigest() {
+				return status.Error(codes.DataLoss, "dataset mismatch")
+			}
+
+			return stream.Send(&trainerv1.UploadDatasetResponse{
+				Response: &trainerv1.UploadDatasetResponse_DatasetCommittedResponse{
+					DatasetCommittedResponse: &trainerv1.DatasetCommittedResponse{Size: commit.GetSize(), Digest: commit.GetDigest()},
+				},
+			})
+		default:
+			return status.Errorf(codes.InvalidArgument, "unexpected request %T", req.GetRequest())
+		}
+	}
+}
+
+// newFakeTrainer returns the fake trainer without datasets.
+func newFakeTrainer() *fakeTrainer {
+	return &fakeTrainer{datasets: make(map[string][]byte), sequences: make(map[string]uint64)}
+}
+
+// upload uploads the test data as the dataset foo.
+func upload(u *Uploader) (*trainerv1.DatasetCommittedResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return u.Upload(ctx, "foo", trainerv1.DatasetType_DOWNLOAD_DATASET, bytes.NewReader(testData))
+}
+
+// checkUploaded checks the dataset foo is committed, and the offsets of the received chunks.
+func checkUploaded(t *testing.T, trainer *fakeTrainer, resp *trainerv1.DatasetCommittedResponse, offsets []uint64) {
+	t.Helper()
+	digest := sha256.Sum256(testData)
+	if resp.GetSize() != uint64(len(testData)) || resp.GetDigest() != "sha256:"+hex.EncodeToString(digest[:]) {
+		t.Fatalf("Upload() = %v", resp)
+	}
+
+	trainer.mu.Lock()
+	defer trainer.mu.Unlock()
+	if !bytes.Equal(trainer.datasets["foo"], testData) {
+		t.Fatalf("uploaded dataset = %q, want %q", trainer.datasets["foo"], testData)
+	}
+
+	if !reflect.DeepEqual(trainer.offsets, offsets) {
+		t.Fatalf("offsets of the received chunks = %v, want %v", trainer.offsets, offsets)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	trainer := newFakeTrainer()
+	resp, err := upload(newTestUploader(t, trainer))
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	checkUploaded(t, trainer, resp, []uint64{0, 4, 8})
+}
+
+func TestUploadResume(t *testing.T) {
+	// The first chunk is persisted by the previous upload.
+	trainer := newFakeTrainer()
+	trainer.datasets["foo"] = append([]byte(nil), testData[:4]...)
+	trainer.sequences["foo"] = 1
+
+	resp, err := upload(newTestUploader(t, trainer))
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	checkUploaded(t, trainer, resp, []uint64{4, 8})
+}
+
+func TestUploadRetryUnavailable(t *testing.T) {
+	trainer := newFakeTrainer()
+	trainer.failAfter = 2
+
+	resp, err := upload(newTestUploader(t, trainer))
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	checkUploaded(t, trainer, resp, []uint64{0, 4, 8})
+	trainer.mu.Lock()
+	defer trainer.mu.Unlock()
+	if trainer.streams != 2 {
+		t.Fatalf("upload streams = %d, want 2", trainer.streams)
+	}
+}
+
+func TestUploadMaxAttempts(t *testing.T) {
+	trainer := newFakeTrainer()
+	trainer.failAfter = 1
+
+	if _, err := upload(newTestUploader(t, trainer, WithMaxAttempts(1))); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Upload() error = %v, want %v", err, codes.Unavailable)
+	}
+}
+
+func TestUploadInvalidAck(t *testing.T) {
+	trainer := newFakeTrainer()
+	trainer.invalidAck = true
+
+	if _, err := upload(newTestUploader(t, trainer)); err == nil {
+		t.Fatal("Upload() error = nil, want error")
+	}
+
+	// The invalid acknowledgement is not retried.
+	trainer.mu.Lock()
+	defer trainer.mu.Unlock()
+	if trainer.streams != 1 {
+		t.Fatalf("upload streams = %d, want 1", trainer.streams)
+	}
+}
+
+func TestWithChunkSize(t *testing.T) {
+	for _, tc := range []struct {
+		chunkSize int
+		want      int
+	}{
+		{chunkSize: -1, want: DefaultChunkSize},
+		{chunkSize: 0, want: DefaultChunkSize},
+		{chunkSize: 1, want: 1},
+		{chunkSize: MaxChunkSize, want: MaxChunkSize},
+		{chunkSize: MaxChunkSize + 1, want: MaxChunkSize},
+	} {
+		if u := NewUploader(nil, "scheduler", "127.0.0.1", WithChunkSize(tc.chunkSize)); u.chunkSize != tc.want {
+			t.Fatalf("WithChunkSize(%d) chunk size = %d, want %d", tc.chunkSize, u.chunkSize, tc.want)
+		}
+	}
+}
+
+func TestTrainDataset(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *trainerv1.TrainRequest
+		data      []byte
+		datasetID string
+		err       error
+	}{
+		{
+			name: "gnn dataset",
+			req: &trainerv1.TrainRequest{Request: &trainerv1.TrainRequest_TrainGnnRequest{
+				TrainGnnRequest: &trainerv1.TrainGNNRequest{Dataset: testData},
+			}},
+			data: testData,
+		},
+		{
+			name: "mlp dataset id",
+			req: &trainerv1.TrainRequest{Request: &trainerv1.TrainRequest_TrainMlpRequest{
+				TrainMlpRequest: &trainerv1.TrainMLPRequest{DatasetId: "foo"},
+			}},
+			datasetID: "foo",
+		},
+		{
+			name: "both",
+			req: &trainerv1.TrainRequest{Request: &trainerv1.TrainRequest_TrainMlpRequest{
+				TrainMlpRequest: &trainerv1.TrainMLPRequest{Dataset: testData, DatasetId: "foo"},
+			}},
+			err: ErrInvalidTrainDataset,
+		},
+		{
+			name: "neither",
+			req: &trainerv1.TrainRequest{Request: &trainerv1.TrainRequest_TrainGnnRequest{
+				TrainGnnRequest: &trainerv1.TrainGNNRequest{},
+			}},
+			err: ErrInvalidTrainDataset,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, datasetID, err := TrainDataset(tc.req)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("TrainDataset() error = %v, want %v", err, tc.err)
+			}
+
+			if !bytes.Equal(data, tc.data) || datasetID != tc.datasetID {
+				t.Fatalf("TrainDataset() = %q, %q, want %q, %q", data, datasetID, tc.data, tc.datasetID)
+			}
+		})
+	}
+
+	if _, _, err := TrainDataset(&trainerv1.TrainRequest{}); err == nil {
+		t.Fatal("TrainDataset() without request error = nil, want error")
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package downloadstats aggregates common.v2.DownloadStatistics of the peer from the
+// scheduler.v2.AnnouncePeerRequest events, and exports the statistics as newline-delimited protojson.
+package downloadstats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	schedulerv2 "d7y.io/api/v2/pkg/apis/scheduler/v2"
+)
+
+// Aggregator aggregates the download statistics of the peer from the announced events,
+// it is safe for concurrent use.
+type Aggregator struct {
+	// hostID is the host id of the peer.
+	hostID string
+
+	// taskID is the task id of the peer.
+	taskID string
+
+	// peerID is the peer id.
+	peerID string
+
+	// mu protects the following fields.
+	mu sync.Mutex
+
+	// startedAt is the download start time.
+	startedAt time.Time
+
+	// finishedAt is the download finish time, it is zero if the download is running.
+	finishedAt time.Time
+
+	// totalPieceCost is the sum of the piece costs.
+	totalPieceCost time.Duration
+
+	// downloadedBytes is the downloaded bytes.
+	downloadedBytes uint64
+
+	// finishedPieceCount is the count of the finished pieces.
+	finishedPieceCount uint32
+
+	// failedPieceCount is the count of the failed piece downloads.
+	failedPieceCount uint32
+
+	// retryCount is the count of the retried piece downloads.
+	retryCount uint32
+
+	// parentSwitchCount is the count of switching to another parent, it is counted only when
+	// the failed piece is finished or failed again from another parent.
+	parentSwitchCount uint32
+
+	// backToSource indicates whether the peer downloads back-to-source.
+	backToSource bool
+
+	// parents is the parents from which the pieces are downloaded.
+	parents map[string]struct{}
+
+	// failedPieces is the parent id of the last failed download by piece number.
+	failedPieces map[int32]string
+
+	// traffics is the downloaded traffic by traffic type.
+	traffics map[commonv2.TrafficType]*commonv2.TrafficStatistics
+}
+
+// NewAggregator returns a new Aggregator of the peer, the download starts now.
+func NewAggregator(hostID, taskID, peerID string) *Aggregator {
+	return &Aggregator{
+		hostID:       hostID,
+		taskID:       taskID,
+		peerID:       peerID,
+		startedAt:    time.Now(),
+		parents:      make(map[string]struct{}),
+		failedPieces: make(map[int32]string),
+		traffics:     make(map[commonv2.TrafficType]*commonv2.TrafficStatistics),
+	}
+}
+
+// Observe aggregates the announced event of the peer, the events of the other peers are ignored.
+func (a *Aggregator) Observe(req *schedulerv2.AnnouncePeerRequest) {
+	if req.GetPeerId() != a.peerID {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch r := req.GetRequest().(type) {
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerStartedRequest:
+		a.startedAt = time.Now()
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceStartedRequest:
+		a.backToSource = true
+	case *schedulerv2.AnnouncePeerRequest_DownloadPieceFinishedRequest:
+		a.finishPiece(r.DownloadPieceFinishedRequest.GetPiece())
+	case *schedulerv2.AnnouncePeerRequest_DownloadPieceBackToSourceFinishedRequest:
+		a.finishPiece(r.DownloadPieceBackToSourceFinishedRequest.GetPiece())
+	case *schedulerv2.AnnouncePeerRequest_DownloadPieceFailedRequest:
+		a.failPiece(r.DownloadPieceFailedRequest.GetPiece())
+	case *schedulerv2.AnnouncePeerRequest_DownloadPieceBackToSourceFailedRequest:
+		a.failPiece(r.DownloadPieceBackToSourceFailedRequest.GetPiece())
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerFinishedRequest,
+		*schedulerv2.AnnouncePeerRequest_DownloadPeerFailedRequest:
+		a.finishedAt = time.Now()
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceFinishedRequest,
+		*schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceFailedRequest:
+		a.backToSource = true
+		a.finishedAt = time.Now()
+	}
+}
+
+// Attach observes the announced event, and attaches the download statistics to it if it is
+// a finished or failed peer event. It returns whether the statistics are attached.
+func (a *Aggregator) Attach(req *schedulerv2.AnnouncePeerRequest) bool {
+	if req.GetPeerId() != a.peerID {
+		return false
+	}
+
+	a.Observe(req)
+	switch r := req.GetRequest().(type) {
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerFinishedRequest:
+		r.DownloadPeerFinishedRequest.Statistics = a.Statistics()
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceFinishedRequest:
+		r.DownloadPeerBackToSourceFinishedRequest.Statistics = a.Statistics()
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerFailedRequest:
+		r.DownloadPeerFailedRequest.Statistics = a.Statistics()
+	case *schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceFailedRequest:
+		r.DownloadPeerBackToSourceFailedRequest.Statistics = a.Statistics()
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Statistics returns the aggregated download statistics, the finish time is now
+// if the download is running.
+func (a *Aggregator) Statistics() *commonv2.DownloadStatistics {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	finishedAt := a.finishedAt
+	if finishedAt.IsZero() {
+		finishedAt = time.Now()
+	}
+
+	stats := &commonv2.DownloadStatistics{
+		HostId:             a.hostID,
+		TaskId:             a.taskID,
+		PeerId:             a.peerID,
+		StartedAt:          timestamppb.New(a.startedAt),
+		FinishedAt:         timestamppb.New(finishedAt),
+		TotalPieceCost:     durationpb.New(a.totalPieceCost),
+		DownloadedBytes:    a.downloadedBytes,
+		FinishedPieceCount: a.finishedPieceCount,
+		FailedPieceCount:   a.failedPieceCount,
+		RetryCount:         a.retryCount,
+		ParentCount:        uint32(len(a.parents)),
+		ParentSwitchCount:  a.parentSwitchCount,
+		BackToSource:       a.backToSource,
+	}
+
+	for _, traffic := range a.traffics {
+		stats.Traffics = append(stats.Traffics, &commonv2.TrafficStatistics{
+			TrafficType: traffic.GetTrafficType(),
+			Bytes:       traffic.GetBytes(),
+			PieceCount:  traffic.GetPieceCount(),
+		})
+	}
+
+	sort.Slice(stats.Traffics, func(i, j int) bool {
+		return stats.Traffics[i].GetTrafficType() < stats.Traffics[j].GetTrafficType()
+	})
+
+	return stats
+}
+
+// finishPiece aggregates the finished piece.
+func (a *Aggregator) finishPiece(piece *commonv2.Piece) {
+	a.retry(piece)
+	delete(a.failedPieces, piece.GetNumber())
+
+	a.finishedPieceCount++
+	a.downloadedBytes += piece.GetLength()
+	a.totalPieceCost += piece.GetCost().AsDuration()
+	if piece.GetParentId() != "" {
+		a.parents[piece.GetParentId()] = struct{}{}
+	}
+
+	traffic, ok := a.traffics[piece.GetTrafficType()]
+	if !ok {
+		traffic = &commonv2.TrafficStatistics{TrafficType: piece.GetTrafficType()}
+		a.traffics[piece.GetTrafficType()] = traffic
+	}
+
+	traffic.Bytes += piece.GetLength()
+	traffic.PieceCount++
+}
+
+// failPiece aggregates the failed piece.
+func (a *Aggregator) failPiece(piece *commonv2.Piece) {
+	a.retry(piece)
+	a.failedPieceCount++
+	a.failedPieces[piece.GetNumber()] = piece.GetParentId()
+}
+
+// retry counts the retry and the parent switch if the piece failed before.
+func (a *Aggregator) retry(piece *commonv2.Piece) {
+	parentID, ok := a.failedPieces[piece.GetNumber()]
+	if !ok {
+		return
+	}
+
+	a.retryCount++
+	if parentID != piece.GetParentId() {
+		a.parentSwitchCount++
+	}
+}
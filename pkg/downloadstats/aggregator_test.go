@@ -0,0 +1,241 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package downloadstats
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	schedulerv2 "d7y.io/api/v2/pkg/apis/scheduler/v2"
+)
+
+const (
+	// testHostID is the host id of the aggregated peer.
+	testHostID = "host"
+
+	// testTaskID is the task id of the aggregated peer.
+	testTaskID = "task"
+
+	// testPeerID is the id of the aggregated peer.
+	testPeerID = "peer"
+)
+
+// newPiece returns the piece of the number downloaded from the parent in 10ms.
+func newPiece(number int32, parentID string, trafficType commonv2.TrafficType) *commonv2.Piece {
+	return &commonv2.Piece{
+		Number:      number,
+		ParentId:    parentID,
+		Length:      100,
+		TrafficType: trafficType,
+		Cost:        durationpb.New(10 * time.Millisecond),
+	}
+}
+
+// pieceFinished returns the event of the piece downloaded from the parent.
+func pieceFinished(number int32, parentID string) *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId: testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_DownloadPieceFinishedRequest{
+			DownloadPieceFinishedRequest: &schedulerv2.DownloadPieceFinishedRequest{Piece: newPiece(number, parentID, commonv2.TrafficType_REMOTE_PEER)},
+		},
+	}
+}
+
+// pieceFailed returns the event of the piece failed from the parent.
+func pieceFailed(number int32, parentID string) *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId: testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_DownloadPieceFailedRequest{
+			DownloadPieceFailedRequest: &schedulerv2.DownloadPieceFailedRequest{Piece: newPiece(number, parentID, commonv2.TrafficType_REMOTE_PEER), Temporary: true},
+		},
+	}
+}
+
+// pieceBackToSourceFinished returns the event of the piece downloaded back-to-source.
+func pieceBackToSourceFinished(number int32) *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId: testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_DownloadPieceBackToSourceFinishedRequest{
+			DownloadPieceBackToSourceFinishedRequest: &schedulerv2.DownloadPieceBackToSourceFinishedRequest{Piece: newPiece(number, "", commonv2.TrafficType_BACK_TO_SOURCE)},
+		},
+	}
+}
+
+// syncPiecesFailed returns the event of syncing pieces from the parent failed.
+func syncPiecesFailed() *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId:  testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_SyncPiecesFailedRequest{SyncPiecesFailedRequest: &schedulerv2.SyncPiecesFailedRequest{}},
+	}
+}
+
+// backToSourceStarted returns the event of the peer starting back-to-source.
+func backToSourceStarted() *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId: testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_DownloadPeerBackToSourceStartedRequest{
+			DownloadPeerBackToSourceStartedRequest: &schedulerv2.DownloadPeerBackToSourceStartedRequest{},
+		},
+	}
+}
+
+// peerFinished returns the event of the peer finished.
+func peerFinished() *schedulerv2.AnnouncePeerRequest {
+	return &schedulerv2.AnnouncePeerRequest{
+		PeerId: testPeerID,
+		Request: &schedulerv2.AnnouncePeerRequest_DownloadPeerFinishedRequest{
+			DownloadPeerFinishedRequest: &schedulerv2.DownloadPeerFinishedRequest{},
+		},
+	}
+}
+
+// withoutTimes returns the statistics without the start time and the finish time.
+func withoutTimes(stats *commonv2.DownloadStatistics) *commonv2.DownloadStatistics {
+	stats = proto.Clone(stats).(*commonv2.DownloadStatistics)
+	stats.StartedAt, stats.FinishedAt = nil, nil
+	return stats
+}
+
+func TestAggregatorObserve(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*schedulerv2.AnnouncePeerRequest
+		want   *commonv2.DownloadStatistics
+	}{
+		{
+			name:   "pieces from parents",
+			events: []*schedulerv2.AnnouncePeerRequest{pieceFinished(0, "a"), pieceFinished(1, "b"), pieceFinished(2, "a")},
+			want: &commonv2.DownloadStatistics{
+				TotalPieceCost:     durationpb.New(30 * time.Millisecond),
+				DownloadedBytes:    300,
+				FinishedPieceCount: 3,
+				ParentCount:        2,
+				Traffics:           []*commonv2.TrafficStatistics{{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 300, PieceCount: 3}},
+			},
+		},
+		{
+			name:   "retry from the same parent",
+			events: []*schedulerv2.AnnouncePeerRequest{pieceFailed(0, "a"), pieceFailed(0, "a"), pieceFinished(0, "a")},
+			want: &commonv2.DownloadStatistics{
+				TotalPieceCost:     durationpb.New(10 * time.Millisecond),
+				DownloadedBytes:    100,
+				FinishedPieceCount: 1,
+				FailedPieceCount:   2,
+				RetryCount:         2,
+				ParentCount:        1,
+				Traffics:           []*commonv2.TrafficStatistics{{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 100, PieceCount: 1}},
+			},
+		},
+		{
+			name:   "retry from another parent after syncing pieces failed",
+			events: []*schedulerv2.AnnouncePeerRequest{pieceFailed(0, "a"), syncPiecesFailed(), pieceFinished(0, "b"), pieceFinished(1, "b")},
+			want: &commonv2.DownloadStatistics{
+				TotalPieceCost:     durationpb.New(20 * time.Millisecond),
+				DownloadedBytes:    200,
+				FinishedPieceCount: 2,
+				FailedPieceCount:   1,
+				RetryCount:         1,
+				ParentCount:        1,
+				ParentSwitchCount:  1,
+				Traffics:           []*commonv2.TrafficStatistics{{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 200, PieceCount: 2}},
+			},
+		},
+		{
+			name:   "syncing pieces failed without retry",
+			events: []*schedulerv2.AnnouncePeerRequest{syncPiecesFailed(), pieceFinished(0, "b")},
+			want: &commonv2.DownloadStatistics{
+				TotalPieceCost:     durationpb.New(10 * time.Millisecond),
+				DownloadedBytes:    100,
+				FinishedPieceCount: 1,
+				ParentCount:        1,
+				Traffics:           []*commonv2.TrafficStatistics{{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 100, PieceCount: 1}},
+			},
+		},
+		{
+			name:   "back-to-source after failed piece",
+			events: []*schedulerv2.AnnouncePeerRequest{pieceFinished(0, "a"), pieceFailed(1, "a"), backToSourceStarted(), pieceBackToSourceFinished(1), peerFinished()},
+			want: &commonv2.DownloadStatistics{
+				TotalPieceCost:     durationpb.New(20 * time.Millisecond),
+				DownloadedBytes:    200,
+				FinishedPieceCount: 2,
+				FailedPieceCount:   1,
+				RetryCount:         1,
+				ParentCount:        1,
+				ParentSwitchCount:  1,
+				BackToSource:       true,
+				Traffics: []*commonv2.TrafficStatistics{
+					{TrafficType: commonv2.TrafficType_BACK_TO_SOURCE, Bytes: 100, PieceCount: 1},
+					{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 100, PieceCount: 1},
+				},
+			},
+		},
+		{
+			name: "events of other peers",
+			events: []*schedulerv2.AnnouncePeerRequest{
+				{PeerId: "other", Request: pieceFinished(0, "a").GetRequest()},
+				{PeerId: "other", Request: backToSourceStarted().GetRequest()},
+			},
+			want: &commonv2.DownloadStatistics{TotalPieceCost: durationpb.New(0)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewAggregator(testHostID, testTaskID, testPeerID)
+			for _, event := range tc.events {
+				a.Observe(event)
+			}
+
+			want := proto.Clone(tc.want).(*commonv2.DownloadStatistics)
+			want.HostId, want.TaskId, want.PeerId = testHostID, testTaskID, testPeerID
+			if got := withoutTimes(a.Statistics()); !proto.Equal(got, want) {
+				t.Fatalf("Statistics() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAggregatorAttach(t *testing.T) {
+	a := NewAggregator(testHostID, testTaskID, testPeerID)
+	if a.Attach(pieceFinished(0, "a")) {
+		t.Fatal("Attach() of the piece event returns true")
+	}
+
+	finished := peerFinished()
+	if !a.Attach(finished) {
+		t.Fatal("Attach() of the finished event returns false")
+	}
+
+	stats := finished.GetDownloadPeerFinishedRequest().GetStatistics()
+	if stats.GetFinishedPieceCount() != 1 {
+		t.Fatalf("attached finished_piece_count = %d, want 1", stats.GetFinishedPieceCount())
+	}
+
+	if stats.GetFinishedAt().AsTime().Before(stats.GetStartedAt().AsTime()) {
+		t.Fatalf("attached finished_at %v is before started_at %v", stats.GetFinishedAt().AsTime(), stats.GetStartedAt().AsTime())
+	}
+
+	other := peerFinished()
+	other.PeerId = "other"
+	if a.Attach(other) || other.GetDownloadPeerFinishedRequest().GetStatistics() != nil {
+		t.Fatal("Attach() attaches the statistics to the event of another peer")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package downloadstats
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+// MaxLineSize is the maximum size of the line of the decoded statistics.
+const MaxLineSize = 1024 * 1024
+
+// marshalOptions is the protojson options of the exported statistics, the fields are named by
+// the proto field names and the unpopulated fields are emitted, so the columns of the data
+// warehouse are stable.
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// Encoder writes the download statistics as newline-delimited protojson.
+type Encoder struct {
+	// w is the writer of the statistics.
+	w io.Writer
+}
+
+// NewEncoder returns a new Encoder of the writer.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the statistics as one line of protojson.
+func (e *Encoder) Encode(stats *commonv2.DownloadStatistics) error {
+	data, err := marshalOptions.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	// Compact protojson never contains newline, so the line is the whole message.
+	_, err = e.w.Write(append(data, '\n'))
+	return err
+}
+
+// Decoder reads the download statistics of newline-delimited protojson.
+type Decoder struct {
+	// scanner is the scanner of the lines.
+	scanner *bufio.Scanner
+}
+
+// NewDecoder returns a new Decoder of the reader.
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLineSize)
+	return &Decoder{scanner: scanner}
+}
+
+// Decode reads the next statistics, the empty lines are skipped, and io.EOF is
+// returned if there are no more statistics.
+func (d *Decoder) Decode() (*commonv2.DownloadStatistics, error) {
+	for d.scanner.Scan() {
+		line := bytes.TrimSpace(d.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		stats := &commonv2.DownloadStatistics{}
+		if err := protojson.Unmarshal(line, stats); err != nil {
+			return nil, err
+		}
+
+		return stats, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,113 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package downloadstats
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	startedAt := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	statistics := []*commonv2.DownloadStatistics{
+		{
+			HostId:             "host-1",
+			TaskId:             "task-1",
+			PeerId:             "peer-1",
+			StartedAt:          timestamppb.New(startedAt),
+			FinishedAt:         timestamppb.New(startedAt.Add(time.Second)),
+			TotalPieceCost:     durationpb.New(1500 * time.Millisecond),
+			DownloadedBytes:    4 << 20,
+			FinishedPieceCount: 4,
+			FailedPieceCount:   1,
+			RetryCount:         1,
+			ParentCount:        2,
+			ParentSwitchCount:  1,
+			BackToSource:       true,
+			Traffics: []*commonv2.TrafficStatistics{
+				{TrafficType: commonv2.TrafficType_BACK_TO_SOURCE, Bytes: 1 << 20, PieceCount: 1},
+				{TrafficType: commonv2.TrafficType_REMOTE_PEER, Bytes: 3 << 20, PieceCount: 3},
+			},
+		},
+		// The unpopulated statistics are emitted and decoded as the zero values.
+		{},
+		NewAggregator("host-2", "task-2", "peer-2").Statistics(),
+	}
+
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+	for _, stats := range statistics {
+		if err := encoder.Encode(stats); err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != len(statistics) {
+		t.Fatalf("encoded %d lines, want %d", len(lines), len(statistics))
+	}
+
+	// The columns are named by the proto field names, and the empty lines are skipped by the decoder.
+	if !strings.Contains(lines[1], `"parent_switch_count":0`) {
+		t.Fatalf("encoded line %s has no unpopulated parent_switch_count", lines[1])
+	}
+
+	decoder := NewDecoder(strings.NewReader(strings.Join(lines, "\n\n") + "\n"))
+	for i, want := range statistics {
+		got, err := decoder.Decode()
+		if err != nil {
+			t.Fatalf("Decode() of line %d error = %v", i, err)
+		}
+
+		if !proto.Equal(got, want) {
+			t.Fatalf("Decode() of line %d = %v, want %v", i, got, want)
+		}
+	}
+
+	if _, err := decoder.Decode(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Decode() at the end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "invalid json", data: "{\n"},
+		{name: "unknown field", data: `{"unknown":1}` + "\n"},
+		{name: "line too long", data: `{"host_id":"` + strings.Repeat("a", MaxLineSize) + `"}` + "\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewDecoder(strings.NewReader(tc.data)).Decode(); err == nil || errors.Is(err, io.EOF) {
+				t.Fatalf("Decode() error = %v, want decoding error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package downloadtoken signs and verifies common.v2.DownloadToken by manager.v2.DownloadTokenKey.
+//
+// The scheduler signs the token scoped to the task and the candidate parents by the newest key
+// of manager.v2.Manager.GetDownloadTokenSigningKey, and returns it in scheduler.v2.NormalTaskResponse
+// or SmallTaskResponse. The child peer sends the token to the parents in dfdaemon.v2.SyncPiecesRequest,
+// or in the Header of the http request to the download port, and the parents verify it by all
+// unexpired public keys of manager.v2.Manager.ListDownloadTokenKeys.
+//
+// The token is a short-lived bearer token, the parents can not authenticate the child peer id of
+// the claims, so anyone holding the token can download the pieces of the task from the parents
+// until it expires. The token must only be sent over the encrypted connection, and the ttl should
+// be as short as the download of the task.
+package downloadtoken
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+const (
+	// Header is the http header of the encoded token when downloading pieces from the download port.
+	Header = "X-Dragonfly-Download-Token"
+
+	// DefaultTTL is the default time to live of the token.
+	DefaultTTL = 10 * time.Minute
+
+	// maxClockSkew is the maximum clock skew between the scheduler and the parent.
+	maxClockSkew = time.Minute
+)
+
+var (
+	// ErrKeyNotFound is returned when the signing key of the token is not found or expired.
+	ErrKeyNotFound = errors.New("download token key not found")
+
+	// ErrInvalidSignature is returned when the signature of the token is invalid.
+	ErrInvalidSignature = errors.New("invalid download token signature")
+
+	// ErrExpired is returned when the token is expired or not yet valid.
+	ErrExpired = errors.New("download token is expired")
+
+	// ErrScopeMismatch is returned when the token is not scoped to the task or the parent.
+	ErrScopeMismatch = errors.New("download token scope mismatch")
+)
+
+// GenerateKey generates the Ed25519 key pair which is valid for the validity period.
+func GenerateKey(id string, validityPeriod time.Duration) (*managerv2.DownloadTokenSigningKey, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &managerv2.DownloadTokenSigningKey{
+		Key: &managerv2.DownloadTokenKey{
+			Id:        id,
+			PublicKey: publicKey,
+			CreatedAt: timestamppb.New(now),
+			ExpiredAt: timestamppb.New(now.Add(validityPeriod)),
+		},
+		PrivateKey: privateKey,
+	}, nil
+}
+
+// Signer signs the tokens by the private key, it is safe for concurrent use.
+type Signer struct {
+	// key is the signing key.
+	key *managerv2.DownloadTokenSigningKey
+
+	// ttl is the time to live of the token.
+	ttl time.Duration
+}
+
+// NewSigner returns the signer of the key pair.
+func NewSigner(key *managerv2.DownloadTokenSigningKey, ttl time.Duration) (*Signer, error) {
+	if err := key.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download token key: %w", err)
+	}
+
+	return &Signer{key: key, ttl: ttl}, nil
+}
+
+// Sign returns the token which allows the holder to download the pieces of the task from the parents,
+// the token is expired after the ttl or the expire time of the key, whichever comes first. The peer id
+// is the child peer id for auditing.
+func (s *Signer) Sign(taskID, peerID string, parentIDs []string) (*commonv2.DownloadToken, error) {
+	now := time.Now()
+	expiredAt := now.Add(s.ttl)
+	if keyExpiredAt := s.key.GetKey().GetExpiredAt().AsTime(); keyExpiredAt.Before(expiredAt) {
+		expiredAt = keyExpiredAt
+	}
+
+	claims := &commonv2.DownloadTokenClaims{
+		TaskId:    taskID,
+		PeerId:    peerID,
+		ParentIds: parentIDs,
+		IssuedAt:  timestamppb.New(now),
+		ExpiredAt: timestamppb.New(expiredAt),
+	}
+
+	if err := claims.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download token claims: %w", err)
+	}
+
+	data, err := proto.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commonv2.DownloadToken{
+		KeyId:     s.key.GetKey().GetId(),
+		Claims:    data,
+		Signature: ed25519.Sign(ed25519.PrivateKey(s.key.GetPrivateKey()), data),
+	}, nil
+}
+
+// Verifier verifies the tokens by the public keys, it is safe for concurrent use.
+type Verifier struct {
+	// mu protects keys.
+	mu sync.RWMutex
+
+	// keys is the verifying keys by key id.
+	keys map[string]*managerv2.DownloadTokenKey
+}
+
+// NewVerifier returns the verifier of the keys.
+func NewVerifier(keys []*managerv2.DownloadTokenKey) (*Verifier, error) {
+	v := &Verifier{}
+	if err := v.SetKeys(keys); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// SetKeys replaces the verifying keys, it is called when the keys are rotated by the manager.
+func (v *Verifier) SetKeys(keys []*managerv2.DownloadTokenKey) error {
+	m := make(map[string]*managerv2.DownloadTokenKey, len(keys))
+	for _, key := range keys {
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("invalid download token key: %w", err)
+		}
+
+		m[key.GetId()] = key
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = m
+	return nil
+}
+
+// Verify verifies the token is signed by the unexpired key, and allows the parent to serve the
+// pieces of the task, it returns the claims of the token.
+func (v *Verifier) Verify(token *commonv2.DownloadToken, taskID, parentID string) (*commonv2.DownloadTokenClaims, error) {
+	if err := token.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download token: %w", err)
+	}
+
+	now := time.Now()
+	v.mu.RLock()
+	key, ok := v.keys[token.GetKeyId()]
+	v.mu.RUnlock()
+	if !ok || now.After(key.GetExpiredAt().AsTime()) {
+		return nil, ErrKeyNotFound
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(key.GetPublicKey()), token.GetClaims(), token.GetSignature()) {
+		return nil, ErrInvalidSignature
+	}
+
+	claims := &commonv2.DownloadTokenClaims{}
+	if err := proto.Unmarshal(token.GetClaims(), claims); err != nil {
+		return nil, fmt.Errorf("invalid download token claims: %w", err)
+	}
+
+	if err := claims.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download token claims: %w", err)
+	}
+
+	if now.Add(maxClockSkew).Before(claims.GetIssuedAt().AsTime()) || now.After(claims.GetExpiredAt().AsTime()) {
+		return nil, ErrExpired
+	}
+
+	if claims.GetTaskId() != taskID {
+		return nil, ErrScopeMismatch
+	}
+
+	for _, id := range claims.GetParentIds() {
+		if id == parentID {
+			return claims, nil
+		}
+	}
+
+	return nil, ErrScopeMismatch
+}
+
+// Encode encodes the token to the string of the http header.
+func Encode(token *commonv2.DownloadToken) (string, error) {
+	data, err := proto.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode decodes the token from the string of the http header.
+func Decode(s string) (*commonv2.DownloadToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download token encoding: %w", err)
+	}
+
+	token := &commonv2.DownloadToken{}
+	if err := proto.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("invalid download token encoding: %w", err)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,288 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package downloadtoken
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// generateKey generates the key pair which is valid for an hour.
+func generateKey(t *testing.T, id string) *managerv2.DownloadTokenSigningKey {
+	t.Helper()
+	key, err := GenerateKey(id, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	return key
+}
+
+// signClaims signs the claims by the key pair, it is used to sign the claims with the
+// specified issue and expire time.
+func signClaims(t *testing.T, key *managerv2.DownloadTokenSigningKey, claims *commonv2.DownloadTokenClaims) *commonv2.DownloadToken {
+	t.Helper()
+	data, err := proto.Marshal(claims)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	return &commonv2.DownloadToken{
+		KeyId:     key.GetKey().GetId(),
+		Claims:    data,
+		Signature: ed25519.Sign(ed25519.PrivateKey(key.GetPrivateKey()), data),
+	}
+}
+
+// claimsAt returns the claims of task foo, peer bar and parent baz which is issued at the time.
+func claimsAt(issuedAt, expiredAt time.Time) *commonv2.DownloadTokenClaims {
+	return &commonv2.DownloadTokenClaims{
+		TaskId:    "foo",
+		PeerId:    "bar",
+		ParentIds: []string{"baz"},
+		IssuedAt:  timestamppb.New(issuedAt),
+		ExpiredAt: timestamppb.New(expiredAt),
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	key := generateKey(t, "key")
+	signer, err := NewSigner(key, DefaultTTL)
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+
+	// The verifier has the public keys only.
+	verifier, err := NewVerifier([]*managerv2.DownloadTokenKey{key.GetKey()})
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	token, err := signer.Sign("foo", "bar", []string{"baz", "qux"})
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	for _, parentID := range []string{"baz", "qux"} {
+		claims, err := verifier.Verify(token, "foo", parentID)
+		if err != nil {
+			t.Fatalf("Verify() of parent %s error = %v", parentID, err)
+		}
+
+		if claims.GetPeerId() != "bar" {
+			t.Fatalf("Verify() peer id = %s, want bar", claims.GetPeerId())
+		}
+
+		if ttl := claims.GetExpiredAt().AsTime().Sub(claims.GetIssuedAt().AsTime()); ttl != DefaultTTL {
+			t.Fatalf("Verify() ttl = %v, want %v", ttl, DefaultTTL)
+		}
+	}
+}
+
+func TestSignExpiredByKey(t *testing.T) {
+	key := generateKey(t, "key")
+	key.Key.ExpiredAt = timestamppb.New(time.Now().Add(time.Minute))
+	signer, err := NewSigner(key, time.Hour)
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+
+	token, err := signer.Sign("foo", "bar", []string{"baz"})
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	claims := &commonv2.DownloadTokenClaims{}
+	if err := proto.Unmarshal(token.GetClaims(), claims); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if !claims.GetExpiredAt().AsTime().Equal(key.GetKey().GetExpiredAt().AsTime()) {
+		t.Fatalf("token expire time = %v, want the expire time of the key %v", claims.GetExpiredAt().AsTime(), key.GetKey().GetExpiredAt().AsTime())
+	}
+}
+
+func TestNewSignerWithoutPrivateKey(t *testing.T) {
+	key := generateKey(t, "key")
+	key.PrivateKey = nil
+	if _, err := NewSigner(key, DefaultTTL); err == nil {
+		t.Fatal("NewSigner() without private key error = nil, want error")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	key, other := generateKey(t, "key"), generateKey(t, "other")
+	expiredKey := generateKey(t, "expired")
+	expiredKey.Key.ExpiredAt = timestamppb.New(time.Now().Add(-time.Second))
+
+	verifier, err := NewVerifier([]*managerv2.DownloadTokenKey{key.GetKey(), expiredKey.GetKey()})
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	now := time.Now()
+	valid := claimsAt(now, now.Add(time.Minute))
+	tests := []struct {
+		name     string
+		token    *commonv2.DownloadToken
+		taskID   string
+		parentID string
+		err      error
+	}{
+		{
+			name:     "valid",
+			token:    signClaims(t, key, valid),
+			taskID:   "foo",
+			parentID: "baz",
+		},
+		{
+			name:     "issued within clock skew",
+			token:    signClaims(t, key, claimsAt(now.Add(maxClockSkew/2), now.Add(time.Hour))),
+			taskID:   "foo",
+			parentID: "baz",
+		},
+		{
+			name:     "issued beyond clock skew",
+			token:    signClaims(t, key, claimsAt(now.Add(2*maxClockSkew), now.Add(time.Hour))),
+			taskID:   "foo",
+			parentID: "baz",
+			err:      ErrExpired,
+		},
+		{
+			name:     "expired",
+			token:    signClaims(t, key, claimsAt(now.Add(-time.Hour), now.Add(-time.Second))),
+			taskID:   "foo",
+			parentID: "baz",
+			err:      ErrExpired,
+		},
+		{
+			name:     "task mismatch",
+			token:    signClaims(t, key, valid),
+			taskID:   "other",
+			parentID: "baz",
+			err:      ErrScopeMismatch,
+		},
+		{
+			name:     "parent mismatch",
+			token:    signClaims(t, key, valid),
+			taskID:   "foo",
+			parentID: "other",
+			err:      ErrScopeMismatch,
+		},
+		{
+			name:     "unknown key",
+			token:    signClaims(t, other, valid),
+			taskID:   "foo",
+			parentID: "baz",
+			err:      ErrKeyNotFound,
+		},
+		{
+			name:     "expired key",
+			token:    signClaims(t, expiredKey, valid),
+			taskID:   "foo",
+			parentID: "baz",
+			err:      ErrKeyNotFound,
+		},
+		{
+			name: "signed by the other key with the same id",
+			token: func() *commonv2.DownloadToken {
+				token := signClaims(t, other, valid)
+				token.KeyId = "key"
+				return token
+			}(),
+			taskID:   "foo",
+			parentID: "baz",
+			err:      ErrInvalidSignature,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := verifier.Verify(tc.token, tc.taskID, tc.parentID)
+			if tc.err == nil {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Verify() error = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestVerifierSetKeys(t *testing.T) {
+	key := generateKey(t, "key")
+	token := signClaims(t, key, claimsAt(time.Now(), time.Now().Add(time.Minute)))
+
+	verifier, err := NewVerifier(nil)
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	if _, err := verifier.Verify(token, "foo", "baz"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("Verify() before rotation error = %v, want %v", err, ErrKeyNotFound)
+	}
+
+	if err := verifier.SetKeys([]*managerv2.DownloadTokenKey{key.GetKey()}); err != nil {
+		t.Fatalf("SetKeys() error = %v", err)
+	}
+
+	if _, err := verifier.Verify(token, "foo", "baz"); err != nil {
+		t.Fatalf("Verify() after rotation error = %v", err)
+	}
+
+	if err := verifier.SetKeys([]*managerv2.DownloadTokenKey{{Id: "invalid"}}); err == nil {
+		t.Fatal("SetKeys() of invalid key error = nil, want error")
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	key := generateKey(t, "key")
+	token := signClaims(t, key, claimsAt(time.Now(), time.Now().Add(time.Minute)))
+
+	s, err := Encode(token)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	decoded, err := Decode(s)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if !proto.Equal(decoded, token) {
+		t.Fatalf("Decode() = %v, want %v", decoded, token)
+	}
+
+	for _, s := range []string{"not base64!", "_w"} {
+		if _, err := Decode(s); err == nil {
+			t.Fatalf("Decode(%q) error = nil, want error", s)
+		}
+	}
+}
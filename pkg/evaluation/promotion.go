@@ -0,0 +1,169 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluation
+
+import (
+	"sync"
+
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// Decide decides the state of the shadow or canary version by the promotion policy and the
+// accumulated statistics of the version and the baseline, it returns false if the policy is
+// empty, the version is not shadow or canary, or the version or the baseline has not enough
+// selections.
+//
+// The shadow version is promoted to CANARY_MODEL if the agreement rate is not less than
+// min_agreement_rate. The canary version is promoted to ACTIVE_MODEL if the success rate delta
+// is not less than min_success_rate_delta, the piece cost ratio is not greater than
+// max_piece_cost_ratio and the back-to-source ratio delta is not greater than
+// max_back_to_source_ratio_delta. The piece cost ratio is skipped if the version or the
+// baseline has no piece. Otherwise the version is demoted to INACTIVE_MODEL.
+func Decide(policy *managerv2.ModelPromotionPolicy, state managerv2.ModelState, stats, baseline *managerv2.OnlineEvaluationStats) (managerv2.ModelState, bool) {
+	if policy == nil || stats.GetSelectionCount() < policy.GetMinSelectionCount() || baseline.GetSelectionCount() < policy.GetMinSelectionCount() {
+		return state, false
+	}
+
+	switch state {
+	case managerv2.ModelState_SHADOW_MODEL:
+		if AgreementRate(stats) >= policy.GetMinAgreementRate() {
+			return managerv2.ModelState_CANARY_MODEL, true
+		}
+	case managerv2.ModelState_CANARY_MODEL:
+		costRatio, ok := pieceCostRatio(stats, baseline)
+		if SuccessRate(stats)-SuccessRate(baseline) >= policy.GetMinSuccessRateDelta() &&
+			(!ok || costRatio <= policy.GetMaxPieceCostRatio()) &&
+			BackToSourceRatio(stats)-BackToSourceRatio(baseline) <= policy.GetMaxBackToSourceRatioDelta() {
+			return managerv2.ModelState_ACTIVE_MODEL, true
+		}
+	default:
+		return state, false
+	}
+
+	return managerv2.ModelState_INACTIVE_MODEL, true
+}
+
+// pieceCostRatio returns the ratio of the mean piece cost of the version to the baseline,
+// it returns false if the version or the baseline has no piece or the baseline has no
+// piece cost, because the mean piece costs can not be compared.
+func pieceCostRatio(stats, baseline *managerv2.OnlineEvaluationStats) (float64, bool) {
+	if stats.GetPieceCount() == 0 || baseline.GetPieceCount() == 0 {
+		return 0, false
+	}
+
+	cost, baselineCost := stats.GetMeanPieceCost().AsDuration(), baseline.GetMeanPieceCost().AsDuration()
+	if baselineCost <= 0 {
+		return 0, false
+	}
+
+	return float64(cost) / float64(baselineCost), true
+}
+
+// key is the key of the model version.
+type key struct {
+	// name is the model name.
+	name string
+
+	// version is the model version.
+	version uint64
+}
+
+// accumulation is the accumulated evaluations of the model version in the state.
+type accumulation struct {
+	// state is the state of the model version.
+	state managerv2.ModelState
+
+	// stats is the accumulated statistics of the model version.
+	stats *managerv2.OnlineEvaluationStats
+
+	// baseline is the accumulated statistics of the baseline.
+	baseline *managerv2.OnlineEvaluationStats
+}
+
+// Accumulator accumulates the online evaluations of the model versions of a scheduler
+// cluster since the model versions enter the current states, it is safe for concurrent use.
+type Accumulator struct {
+	// mu protects accumulations.
+	mu sync.Mutex
+
+	// accumulations is the accumulated evaluations by model version.
+	accumulations map[key]*accumulation
+}
+
+// NewAccumulator returns a new Accumulator.
+func NewAccumulator() *Accumulator {
+	return &Accumulator{accumulations: make(map[key]*accumulation)}
+}
+
+// Add adds the online evaluation, the accumulated evaluations of the model version
+// are dropped if the state of the model version is changed.
+func (a *Accumulator) Add(evaluation *managerv2.OnlineEvaluation) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	k := key{name: evaluation.GetName(), version: evaluation.GetVersion()}
+	acc, ok := a.accumulations[k]
+	if !ok || acc.state != evaluation.GetState() {
+		acc = &accumulation{state: evaluation.GetState()}
+		a.accumulations[k] = acc
+	}
+
+	acc.stats = Merge(acc.stats, evaluation.GetStats())
+	acc.baseline = Merge(acc.baseline, evaluation.GetBaselineStats())
+}
+
+// Get returns the accumulated statistics of the model version and the baseline in the state.
+func (a *Accumulator) Get(name string, version uint64, state managerv2.ModelState) (*managerv2.OnlineEvaluationStats, *managerv2.OnlineEvaluationStats, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	acc, ok := a.accumulations[key{name: name, version: version}]
+	if !ok || acc.state != state {
+		return nil, nil, false
+	}
+
+	return acc.stats, acc.baseline, true
+}
+
+// Decide decides the state of the model version by the promotion policy of the model and the
+// accumulated evaluations, and the accumulated evaluations are dropped if the state is changed.
+// The caller sets the canary percentage of the model by the policy if it is promoted to CANARY_MODEL.
+func (a *Accumulator) Decide(model *managerv2.Model) (managerv2.ModelState, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	k := key{name: model.GetName(), version: model.GetVersion()}
+	acc, ok := a.accumulations[k]
+	if !ok || acc.state != model.GetState() {
+		return model.GetState(), false
+	}
+
+	state, decided := Decide(model.GetPromotionPolicy(), acc.state, acc.stats, acc.baseline)
+	if decided {
+		delete(a.accumulations, k)
+	}
+
+	return state, decided
+}
+
+// Delete drops the accumulated evaluations of the model version.
+func (a *Accumulator) Delete(name string, version uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.accumulations, key{name: name, version: version})
+}
@@ -0,0 +1,290 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluation
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// testPolicy returns the promotion policy of the tests.
+func testPolicy() *managerv2.ModelPromotionPolicy {
+	return &managerv2.ModelPromotionPolicy{
+		MinSelectionCount:         10,
+		MinAgreementRate:          0.9,
+		MinSuccessRateDelta:       0,
+		MaxPieceCostRatio:         1.2,
+		MaxBackToSourceRatioDelta: 0.05,
+		CanaryPercentage:          10,
+	}
+}
+
+// testStats returns the statistics of the selections whose success rate is success / 100,
+// the mean piece cost is cost, and the back-to-source ratio is backToSource / 100.
+func testStats(success uint64, pieces uint64, cost time.Duration, backToSource uint64) *managerv2.OnlineEvaluationStats {
+	return &managerv2.OnlineEvaluationStats{
+		SelectionCount:    100,
+		SuccessCount:      success,
+		PieceCount:        pieces,
+		MeanPieceCost:     durationpb.New(cost),
+		PeerCount:         100,
+		BackToSourceCount: backToSource,
+	}
+}
+
+func TestDecide(t *testing.T) {
+	baseline := testStats(90, 100, 10*time.Millisecond, 5)
+	tests := []struct {
+		name      string
+		policy    *managerv2.ModelPromotionPolicy
+		state     managerv2.ModelState
+		stats     *managerv2.OnlineEvaluationStats
+		baseline  *managerv2.OnlineEvaluationStats
+		wantState managerv2.ModelState
+		decided   bool
+	}{
+		{
+			name:      "without policy",
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(100, 100, 10*time.Millisecond, 5),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_CANARY_MODEL,
+		},
+		{
+			name:      "not enough selections",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     &managerv2.OnlineEvaluationStats{SelectionCount: 9, SuccessCount: 9},
+			baseline:  baseline,
+			wantState: managerv2.ModelState_CANARY_MODEL,
+		},
+		{
+			name:      "not enough baseline selections",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(100, 100, 10*time.Millisecond, 5),
+			baseline:  &managerv2.OnlineEvaluationStats{SelectionCount: 9},
+			wantState: managerv2.ModelState_CANARY_MODEL,
+		},
+		{
+			name:      "active version",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_ACTIVE_MODEL,
+			stats:     testStats(100, 100, 10*time.Millisecond, 5),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_ACTIVE_MODEL,
+		},
+		{
+			name:      "shadow version agrees",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_SHADOW_MODEL,
+			stats:     &managerv2.OnlineEvaluationStats{SelectionCount: 100, AgreementCount: 90},
+			baseline:  baseline,
+			wantState: managerv2.ModelState_CANARY_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "shadow version disagrees",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_SHADOW_MODEL,
+			stats:     &managerv2.OnlineEvaluationStats{SelectionCount: 100, AgreementCount: 89},
+			baseline:  baseline,
+			wantState: managerv2.ModelState_INACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "canary version is better",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 100, 12*time.Millisecond, 10),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_ACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "canary version has lower success rate",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(89, 100, 10*time.Millisecond, 5),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_INACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "canary version has higher piece cost",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 100, 13*time.Millisecond, 5),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_INACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "canary version has higher back-to-source ratio",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 100, 10*time.Millisecond, 11),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_INACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "canary version without pieces skips piece cost",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 0, 0, 5),
+			baseline:  baseline,
+			wantState: managerv2.ModelState_ACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "baseline without pieces skips piece cost",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 100, time.Second, 5),
+			baseline:  testStats(90, 0, 0, 5),
+			wantState: managerv2.ModelState_ACTIVE_MODEL,
+			decided:   true,
+		},
+		{
+			name:      "baseline without piece cost skips piece cost",
+			policy:    testPolicy(),
+			state:     managerv2.ModelState_CANARY_MODEL,
+			stats:     testStats(95, 100, time.Second, 5),
+			baseline:  testStats(90, 100, 0, 5),
+			wantState: managerv2.ModelState_ACTIVE_MODEL,
+			decided:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state, decided := Decide(tc.policy, tc.state, tc.stats, tc.baseline)
+			if state != tc.wantState || decided != tc.decided {
+				t.Fatalf("Decide() = %s, %t, want %s, %t", state, decided, tc.wantState, tc.decided)
+			}
+		})
+	}
+}
+
+// testEvaluation returns the evaluation of the version of the model foo in the state.
+func testEvaluation(version uint64, state managerv2.ModelState, stats, baseline *managerv2.OnlineEvaluationStats) *managerv2.OnlineEvaluation {
+	return &managerv2.OnlineEvaluation{Name: "foo", Version: version, State: state, Stats: stats, BaselineStats: baseline}
+}
+
+func TestAccumulator(t *testing.T) {
+	a := NewAccumulator()
+	shadow := &managerv2.OnlineEvaluationStats{SelectionCount: 5, AgreementCount: 5}
+	a.Add(testEvaluation(1, managerv2.ModelState_SHADOW_MODEL, shadow, testStats(45, 50, 10*time.Millisecond, 5)))
+	a.Add(testEvaluation(1, managerv2.ModelState_SHADOW_MODEL, shadow, testStats(45, 50, 10*time.Millisecond, 5)))
+	a.Add(testEvaluation(2, managerv2.ModelState_SHADOW_MODEL, shadow, nil))
+
+	stats, baseline, ok := a.Get("foo", 1, managerv2.ModelState_SHADOW_MODEL)
+	if !ok {
+		t.Fatal("Get() ok = false, want true")
+	}
+
+	if want := (&managerv2.OnlineEvaluationStats{SelectionCount: 10, AgreementCount: 10}); !proto.Equal(stats, want) {
+		t.Fatalf("Get() stats = %v, want %v", stats, want)
+	}
+
+	if want := Merge(testStats(45, 50, 10*time.Millisecond, 5), testStats(45, 50, 10*time.Millisecond, 5)); !proto.Equal(baseline, want) {
+		t.Fatalf("Get() baseline = %v, want %v", baseline, want)
+	}
+
+	if _, _, ok := a.Get("foo", 1, managerv2.ModelState_CANARY_MODEL); ok {
+		t.Fatal("Get() of other state ok = true, want false")
+	}
+
+	// The accumulated evaluations are dropped if the state of the version is changed.
+	canary := testStats(95, 100, 10*time.Millisecond, 5)
+	a.Add(testEvaluation(1, managerv2.ModelState_CANARY_MODEL, canary, testStats(90, 100, 10*time.Millisecond, 5)))
+	if _, _, ok := a.Get("foo", 1, managerv2.ModelState_SHADOW_MODEL); ok {
+		t.Fatal("Get() of previous state ok = true, want false")
+	}
+
+	if stats, _, ok := a.Get("foo", 1, managerv2.ModelState_CANARY_MODEL); !ok || !proto.Equal(stats, Merge(nil, canary)) {
+		t.Fatalf("Get() after state change = %v, %t, want %v", stats, ok, canary)
+	}
+
+	// The other versions are kept.
+	if _, _, ok := a.Get("foo", 2, managerv2.ModelState_SHADOW_MODEL); !ok {
+		t.Fatal("Get() of other version ok = false, want true")
+	}
+
+	a.Delete("foo", 2)
+	if _, _, ok := a.Get("foo", 2, managerv2.ModelState_SHADOW_MODEL); ok {
+		t.Fatal("Get() after Delete() ok = true, want false")
+	}
+}
+
+func TestAccumulatorDecide(t *testing.T) {
+	a := NewAccumulator()
+	model := &managerv2.Model{Name: "foo", Version: 1, State: managerv2.ModelState_SHADOW_MODEL, PromotionPolicy: testPolicy()}
+	if state, decided := a.Decide(model); decided || state != managerv2.ModelState_SHADOW_MODEL {
+		t.Fatalf("Decide() without evaluations = %s, %t, want SHADOW_MODEL, false", state, decided)
+	}
+
+	// The evaluations are accumulated until there are enough selections.
+	shadow := &managerv2.OnlineEvaluationStats{SelectionCount: 6, AgreementCount: 6}
+	a.Add(testEvaluation(1, managerv2.ModelState_SHADOW_MODEL, shadow, testStats(90, 100, 10*time.Millisecond, 5)))
+	if state, decided := a.Decide(model); decided || state != managerv2.ModelState_SHADOW_MODEL {
+		t.Fatalf("Decide() without enough selections = %s, %t, want SHADOW_MODEL, false", state, decided)
+	}
+
+	// The accumulated evaluations of other states are not used.
+	canary := proto.Clone(model).(*managerv2.Model)
+	canary.State = managerv2.ModelState_CANARY_MODEL
+	if state, decided := a.Decide(canary); decided || state != managerv2.ModelState_CANARY_MODEL {
+		t.Fatalf("Decide() of other state = %s, %t, want CANARY_MODEL, false", state, decided)
+	}
+
+	a.Add(testEvaluation(1, managerv2.ModelState_SHADOW_MODEL, shadow, nil))
+	if state, decided := a.Decide(model); !decided || state != managerv2.ModelState_CANARY_MODEL {
+		t.Fatalf("Decide() = %s, %t, want CANARY_MODEL, true", state, decided)
+	}
+
+	// The accumulated evaluations are dropped after the decision.
+	if _, _, ok := a.Get("foo", 1, managerv2.ModelState_SHADOW_MODEL); ok {
+		t.Fatal("Get() after decision ok = true, want false")
+	}
+}
+
+func TestAccumulatorConcurrentAdd(t *testing.T) {
+	a := NewAccumulator()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				a.Add(testEvaluation(1, managerv2.ModelState_SHADOW_MODEL, &managerv2.OnlineEvaluationStats{SelectionCount: 1}, nil))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats, _, ok := a.Get("foo", 1, managerv2.ModelState_SHADOW_MODEL); !ok || stats.GetSelectionCount() != 1000 {
+		t.Fatalf("Get() = %v, %t, want 1000 selections", stats, ok)
+	}
+}
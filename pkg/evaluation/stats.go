@@ -0,0 +1,74 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package evaluation accumulates the online evaluations of the model versions reported by
+// manager.v2.Manager/ReportOnlineEvaluation, and decides the promotions of the shadow and
+// canary versions by manager.v2.ModelPromotionPolicy.
+package evaluation
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// Merge returns the statistics accumulated by the two statistics, the mean piece cost
+// is weighted by the piece count.
+func Merge(a, b *managerv2.OnlineEvaluationStats) *managerv2.OnlineEvaluationStats {
+	merged := &managerv2.OnlineEvaluationStats{
+		SelectionCount:    a.GetSelectionCount() + b.GetSelectionCount(),
+		SuccessCount:      a.GetSuccessCount() + b.GetSuccessCount(),
+		AgreementCount:    a.GetAgreementCount() + b.GetAgreementCount(),
+		PieceCount:        a.GetPieceCount() + b.GetPieceCount(),
+		PeerCount:         a.GetPeerCount() + b.GetPeerCount(),
+		BackToSourceCount: a.GetBackToSourceCount() + b.GetBackToSourceCount(),
+	}
+
+	if merged.PieceCount > 0 {
+		total := float64(a.GetMeanPieceCost().AsDuration())*float64(a.GetPieceCount()) +
+			float64(b.GetMeanPieceCost().AsDuration())*float64(b.GetPieceCount())
+		merged.MeanPieceCost = durationpb.New(time.Duration(total / float64(merged.PieceCount)))
+	}
+
+	return merged
+}
+
+// SuccessRate returns the success rate of the parent selections, it is zero if there is no selection.
+func SuccessRate(stats *managerv2.OnlineEvaluationStats) float64 {
+	return ratio(stats.GetSuccessCount(), stats.GetSelectionCount())
+}
+
+// AgreementRate returns the agreement rate of the parent selections with the baseline,
+// it is zero if there is no selection.
+func AgreementRate(stats *managerv2.OnlineEvaluationStats) float64 {
+	return ratio(stats.GetAgreementCount(), stats.GetSelectionCount())
+}
+
+// BackToSourceRatio returns the ratio of the peers downloading back-to-source, it is zero if there is no peer.
+func BackToSourceRatio(stats *managerv2.OnlineEvaluationStats) float64 {
+	return ratio(stats.GetBackToSourceCount(), stats.GetPeerCount())
+}
+
+// ratio returns n / total, it is zero if the total is zero.
+func ratio(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(total)
+}
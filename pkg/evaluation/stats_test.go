@@ -0,0 +1,98 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evaluation
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *managerv2.OnlineEvaluationStats
+		b    *managerv2.OnlineEvaluationStats
+		want *managerv2.OnlineEvaluationStats
+	}{
+		{
+			name: "empty",
+			want: &managerv2.OnlineEvaluationStats{},
+		},
+		{
+			name: "one side",
+			a:    &managerv2.OnlineEvaluationStats{SelectionCount: 2, PieceCount: 4, MeanPieceCost: durationpb.New(10 * time.Millisecond)},
+			want: &managerv2.OnlineEvaluationStats{SelectionCount: 2, PieceCount: 4, MeanPieceCost: durationpb.New(10 * time.Millisecond)},
+		},
+		{
+			name: "counts are summed and mean piece cost is weighted by piece count",
+			a: &managerv2.OnlineEvaluationStats{
+				SelectionCount: 1, SuccessCount: 2, AgreementCount: 3, PieceCount: 1,
+				MeanPieceCost: durationpb.New(10 * time.Millisecond), PeerCount: 4, BackToSourceCount: 5,
+			},
+			b: &managerv2.OnlineEvaluationStats{
+				SelectionCount: 10, SuccessCount: 20, AgreementCount: 30, PieceCount: 3,
+				MeanPieceCost: durationpb.New(30 * time.Millisecond), PeerCount: 40, BackToSourceCount: 50,
+			},
+			want: &managerv2.OnlineEvaluationStats{
+				SelectionCount: 11, SuccessCount: 22, AgreementCount: 33, PieceCount: 4,
+				MeanPieceCost: durationpb.New(25 * time.Millisecond), PeerCount: 44, BackToSourceCount: 55,
+			},
+		},
+		{
+			name: "mean piece cost without pieces is dropped",
+			a:    &managerv2.OnlineEvaluationStats{SelectionCount: 1, MeanPieceCost: durationpb.New(10 * time.Millisecond)},
+			want: &managerv2.OnlineEvaluationStats{SelectionCount: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Merge(tc.a, tc.b); !proto.Equal(got, tc.want) {
+				t.Fatalf("Merge() = %v, want %v", got, tc.want)
+			}
+
+			if got := Merge(tc.b, tc.a); !proto.Equal(got, tc.want) {
+				t.Fatalf("Merge() swapped = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRates(t *testing.T) {
+	stats := &managerv2.OnlineEvaluationStats{SelectionCount: 4, SuccessCount: 3, AgreementCount: 1, PeerCount: 10, BackToSourceCount: 2}
+	if rate := SuccessRate(stats); rate != 0.75 {
+		t.Fatalf("SuccessRate() = %v, want 0.75", rate)
+	}
+
+	if rate := AgreementRate(stats); rate != 0.25 {
+		t.Fatalf("AgreementRate() = %v, want 0.25", rate)
+	}
+
+	if rate := BackToSourceRatio(stats); rate != 0.2 {
+		t.Fatalf("BackToSourceRatio() = %v, want 0.2", rate)
+	}
+
+	// The rates of the empty statistics are zero.
+	if SuccessRate(nil) != 0 || AgreementRate(nil) != 0 || BackToSourceRatio(&managerv2.OnlineEvaluationStats{}) != 0 {
+		t.Fatal("rates of empty statistics are not zero")
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fieldmask prunes messages according to google.protobuf.FieldMask,
+// it is used by StatPeer and StatTask to reduce the size of common.v2 messages.
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// node is the tree of field mask paths, empty node represents the whole field is kept.
+type node map[protoreflect.Name]node
+
+// Prune clears the fields of message in place which are not included in the field mask.
+// Nil or empty field mask represents all fields, and the message is not changed.
+// For example, the paths ["id", "task.id", "host"] of common.v2.Peer keeps
+// the peer id, the task id and the whole host, the other fields are cleared.
+func Prune(m proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+
+	if !mask.IsValid(m) {
+		return fmt.Errorf("invalid field mask %v of %s", mask.GetPaths(), m.ProtoReflect().Descriptor().FullName())
+	}
+
+	// Normalize removes the sub paths which are covered by the shorter paths,
+	// so that the leaf node of the tree represents the whole field is kept.
+	normalized := &fieldmaskpb.FieldMask{Paths: append([]string(nil), mask.GetPaths()...)}
+	normalized.Normalize()
+
+	prune(m.ProtoReflect(), newNode(normalized.GetPaths()))
+	return nil
+}
+
+// newNode builds the tree of field mask paths.
+func newNode(paths []string) node {
+	root := node{}
+	for _, path := range paths {
+		n := root
+		for _, name := range strings.Split(path, ".") {
+			child, ok := n[protoreflect.Name(name)]
+			if !ok {
+				child = node{}
+				n[protoreflect.Name(name)] = child
+			}
+
+			n = child
+		}
+	}
+
+	return root
+}
+
+// prune clears the fields of message which are not included in the node.
+func prune(m protoreflect.Message, n node) {
+	var fds []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+
+	for _, fd := range fds {
+		child, ok := n[fd.Name()]
+		if !ok {
+			m.Clear(fd)
+			continue
+		}
+
+		if len(child) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			prune(m.Mutable(fd).Message(), child)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fieldmask
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+// newPeer returns the peer with the nested task, host and pieces.
+func newPeer() *commonv2.Peer {
+	location, idc := "a|b|c", "idc"
+	return &commonv2.Peer{
+		Id:       "peer",
+		Priority: commonv2.Priority_LEVEL3,
+		Pieces: []*commonv2.Piece{
+			{Number: 0, ParentId: "parent", Length: 100},
+			{Number: 1, ParentId: "parent", Length: 100},
+		},
+		Task: &commonv2.Task{
+			Id:     "task",
+			Url:    "https://example.com/foo",
+			Header: map[string]string{"Range": "bytes=0-99"},
+		},
+		Host: &commonv2.Host{
+			Id:       "host",
+			Hostname: "hostname",
+			Network:  &commonv2.Network{Location: &location, Idc: &idc, TcpConnectionCount: 10},
+		},
+		FinishedPieceCount: 2,
+	}
+}
+
+func TestPrune(t *testing.T) {
+	location := "a|b|c"
+	tests := []struct {
+		name  string
+		paths []string
+		want  *commonv2.Peer
+	}{
+		{
+			name: "empty mask",
+			want: newPeer(),
+		},
+		{
+			name:  "top-level fields",
+			paths: []string{"id", "finished_piece_count"},
+			want:  &commonv2.Peer{Id: "peer", FinishedPieceCount: 2},
+		},
+		{
+			name:  "nested paths",
+			paths: []string{"id", "task.id", "host.network.location"},
+			want: &commonv2.Peer{
+				Id:   "peer",
+				Task: &commonv2.Task{Id: "task"},
+				Host: &commonv2.Host{Network: &commonv2.Network{Location: &location}},
+			},
+		},
+		{
+			name:  "sub path covered by the whole field",
+			paths: []string{"host.id", "host", "task.url"},
+			want: &commonv2.Peer{
+				Task: &commonv2.Task{Url: "https://example.com/foo"},
+				Host: newPeer().GetHost(),
+			},
+		},
+		{
+			name:  "repeated field",
+			paths: []string{"pieces"},
+			want:  &commonv2.Peer{Pieces: newPeer().GetPieces()},
+		},
+		{
+			name:  "map field",
+			paths: []string{"task.header"},
+			want:  &commonv2.Peer{Task: &commonv2.Task{Header: map[string]string{"Range": "bytes=0-99"}}},
+		},
+		{
+			name:  "unset message field",
+			paths: []string{"id", "range.start"},
+			want:  &commonv2.Peer{Id: "peer"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			peer := newPeer()
+			if err := Prune(peer, &fieldmaskpb.FieldMask{Paths: tc.paths}); err != nil {
+				t.Fatalf("Prune() error = %v", err)
+			}
+
+			if !proto.Equal(peer, tc.want) {
+				t.Fatalf("Prune() = %v, want %v", peer, tc.want)
+			}
+		})
+	}
+}
+
+func TestPruneNilMask(t *testing.T) {
+	peer := newPeer()
+	if err := Prune(peer, nil); err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if !proto.Equal(peer, newPeer()) {
+		t.Fatalf("Prune() with nil mask changes the message: %v", peer)
+	}
+}
+
+func TestPruneInvalidPaths(t *testing.T) {
+	for _, paths := range [][]string{
+		{"unknown"},
+		{"id", "task.unknown"},
+		{"pieces.number"},
+		{"task.header.Range"},
+		{"id."},
+	} {
+		peer := newPeer()
+		if err := Prune(peer, &fieldmaskpb.FieldMask{Paths: paths}); err == nil {
+			t.Fatalf("Prune() of paths %v error = nil, want error", paths)
+		}
+
+		if !proto.Equal(peer, newPeer()) {
+			t.Fatalf("Prune() of invalid paths %v changes the message: %v", paths, peer)
+		}
+	}
+}
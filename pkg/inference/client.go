@@ -0,0 +1,405 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package inference provides the typed client of inference.v1.GRPCInferenceService
+// for scoring the candidate parents and predicting the bandwidth between hosts.
+//
+// The inputs of the model are named by ChildHostInput, ParentHostInput, ParentPeerInput
+// and PairInput, and every input is the flattened features of HostFeatures, PeerFeatures
+// or PairFeatures. The data types and the shapes of the inputs are resolved by
+// ModelMetadata and ModelConfig, and the requests are split into batches of the
+// max_batch_size of the model.
+package inference
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+const (
+	// DefaultParentModelName is the default name of the model scoring the parents.
+	DefaultParentModelName = "parent_selection"
+
+	// DefaultBandwidthModelName is the default name of the model predicting the bandwidth.
+	DefaultBandwidthModelName = "bandwidth_prediction"
+)
+
+// modelTensor is the resolved input or output tensor of the model.
+type modelTensor struct {
+	// name is the tensor name.
+	name string
+
+	// datatype is the tensor data type, for example FP32.
+	datatype string
+
+	// dims is the shape of the tensor without the batch dimension.
+	dims []int64
+
+	// width is the element count of the tensor without the batch dimension.
+	width int
+
+	// batchDim indicates whether the first dimension of the shape is the batch dimension.
+	batchDim bool
+}
+
+// model is the resolved model of the inference server.
+type model struct {
+	// name is the model name.
+	name string
+
+	// version is the model version, empty version represents the latest version.
+	version string
+
+	// batched indicates whether the model supports batching by max_batch_size.
+	batched bool
+
+	// maxBatchSize is the maximum batch size of the request, zero represents unlimited.
+	maxBatchSize int
+
+	// inputs is the input tensors of the model.
+	inputs []modelTensor
+
+	// output is the requested output tensor of the model.
+	output modelTensor
+}
+
+// modelSpec is the configured model name and version.
+type modelSpec struct {
+	// name is the model name.
+	name string
+
+	// version is the model version, empty version represents the latest version.
+	version string
+}
+
+// Client is the typed client of inference.v1.GRPCInferenceService, it is safe for concurrent use.
+type Client struct {
+	// client is the client of inference.v1.GRPCInferenceService.
+	client inferencev1.GRPCInferenceServiceClient
+
+	// parentModel is the model scoring the parents.
+	parentModel modelSpec
+
+	// bandwidthModel is the model predicting the bandwidth.
+	bandwidthModel modelSpec
+
+	// mu protects models.
+	mu sync.Mutex
+
+	// models is the resolved models by model name.
+	models map[string]*model
+}
+
+// Option is a functional option for configuring the Client.
+type Option func(c *Client)
+
+// WithParentModel sets the name and the version of the model scoring the parents.
+func WithParentModel(name, version string) Option {
+	return func(c *Client) {
+		c.parentModel = modelSpec{name: name, version: version}
+	}
+}
+
+// WithBandwidthModel sets the name and the version of the model predicting the bandwidth.
+func WithBandwidthModel(name, version string) Option {
+	return func(c *Client) {
+		c.bandwidthModel = modelSpec{name: name, version: version}
+	}
+}
+
+// New returns a new Client.
+func New(client inferencev1.GRPCInferenceServiceClient, options ...Option) *Client {
+	c := &Client{
+		client:         client,
+		parentModel:    modelSpec{name: DefaultParentModelName},
+		bandwidthModel: modelSpec{name: DefaultBandwidthModelName},
+		models:         make(map[string]*model),
+	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c
+}
+
+// Reload drops the resolved models, and the models are resolved again by the next inference,
+// it is called when the active version of the model is changed.
+func (c *Client) Reload() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.models = make(map[string]*model)
+}
+
+// ScoreParents returns the scores of the candidate parents for the child host
+// in order of the candidates, the higher score represents the better parent.
+func (c *Client) ScoreParents(ctx context.Context, child *commonv2.Host, candidates []*commonv2.Peer) ([]float64, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	m, err := c.model(ctx, c.parentModel, ScoreOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string][][]float64, len(m.inputs))
+	for _, candidate := range candidates {
+		rows[ChildHostInput] = append(rows[ChildHostInput], hostFeatures(child))
+		rows[ParentHostInput] = append(rows[ParentHostInput], hostFeatures(candidate.GetHost()))
+		rows[ParentPeerInput] = append(rows[ParentPeerInput], peerFeatures(candidate))
+		rows[PairInput] = append(rows[PairInput], pairFeatures(child, candidate.GetHost()))
+	}
+
+	return c.infer(ctx, m, rows, len(candidates))
+}
+
+// PredictBandwidth returns the predicted bandwidth in bytes per second from the parent hosts
+// to the child host in order of the parents.
+func (c *Client) PredictBandwidth(ctx context.Context, child *commonv2.Host, parents []*commonv2.Host) ([]float64, error) {
+	if len(parents) == 0 {
+		return nil, nil
+	}
+
+	m, err := c.model(ctx, c.bandwidthModel, BandwidthOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string][][]float64, len(m.inputs))
+	for _, parent := range parents {
+		rows[ChildHostInput] = append(rows[ChildHostInput], hostFeatures(child))
+		rows[ParentHostInput] = append(rows[ParentHostInput], hostFeatures(parent))
+		rows[PairInput] = append(rows[PairInput], pairFeatures(child, parent))
+	}
+
+	return c.infer(ctx, m, rows, len(parents))
+}
+
+// model returns the resolved model, the model is resolved by ModelMetadata and ModelConfig once.
+func (c *Client) model(ctx context.Context, spec modelSpec, outputName string) (*model, error) {
+	c.mu.Lock()
+	m, ok := c.models[spec.name]
+	c.mu.Unlock()
+	if ok {
+		return m, nil
+	}
+
+	m, err := c.resolve(ctx, spec, outputName)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.models[spec.name] = m
+	c.mu.Unlock()
+	return m, nil
+}
+
+// resolve resolves the tensors and the batch size of the model.
+func (c *Client) resolve(ctx context.Context, spec modelSpec, outputName string) (*model, error) {
+	metadata, err := c.client.ModelMetadata(ctx, &inferencev1.ModelMetadataRequest{Name: spec.name, Version: spec.version})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := c.client.ModelConfig(ctx, &inferencev1.ModelConfigRequest{Name: spec.name, Version: spec.version})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &model{
+		name:    spec.name,
+		version: spec.version,
+		batched: config.GetConfig().GetMaxBatchSize() > 0,
+	}
+
+	for _, input := range metadata.GetInputs() {
+		t, err := m.resolveTensor(input)
+		if err != nil {
+			return nil, err
+		}
+
+		width, ok := featureWidths[input.GetName()]
+		if !ok || (input.GetName() == ParentPeerInput && outputName != ScoreOutput) {
+			return nil, fmt.Errorf("model %s: unsupported input %s", spec.name, input.GetName())
+		}
+
+		if t.width != width {
+			return nil, fmt.Errorf("model %s: input %s has %d elements, but %d features are required", spec.name, t.name, t.width, width)
+		}
+
+		if err := validateDatatype(t.datatype); err != nil {
+			return nil, fmt.Errorf("model %s: input %s: %w", spec.name, t.name, err)
+		}
+
+		m.inputs = append(m.inputs, t)
+	}
+
+	for _, output := range metadata.GetOutputs() {
+		if output.GetName() != outputName {
+			continue
+		}
+
+		t, err := m.resolveTensor(output)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.width != 1 {
+			return nil, fmt.Errorf("model %s: output %s has %d elements, but 1 is required", spec.name, t.name, t.width)
+		}
+
+		if err := validateDatatype(t.datatype); err != nil {
+			return nil, fmt.Errorf("model %s: output %s: %w", spec.name, t.name, err)
+		}
+
+		m.output = t
+
+		// The model without max_batch_size accepts one row per request
+		// if any tensor has no batch dimension, otherwise the batch size is unlimited.
+		m.maxBatchSize = int(config.GetConfig().GetMaxBatchSize())
+		if !m.batched && !m.hasBatchDims() {
+			m.maxBatchSize = 1
+		}
+
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("model %s: output %s not found", spec.name, outputName)
+}
+
+// resolveTensor resolves the tensor of the metadata, the model without the batch dimension
+// accepts a variable-size first dimension as the batch dimension, otherwise one row per request.
+func (m *model) resolveTensor(metadata *inferencev1.ModelMetadataResponse_TensorMetadata) (modelTensor, error) {
+	dims, batchDim := metadata.GetShape(), true
+	switch {
+	case m.batched:
+		if len(dims) == 0 || dims[0] != -1 {
+			return modelTensor{}, fmt.Errorf("model %s: tensor %s has no batch dimension", m.name, metadata.GetName())
+		}
+
+		dims = dims[1:]
+	case len(dims) > 0 && dims[0] == -1:
+		dims = dims[1:]
+	default:
+		batchDim = false
+	}
+
+	width := 1
+	for _, dim := range dims {
+		if dim <= 0 {
+			return modelTensor{}, fmt.Errorf("model %s: tensor %s has variable-size dimension", m.name, metadata.GetName())
+		}
+
+		width *= int(dim)
+	}
+
+	return modelTensor{
+		name:     metadata.GetName(),
+		datatype: metadata.GetDatatype(),
+		dims:     dims,
+		width:    width,
+		batchDim: batchDim,
+	}, nil
+}
+
+// hasBatchDims returns whether all the tensors of the model have the batch dimension.
+func (m *model) hasBatchDims() bool {
+	if !m.output.batchDim {
+		return false
+	}
+
+	for _, t := range m.inputs {
+		if !t.batchDim {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shape returns the shape of the tensor of the batch size.
+func (t modelTensor) shape(batchSize int) []int64 {
+	if !t.batchDim {
+		return t.dims
+	}
+
+	return append([]int64{int64(batchSize)}, t.dims...)
+}
+
+// featureWidths is the feature count by input name.
+var featureWidths = map[string]int{
+	ChildHostInput:  len(HostFeatures),
+	ParentHostInput: len(HostFeatures),
+	ParentPeerInput: len(PeerFeatures),
+	PairInput:       len(PairFeatures),
+}
+
+// infer infers the rows in batches and returns the outputs in order of the rows.
+func (c *Client) infer(ctx context.Context, m *model, rows map[string][][]float64, n int) ([]float64, error) {
+	batchSize := n
+	if m.maxBatchSize > 0 && m.maxBatchSize < batchSize {
+		batchSize = m.maxBatchSize
+	}
+
+	outputs := make([]float64, 0, n)
+	for start := 0; start < n; start += batchSize {
+		end := start + batchSize
+		if end > n {
+			end = n
+		}
+
+		req := &inferencev1.ModelInferRequest{
+			ModelName:    m.name,
+			ModelVersion: m.version,
+			Outputs:      []*inferencev1.ModelInferRequest_InferRequestedOutputTensor{{Name: m.output.name}},
+		}
+
+		for _, input := range m.inputs {
+			var values []float64
+			for _, row := range rows[input.name][start:end] {
+				values = append(values, row...)
+			}
+
+			if err := appendInput(req, input, input.shape(end-start), values); err != nil {
+				return nil, fmt.Errorf("model %s: %w", m.name, err)
+			}
+		}
+
+		resp, err := c.client.ModelInfer(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		values, err := output(resp, m.output)
+		if err != nil {
+			return nil, fmt.Errorf("model %s: %w", m.name, err)
+		}
+
+		if len(values) != end-start {
+			return nil, fmt.Errorf("model %s: output %s has %d elements, but %d are required", m.name, m.output.name, len(values), end-start)
+		}
+
+		outputs = append(outputs, values...)
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,340 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inference
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	"d7y.io/api/v2/pkg/tensor"
+)
+
+// bufSize is the buffer size of the bufconn listener.
+const bufSize = 1024 * 1024
+
+// fakeServer is the in-process inference server, the output of every row is the first feature
+// of the source input, so the outputs show which rows are sent in which order.
+type fakeServer struct {
+	inferencev1.UnimplementedGRPCInferenceServiceServer
+
+	// inputs is the input tensors of the model.
+	inputs []*inferencev1.ModelMetadataResponse_TensorMetadata
+
+	// output is the output tensor of the model.
+	output *inferencev1.ModelMetadataResponse_TensorMetadata
+
+	// maxBatchSize is the max_batch_size of the model config.
+	maxBatchSize int32
+
+	// source is the name of the input whose first feature is the output.
+	source string
+
+	// mu protects metadataCount and batchSizes.
+	mu sync.Mutex
+
+	// metadataCount is the count of the ModelMetadata calls.
+	metadataCount int
+
+	// batchSizes is the row count of every ModelInfer call.
+	batchSizes []int
+}
+
+// ModelMetadata returns the tensors of the model.
+func (s *fakeServer) ModelMetadata(ctx context.Context, req *inferencev1.ModelMetadataRequest) (*inferencev1.ModelMetadataResponse, error) {
+	s.mu.Lock()
+	s.metadataCount++
+	s.mu.Unlock()
+
+	return &inferencev1.ModelMetadataResponse{
+		Name:     req.GetName(),
+		Platform: "onnxruntime_onnx",
+		Inputs:   s.inputs,
+		Outputs:  []*inferencev1.ModelMetadataResponse_TensorMetadata{s.output},
+	}, nil
+}
+
+// ModelConfig returns the max_batch_size of the model.
+func (s *fakeServer) ModelConfig(ctx context.Context, req *inferencev1.ModelConfigRequest) (*inferencev1.ModelConfigResponse, error) {
+	return &inferencev1.ModelConfigResponse{Config: &inferencev1.ModelConfig{Name: req.GetName(), MaxBatchSize: s.maxBatchSize}}, nil
+}
+
+// ModelInfer returns the first feature of the source input of every row.
+func (s *fakeServer) ModelInfer(ctx context.Context, req *inferencev1.ModelInferRequest) (*inferencev1.ModelInferResponse, error) {
+	values, _, err := tensor.Input[float64](req, s.source)
+	if err != nil {
+		return nil, err
+	}
+
+	width := featureWidths[s.source]
+	outputs := make([]float64, 0, len(values)/width)
+	for i := 0; i < len(values); i += width {
+		outputs = append(outputs, values[i])
+	}
+
+	s.mu.Lock()
+	s.batchSizes = append(s.batchSizes, len(outputs))
+	s.mu.Unlock()
+
+	resp := &inferencev1.ModelInferResponse{ModelName: req.GetModelName()}
+	shape := []int64{int64(len(outputs)), 1}
+	if s.output.GetDatatype() == tensor.DatatypeFP32 {
+		err = tensor.AppendRawOutput(resp, s.output.GetName(), s.output.GetDatatype(), shape, convert[float64, float32](outputs))
+	} else {
+		err = tensor.AppendRawOutput(resp, s.output.GetName(), s.output.GetDatatype(), shape, outputs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// calls returns the count of the ModelMetadata calls and the row count of every ModelInfer call.
+func (s *fakeServer) calls() (int, []int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.metadataCount, append([]int(nil), s.batchSizes...)
+}
+
+// newTestClient serves the fake server by bufconn and returns the client of the server.
+func newTestClient(t *testing.T, s *fakeServer, options ...Option) *Client {
+	t.Helper()
+	server := grpc.NewServer()
+	inferencev1.RegisterGRPCInferenceServiceServer(server, s)
+
+	lis := bufconn.Listen(bufSize)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+	return New(inferencev1.NewGRPCInferenceServiceClient(conn), options...)
+}
+
+// newTensor returns the tensor metadata of the name, the data type and the shape.
+func newTensor(name, datatype string, shape ...int64) *inferencev1.ModelMetadataResponse_TensorMetadata {
+	return &inferencev1.ModelMetadataResponse_TensorMetadata{Name: name, Datatype: datatype, Shape: shape}
+}
+
+// scoreTensors returns the input tensors of the parent model with the first dimension.
+func scoreTensors(dim int64) []*inferencev1.ModelMetadataResponse_TensorMetadata {
+	return []*inferencev1.ModelMetadataResponse_TensorMetadata{
+		newTensor(ChildHostInput, tensor.DatatypeFP32, dim, int64(len(HostFeatures))),
+		newTensor(ParentHostInput, tensor.DatatypeFP32, dim, int64(len(HostFeatures))),
+		newTensor(ParentPeerInput, tensor.DatatypeFP64, dim, int64(len(PeerFeatures))),
+		newTensor(PairInput, tensor.DatatypeFP32, dim, int64(len(PairFeatures))),
+	}
+}
+
+// newCandidates returns the candidate parents with the finished piece counts, the pieces are
+// pruned by the field mask.
+func newCandidates(finishedPieceCounts ...uint32) []*commonv2.Peer {
+	candidates := make([]*commonv2.Peer, 0, len(finishedPieceCounts))
+	for _, count := range finishedPieceCounts {
+		candidates = append(candidates, &commonv2.Peer{FinishedPieceCount: count, Host: &commonv2.Host{Type: 1}})
+	}
+
+	return candidates
+}
+
+// equalValues returns whether the values are equal.
+func equalValues[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestClientScoreParents(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputs       []*inferencev1.ModelMetadataResponse_TensorMetadata
+		maxBatchSize int32
+		batchSizes   []int
+	}{
+		{
+			name:         "max batch size",
+			inputs:       scoreTensors(-1),
+			maxBatchSize: 2,
+			batchSizes:   []int{2, 2, 1},
+		},
+		{
+			name:       "variable-size first dimension",
+			inputs:     scoreTensors(-1),
+			batchSizes: []int{5},
+		},
+		{
+			name: "without batch dimension",
+			inputs: []*inferencev1.ModelMetadataResponse_TensorMetadata{
+				newTensor(ChildHostInput, tensor.DatatypeFP32, -1, int64(len(HostFeatures))),
+				newTensor(ParentHostInput, tensor.DatatypeFP32, int64(len(HostFeatures))),
+				newTensor(ParentPeerInput, tensor.DatatypeFP64, int64(len(PeerFeatures))),
+				newTensor(PairInput, tensor.DatatypeFP32, -1, int64(len(PairFeatures))),
+			},
+			batchSizes: []int{1, 1, 1, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &fakeServer{
+				inputs:       tc.inputs,
+				output:       newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+				maxBatchSize: tc.maxBatchSize,
+				source:       ParentPeerInput,
+			}
+			c := newTestClient(t, s)
+
+			scores, err := c.ScoreParents(context.Background(), &commonv2.Host{Type: 0}, newCandidates(5, 4, 3, 2, 1))
+			if err != nil {
+				t.Fatalf("ScoreParents() error = %v", err)
+			}
+
+			if want := []float64{5, 4, 3, 2, 1}; !equalValues(scores, want) {
+				t.Fatalf("ScoreParents() = %v, want %v", scores, want)
+			}
+
+			if _, batchSizes := s.calls(); !equalValues(batchSizes, tc.batchSizes) {
+				t.Fatalf("batch sizes = %v, want %v", batchSizes, tc.batchSizes)
+			}
+
+			// The model is resolved once.
+			if _, err := c.ScoreParents(context.Background(), &commonv2.Host{}, newCandidates(1)); err != nil {
+				t.Fatalf("ScoreParents() error = %v", err)
+			}
+
+			if count, _ := s.calls(); count != 1 {
+				t.Fatalf("ModelMetadata is called %d times, want 1", count)
+			}
+
+			c.Reload()
+			if _, err := c.ScoreParents(context.Background(), &commonv2.Host{}, newCandidates(1)); err != nil {
+				t.Fatalf("ScoreParents() error = %v", err)
+			}
+
+			if count, _ := s.calls(); count != 2 {
+				t.Fatalf("ModelMetadata is called %d times after Reload, want 2", count)
+			}
+		})
+	}
+}
+
+func TestClientPredictBandwidth(t *testing.T) {
+	s := &fakeServer{
+		inputs: []*inferencev1.ModelMetadataResponse_TensorMetadata{
+			newTensor(ChildHostInput, tensor.DatatypeFP32, -1, int64(len(HostFeatures))),
+			newTensor(PairInput, tensor.DatatypeFP64, -1, int64(len(PairFeatures))),
+		},
+		output:       newTensor(BandwidthOutput, tensor.DatatypeFP64, -1, 1),
+		maxBatchSize: 8,
+		source:       PairInput,
+	}
+	c := newTestClient(t, s, WithBandwidthModel("bandwidth", "2"))
+
+	host := func(location string) *commonv2.Host {
+		return &commonv2.Host{Network: &commonv2.Network{Location: &location}}
+	}
+
+	bandwidths, err := c.PredictBandwidth(context.Background(), host("a|b|c|d"), []*commonv2.Host{host("a|b|c|d"), host("a|b|x|y"), host("x")})
+	if err != nil {
+		t.Fatalf("PredictBandwidth() error = %v", err)
+	}
+
+	if want := []float64{1, 0.5, 0}; !equalValues(bandwidths, want) {
+		t.Fatalf("PredictBandwidth() = %v, want %v", bandwidths, want)
+	}
+}
+
+func TestClientResolveError(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputs       []*inferencev1.ModelMetadataResponse_TensorMetadata
+		output       *inferencev1.ModelMetadataResponse_TensorMetadata
+		maxBatchSize int32
+	}{
+		{
+			name:   "output not found",
+			inputs: scoreTensors(-1),
+			output: newTensor(BandwidthOutput, tensor.DatatypeFP32, -1, 1),
+		},
+		{
+			name:   "unsupported input",
+			inputs: append(scoreTensors(-1), newTensor("task", tensor.DatatypeFP32, -1, 1)),
+			output: newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+		},
+		{
+			name:   "feature count mismatch",
+			inputs: []*inferencev1.ModelMetadataResponse_TensorMetadata{newTensor(PairInput, tensor.DatatypeFP32, -1, 3)},
+			output: newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+		},
+		{
+			name:   "unsupported data type",
+			inputs: []*inferencev1.ModelMetadataResponse_TensorMetadata{newTensor(PairInput, tensor.DatatypeBool, -1, 2)},
+			output: newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+		},
+		{
+			name:   "variable-size dimension",
+			inputs: []*inferencev1.ModelMetadataResponse_TensorMetadata{newTensor(PairInput, tensor.DatatypeFP32, -1, -1)},
+			output: newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+		},
+		{
+			name:         "batched model without batch dimension",
+			inputs:       []*inferencev1.ModelMetadataResponse_TensorMetadata{newTensor(PairInput, tensor.DatatypeFP32, 2)},
+			output:       newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 1),
+			maxBatchSize: 4,
+		},
+		{
+			name:   "multiple output elements",
+			inputs: scoreTensors(-1),
+			output: newTensor(ScoreOutput, tensor.DatatypeFP32, -1, 2),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, &fakeServer{inputs: tc.inputs, output: tc.output, maxBatchSize: tc.maxBatchSize, source: ParentPeerInput})
+			if _, err := c.ScoreParents(context.Background(), &commonv2.Host{}, newCandidates(1)); err == nil {
+				t.Fatal("ScoreParents() error = nil, want error")
+			}
+		})
+	}
+}
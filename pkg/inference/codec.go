@@ -0,0 +1,85 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inference
+
+import (
+	"fmt"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	"d7y.io/api/v2/pkg/tensor"
+)
+
+// number is the element types of the features.
+type number interface {
+	int32 | int64 | float32 | float64
+}
+
+// validateDatatype returns error if the data type is not supported.
+func validateDatatype(datatype string) error {
+	switch datatype {
+	case tensor.DatatypeFP16, tensor.DatatypeBF16, tensor.DatatypeFP32, tensor.DatatypeFP64, tensor.DatatypeInt32, tensor.DatatypeInt64:
+		return nil
+	default:
+		return fmt.Errorf("unsupported data type %s", datatype)
+	}
+}
+
+// appendInput appends the input tensor of the values converted to the data type to the request.
+func appendInput(req *inferencev1.ModelInferRequest, t modelTensor, shape []int64, values []float64) error {
+	switch t.datatype {
+	case tensor.DatatypeFP16, tensor.DatatypeBF16, tensor.DatatypeFP32:
+		return tensor.AppendRawInput(req, t.name, t.datatype, shape, convert[float64, float32](values))
+	case tensor.DatatypeFP64:
+		return tensor.AppendRawInput(req, t.name, t.datatype, shape, values)
+	case tensor.DatatypeInt32:
+		return tensor.AppendRawInput(req, t.name, t.datatype, shape, convert[float64, int32](values))
+	case tensor.DatatypeInt64:
+		return tensor.AppendRawInput(req, t.name, t.datatype, shape, convert[float64, int64](values))
+	default:
+		return fmt.Errorf("unsupported data type %s", t.datatype)
+	}
+}
+
+// output returns the values of the output tensor of the response converted to float64.
+func output(resp *inferencev1.ModelInferResponse, t modelTensor) ([]float64, error) {
+	switch t.datatype {
+	case tensor.DatatypeFP16, tensor.DatatypeBF16, tensor.DatatypeFP32:
+		values, _, err := tensor.Output[float32](resp, t.name)
+		return convert[float32, float64](values), err
+	case tensor.DatatypeFP64:
+		values, _, err := tensor.Output[float64](resp, t.name)
+		return values, err
+	case tensor.DatatypeInt32:
+		values, _, err := tensor.Output[int32](resp, t.name)
+		return convert[int32, float64](values), err
+	case tensor.DatatypeInt64:
+		values, _, err := tensor.Output[int64](resp, t.name)
+		return convert[int64, float64](values), err
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", t.datatype)
+	}
+}
+
+// convert converts the values to the element type.
+func convert[From, To number](values []From) []To {
+	converted := make([]To, len(values))
+	for i, v := range values {
+		converted[i] = To(v)
+	}
+
+	return converted
+}
@@ -0,0 +1,142 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inference
+
+import (
+	"strings"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+// Names of the input tensors, every input tensor of the model must be one of them.
+const (
+	// ChildHostInput is the input of the host features of the child.
+	ChildHostInput = "child_host"
+
+	// ParentHostInput is the input of the host features of the parent.
+	ParentHostInput = "parent_host"
+
+	// ParentPeerInput is the input of the peer features of the parent, it is not supported by PredictBandwidth.
+	ParentPeerInput = "parent_peer"
+
+	// PairInput is the input of the features between the child host and the parent host.
+	PairInput = "pair"
+)
+
+// Names of the output tensors.
+const (
+	// ScoreOutput is the output of the scores of the parents.
+	ScoreOutput = "score"
+
+	// BandwidthOutput is the output of the predicted bandwidth in bytes per second.
+	BandwidthOutput = "bandwidth"
+)
+
+// HostFeatures is the names of the host features in order of ChildHostInput and ParentHostInput.
+var HostFeatures = []string{
+	"type",
+	"cpu_logical_count",
+	"cpu_percent",
+	"memory_used_percent",
+	"memory_available",
+	"tcp_connection_count",
+	"upload_tcp_connection_count",
+	"disk_used_percent",
+	"disk_free",
+}
+
+// PeerFeatures is the names of the peer features in order of ParentPeerInput.
+var PeerFeatures = []string{
+	"finished_piece_count",
+	"piece_count",
+	"cost_seconds",
+	"need_back_to_source",
+}
+
+// PairFeatures is the names of the pair features in order of PairInput.
+var PairFeatures = []string{
+	"location_affinity",
+	"idc_affinity",
+}
+
+// hostFeatures returns the host features of the host.
+func hostFeatures(host *commonv2.Host) []float64 {
+	return []float64{
+		float64(host.GetType()),
+		float64(host.GetCpu().GetLogicalCount()),
+		host.GetCpu().GetPercent(),
+		host.GetMemory().GetUsedPercent(),
+		float64(host.GetMemory().GetAvailable()),
+		float64(host.GetNetwork().GetTcpConnectionCount()),
+		float64(host.GetNetwork().GetUploadTcpConnectionCount()),
+		host.GetDisk().GetUsedPercent(),
+		float64(host.GetDisk().GetFree()),
+	}
+}
+
+// peerFeatures returns the peer features of the peer.
+func peerFeatures(peer *commonv2.Peer) []float64 {
+	var needBackToSource float64
+	if peer.GetNeedBackToSource() {
+		needBackToSource = 1
+	}
+
+	return []float64{
+		float64(peer.GetFinishedPieceCount()),
+		float64(peer.GetTask().GetPieceCount()),
+		peer.GetCost().AsDuration().Seconds(),
+		needBackToSource,
+	}
+}
+
+// pairFeatures returns the features between the child host and the parent host.
+func pairFeatures(child, parent *commonv2.Host) []float64 {
+	return []float64{
+		locationAffinity(child.GetNetwork().GetLocation(), parent.GetNetwork().GetLocation()),
+		idcAffinity(child.GetNetwork().GetIdc(), parent.GetNetwork().GetIdc()),
+	}
+}
+
+// locationAffinity returns the ratio of the common prefix elements of the locations,
+// the location is separated by |, for example area|country|province|city.
+func locationAffinity(src, dest string) float64 {
+	if src == "" || dest == "" {
+		return 0
+	}
+
+	srcElements, destElements := strings.Split(src, "|"), strings.Split(dest, "|")
+	n := len(srcElements)
+	if len(destElements) > n {
+		n = len(destElements)
+	}
+
+	var common int
+	for common < len(srcElements) && common < len(destElements) && srcElements[common] == destElements[common] {
+		common++
+	}
+
+	return float64(common) / float64(n)
+}
+
+// idcAffinity returns 1 if the idcs are the same, otherwise 0.
+func idcAffinity(src, dest string) float64 {
+	if src != "" && src == dest {
+		return 1
+	}
+
+	return 0
+}
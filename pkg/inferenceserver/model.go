@@ -0,0 +1,198 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inferenceserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	"d7y.io/api/v2/pkg/tensor"
+)
+
+// Tensor is the input or output tensor of the model, the data is the raw contents
+// which can be converted by d7y.io/api/v2/pkg/tensor.
+type Tensor struct {
+	// Datatype is the tensor data type, for example FP32.
+	Datatype string
+
+	// Shape is the tensor shape including the batch dimension.
+	Shape []int64
+
+	// Data is the raw contents of the tensor.
+	Data []byte
+}
+
+// InferFunc infers the output tensors by name from the input tensors by name, the inputs
+// are validated against the model config, and the outputs which are not requested are dropped.
+type InferFunc func(ctx context.Context, inputs map[string]*Tensor) (map[string]*Tensor, error)
+
+// Model is the Go model served by the server.
+type Model struct {
+	// Config is the model config, the name, the inputs, the outputs, the max batch size
+	// and the version policy are used by the server.
+	Config *inferencev1.ModelConfig
+
+	// Infer infers the outputs of the model.
+	Infer InferFunc
+}
+
+// validate validates the model config.
+func (m *Model) validate() error {
+	if m.Config.GetName() == "" {
+		return fmt.Errorf("model name is empty")
+	}
+
+	if m.Infer == nil {
+		return fmt.Errorf("model %s has no infer func", m.Config.GetName())
+	}
+
+	for _, input := range m.Config.GetInput() {
+		if _, err := tensor.Datatype(input.GetDataType()); err != nil {
+			return fmt.Errorf("model %s: input %s: %w", m.Config.GetName(), input.GetName(), err)
+		}
+	}
+
+	for _, output := range m.Config.GetOutput() {
+		if _, err := tensor.Datatype(output.GetDataType()); err != nil {
+			return fmt.Errorf("model %s: output %s: %w", m.Config.GetName(), output.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// policyVersions returns the versions which are served by the version policy of the config
+// in descending order, the default policy serves the latest version.
+func policyVersions(config *inferencev1.ModelConfig, versions []int64) []int64 {
+	sorted := append([]int64{}, versions...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	policy := config.GetVersionPolicy()
+	switch {
+	case policy.GetAll() != nil:
+		return sorted
+	case policy.GetSpecific() != nil:
+		specific := make(map[int64]struct{}, len(policy.GetSpecific().GetVersions()))
+		for _, version := range policy.GetSpecific().GetVersions() {
+			specific[version] = struct{}{}
+		}
+
+		var served []int64
+		for _, version := range sorted {
+			if _, ok := specific[version]; ok {
+				served = append(served, version)
+			}
+		}
+
+		return served
+	default:
+		n := 1
+		if policy.GetLatest().GetNumVersions() > 0 {
+			n = int(policy.GetLatest().GetNumVersions())
+		}
+
+		if n > len(sorted) {
+			n = len(sorted)
+		}
+
+		return sorted[:n]
+	}
+}
+
+// rawContents returns the raw contents of the input tensor of the typed contents.
+func rawContents(input *inferencev1.ModelInferRequest_InferInputTensor) ([]byte, error) {
+	datatype, contents := input.GetDatatype(), input.GetContents()
+	switch datatype {
+	case tensor.DatatypeBool:
+		return convertContents[bool](datatype, contents)
+	case tensor.DatatypeUint8:
+		return convertContents[uint8](datatype, contents)
+	case tensor.DatatypeUint16:
+		return convertContents[uint16](datatype, contents)
+	case tensor.DatatypeUint32:
+		return convertContents[uint32](datatype, contents)
+	case tensor.DatatypeUint64:
+		return convertContents[uint64](datatype, contents)
+	case tensor.DatatypeInt8:
+		return convertContents[int8](datatype, contents)
+	case tensor.DatatypeInt16:
+		return convertContents[int16](datatype, contents)
+	case tensor.DatatypeInt32:
+		return convertContents[int32](datatype, contents)
+	case tensor.DatatypeInt64:
+		return convertContents[int64](datatype, contents)
+	case tensor.DatatypeFP32:
+		return convertContents[float32](datatype, contents)
+	case tensor.DatatypeFP64:
+		return convertContents[float64](datatype, contents)
+	case tensor.DatatypeBytes:
+		return convertContents[[]byte](datatype, contents)
+	default:
+		return nil, fmt.Errorf("data type %s must be represented as raw contents", datatype)
+	}
+}
+
+// convertContents converts the typed contents to the raw contents.
+func convertContents[T tensor.Element](datatype string, contents *inferencev1.InferTensorContents) ([]byte, error) {
+	values, err := tensor.FromContents[T](datatype, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return tensor.EncodeRaw(datatype, values)
+}
+
+// validateInput validates the input tensor against the input config, and returns the batch size.
+func validateInput(config *inferencev1.ModelConfig, input *inferencev1.ModelInput, t *Tensor) (int64, error) {
+	datatype, _ := tensor.Datatype(input.GetDataType())
+	if t.Datatype != datatype {
+		return 0, fmt.Errorf("input %s: data type %s does not match %s", input.GetName(), t.Datatype, datatype)
+	}
+
+	if err := tensor.ValidateShape(input, config.GetMaxBatchSize(), t.Shape); err != nil {
+		return 0, err
+	}
+
+	count, err := tensor.ElementCount(t.Shape)
+	if err != nil {
+		return 0, err
+	}
+
+	if datatype == tensor.DatatypeBytes {
+		values, err := tensor.DecodeRaw[[]byte](datatype, t.Data)
+		if err != nil {
+			return 0, fmt.Errorf("input %s: %w", input.GetName(), err)
+		}
+
+		if int64(len(values)) != count {
+			return 0, fmt.Errorf("input %s: shape %v requires %d elements, but got %d", input.GetName(), t.Shape, count, len(values))
+		}
+	} else {
+		size, _ := tensor.ElementSize(datatype)
+		if int64(len(t.Data)) != count*int64(size) {
+			return 0, fmt.Errorf("input %s: shape %v requires %d bytes, but got %d", input.GetName(), t.Shape, count*int64(size), len(t.Data))
+		}
+	}
+
+	if config.GetMaxBatchSize() > 0 {
+		return t.Shape[0], nil
+	}
+
+	return 1, nil
+}
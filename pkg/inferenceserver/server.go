@@ -0,0 +1,628 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package inferenceserver implements an in-memory inference.v1.GRPCInferenceService server
+// which serves a repository of Go models, it is used to test the clients of the inference
+// service without a real inference server.
+package inferenceserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	"d7y.io/api/v2/pkg/tensor"
+)
+
+const (
+	// DefaultName is the default name of the server.
+	DefaultName = "dragonfly-inference"
+
+	// DefaultVersion is the default version of the server.
+	DefaultVersion = "v1"
+)
+
+const (
+	// stateReady is the repository index state of the ready model version.
+	stateReady = "READY"
+
+	// stateUnavailable is the repository index state of the unavailable model version.
+	stateUnavailable = "UNAVAILABLE"
+)
+
+// extensions is the protocol extensions supported by the server.
+var extensions = []string{"model_repository", "model_configuration", "statistics"}
+
+// Server is the in-memory inference server, it is safe for concurrent use.
+type Server struct {
+	inferencev1.UnimplementedGRPCInferenceServiceServer
+
+	// name is the name of the server.
+	name string
+
+	// version is the version of the server.
+	version string
+
+	// mu is the lock of the repository.
+	mu sync.RWMutex
+
+	// repository is the models by name.
+	repository map[string]*repositoryModel
+}
+
+// repositoryModel is the model in the repository.
+type repositoryModel struct {
+	// versions is the model versions by version.
+	versions map[int64]*modelVersion
+
+	// loaded is whether the model is loaded.
+	loaded bool
+}
+
+// modelVersion is the version of the model.
+type modelVersion struct {
+	// model is the Go model.
+	model *Model
+
+	// statistics is the inference statistics of the version.
+	statistics *statistics
+}
+
+// Option is a functional option for configuring the server.
+type Option func(s *Server)
+
+// WithName sets the name of the server returned by ServerMetadata.
+func WithName(name string) Option {
+	return func(s *Server) {
+		s.name = name
+	}
+}
+
+// WithVersion sets the version of the server returned by ServerMetadata.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// New returns a new in-memory inference server with an empty repository.
+func New(options ...Option) *Server {
+	s := &Server{
+		name:       DefaultName,
+		version:    DefaultVersion,
+		repository: make(map[string]*repositoryModel),
+	}
+
+	for _, opt := range options {
+		opt(s)
+	}
+
+	return s
+}
+
+// AddModel adds the version of the model to the repository, the model is served
+// after it is loaded by LoadModel or RepositoryModelLoad. The versions served by
+// the model are decided by the version policy of the config of the latest version.
+func (s *Server) AddModel(version int64, model *Model) error {
+	if version < 1 {
+		return errors.New("model version must be greater than zero")
+	}
+
+	if model == nil {
+		return errors.New("model is nil")
+	}
+
+	if err := model.validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := model.Config.GetName()
+	m, ok := s.repository[name]
+	if !ok {
+		m = &repositoryModel{versions: make(map[int64]*modelVersion)}
+		s.repository[name] = m
+	}
+
+	m.versions[version] = &modelVersion{model: model, statistics: newStatistics()}
+	return nil
+}
+
+// LoadModel loads the model in the repository, the statistics of the model are reset.
+func (s *Server) LoadModel(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.repository[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "model %s not found in repository", name)
+	}
+
+	for _, v := range m.versions {
+		v.statistics.reset()
+	}
+
+	m.loaded = true
+	return nil
+}
+
+// UnloadModel unloads the model in the repository.
+func (s *Server) UnloadModel(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.repository[name]
+	if !ok {
+		return status.Errorf(codes.NotFound, "model %s not found in repository", name)
+	}
+
+	m.loaded = false
+	return nil
+}
+
+// ServerLive returns the server is live.
+func (s *Server) ServerLive(context.Context, *inferencev1.ServerLiveRequest) (*inferencev1.ServerLiveResponse, error) {
+	return &inferencev1.ServerLiveResponse{Live: true}, nil
+}
+
+// ServerReady returns the server is ready.
+func (s *Server) ServerReady(context.Context, *inferencev1.ServerReadyRequest) (*inferencev1.ServerReadyResponse, error) {
+	return &inferencev1.ServerReadyResponse{Ready: true}, nil
+}
+
+// ModelReady returns whether the model version is ready, the unknown model is not ready.
+func (s *Server) ModelReady(_ context.Context, req *inferencev1.ModelReadyRequest) (*inferencev1.ModelReadyResponse, error) {
+	_, _, err := s.resolve(req.GetName(), req.GetVersion())
+	return &inferencev1.ModelReadyResponse{Ready: err == nil}, nil
+}
+
+// ServerMetadata returns the metadata of the server.
+func (s *Server) ServerMetadata(context.Context, *inferencev1.ServerMetadataRequest) (*inferencev1.ServerMetadataResponse, error) {
+	return &inferencev1.ServerMetadataResponse{
+		Name:       s.name,
+		Version:    s.version,
+		Extensions: append([]string{}, extensions...),
+	}, nil
+}
+
+// ModelMetadata returns the metadata of the model version.
+func (s *Server) ModelMetadata(_ context.Context, req *inferencev1.ModelMetadataRequest) (*inferencev1.ModelMetadataResponse, error) {
+	v, _, err := s.resolve(req.GetName(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	served := s.served(s.repository[req.GetName()])
+	s.mu.RUnlock()
+
+	config := v.model.Config
+	platform := config.GetPlatform()
+	if platform == "" {
+		platform = config.GetBackend()
+	}
+
+	resp := &inferencev1.ModelMetadataResponse{
+		Name:     config.GetName(),
+		Platform: platform,
+	}
+
+	for _, version := range served {
+		resp.Versions = append(resp.Versions, strconv.FormatInt(version, 10))
+	}
+
+	for _, input := range config.GetInput() {
+		datatype, _ := tensor.Datatype(input.GetDataType())
+		resp.Inputs = append(resp.Inputs, &inferencev1.ModelMetadataResponse_TensorMetadata{
+			Name:     input.GetName(),
+			Datatype: datatype,
+			Shape:    metadataShape(config, input.GetDims()),
+		})
+	}
+
+	for _, output := range config.GetOutput() {
+		datatype, _ := tensor.Datatype(output.GetDataType())
+		resp.Outputs = append(resp.Outputs, &inferencev1.ModelMetadataResponse_TensorMetadata{
+			Name:     output.GetName(),
+			Datatype: datatype,
+			Shape:    metadataShape(config, output.GetDims()),
+		})
+	}
+
+	return resp, nil
+}
+
+// ModelConfig returns the config of the model version.
+func (s *Server) ModelConfig(_ context.Context, req *inferencev1.ModelConfigRequest) (*inferencev1.ModelConfigResponse, error) {
+	v, _, err := s.resolve(req.GetName(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &inferencev1.ModelConfigResponse{
+		Config: proto.Clone(v.model.Config).(*inferencev1.ModelConfig),
+	}, nil
+}
+
+// ModelInfer infers the model version, the inputs are validated against the model config,
+// and the outputs are returned as the raw contents in the requested order.
+func (s *Server) ModelInfer(ctx context.Context, req *inferencev1.ModelInferRequest) (*inferencev1.ModelInferResponse, error) {
+	v, version, err := s.resolve(req.GetModelName(), req.GetModelVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	o := observation{start: time.Now()}
+	resp, err := s.infer(ctx, v, version, req, &o)
+	o.failed = err != nil
+	v.statistics.record(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ModelStreamInfer infers the model version for every request of the stream, the error
+// of the request is returned in the error message of the response and the stream is kept.
+func (s *Server) ModelStreamInfer(stream inferencev1.GRPCInferenceService_ModelStreamInferServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		resp, err := s.ModelInfer(stream.Context(), req)
+		if err != nil {
+			if err := stream.Send(&inferencev1.ModelStreamInferResponse{
+				ErrorMessage: status.Convert(err).Message(),
+				InferResponse: &inferencev1.ModelInferResponse{
+					ModelName:    req.GetModelName(),
+					ModelVersion: req.GetModelVersion(),
+					Id:           req.GetId(),
+				},
+			}); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := stream.Send(&inferencev1.ModelStreamInferResponse{InferResponse: resp}); err != nil {
+			return err
+		}
+	}
+}
+
+// ModelStatistics returns the inference statistics of the ready model versions, the
+// statistics of all ready models are returned if the name is empty.
+func (s *Server) ModelStatistics(_ context.Context, req *inferencev1.ModelStatisticsRequest) (*inferencev1.ModelStatisticsResponse, error) {
+	if req.GetName() != "" {
+		v, version, err := s.resolve(req.GetName(), req.GetVersion())
+		if err != nil {
+			return nil, err
+		}
+
+		if req.GetVersion() != "" {
+			return &inferencev1.ModelStatisticsResponse{
+				ModelStats: []*inferencev1.ModelStatistics{v.statistics.proto(req.GetName(), version)},
+			}, nil
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := &inferencev1.ModelStatisticsResponse{}
+	for _, name := range s.names() {
+		if req.GetName() != "" && name != req.GetName() {
+			continue
+		}
+
+		m := s.repository[name]
+		if !m.loaded {
+			continue
+		}
+
+		for _, version := range s.served(m) {
+			resp.ModelStats = append(resp.ModelStats, m.versions[version].statistics.proto(name, version))
+		}
+	}
+
+	return resp, nil
+}
+
+// RepositoryIndex returns the index of the model versions in the repository.
+func (s *Server) RepositoryIndex(_ context.Context, req *inferencev1.RepositoryIndexRequest) (*inferencev1.RepositoryIndexResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := &inferencev1.RepositoryIndexResponse{}
+	for _, name := range s.names() {
+		m := s.repository[name]
+		served := make(map[int64]struct{})
+		for _, version := range s.served(m) {
+			served[version] = struct{}{}
+		}
+
+		versions := make([]int64, 0, len(m.versions))
+		for version := range m.versions {
+			versions = append(versions, version)
+		}
+
+		sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+		for _, version := range versions {
+			index := &inferencev1.RepositoryIndexResponse_ModelIndex{
+				Name:    name,
+				Version: strconv.FormatInt(version, 10),
+				State:   stateReady,
+			}
+
+			if !m.loaded {
+				index.State, index.Reason = stateUnavailable, "unloaded"
+			} else if _, ok := served[version]; !ok {
+				index.State, index.Reason = stateUnavailable, "unloaded by version policy"
+			}
+
+			if req.GetReady() && index.State != stateReady {
+				continue
+			}
+
+			resp.Models = append(resp.Models, index)
+		}
+	}
+
+	return resp, nil
+}
+
+// RepositoryModelLoad loads the model in the repository.
+func (s *Server) RepositoryModelLoad(_ context.Context, req *inferencev1.RepositoryModelLoadRequest) (*inferencev1.RepositoryModelLoadResponse, error) {
+	if err := s.LoadModel(req.GetModelName()); err != nil {
+		return nil, err
+	}
+
+	return &inferencev1.RepositoryModelLoadResponse{}, nil
+}
+
+// RepositoryModelUnload unloads the model in the repository.
+func (s *Server) RepositoryModelUnload(_ context.Context, req *inferencev1.RepositoryModelUnloadRequest) (*inferencev1.RepositoryModelUnloadResponse, error) {
+	if err := s.UnloadModel(req.GetModelName()); err != nil {
+		return nil, err
+	}
+
+	return &inferencev1.RepositoryModelUnloadResponse{}, nil
+}
+
+// infer validates the request, executes the model version and builds the response.
+func (s *Server) infer(ctx context.Context, v *modelVersion, version int64, req *inferencev1.ModelInferRequest, o *observation) (*inferencev1.ModelInferResponse, error) {
+	config := v.model.Config
+	if len(req.GetRawInputContents()) > 0 && len(req.GetRawInputContents()) != len(req.GetInputs()) {
+		return nil, status.Errorf(codes.InvalidArgument, "expected %d raw input contents, but got %d", len(req.GetInputs()), len(req.GetRawInputContents()))
+	}
+
+	configs := make(map[string]*inferencev1.ModelInput, len(config.GetInput()))
+	for _, input := range config.GetInput() {
+		configs[input.GetName()] = input
+	}
+
+	inputs := make(map[string]*Tensor, len(req.GetInputs()))
+	batchSize := int64(-1)
+	for i, input := range req.GetInputs() {
+		inputConfig, ok := configs[input.GetName()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unexpected input %s for model %s", input.GetName(), config.GetName())
+		}
+
+		if _, ok := inputs[input.GetName()]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate input %s", input.GetName())
+		}
+
+		t := &Tensor{Datatype: input.GetDatatype(), Shape: input.GetShape()}
+		if len(req.GetRawInputContents()) > 0 {
+			t.Data = req.GetRawInputContents()[i]
+		} else {
+			data, err := rawContents(input)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "input %s: %s", input.GetName(), err)
+			}
+
+			t.Data = data
+		}
+
+		n, err := validateInput(config, inputConfig, t)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if batchSize != -1 && n != batchSize {
+			return nil, status.Errorf(codes.InvalidArgument, "input %s has batch size %d, but other inputs have %d", input.GetName(), n, batchSize)
+		}
+
+		batchSize = n
+		inputs[input.GetName()] = t
+	}
+
+	for _, input := range config.GetInput() {
+		if _, ok := inputs[input.GetName()]; !ok && !input.GetOptional() {
+			return nil, status.Errorf(codes.InvalidArgument, "missing input %s for model %s", input.GetName(), config.GetName())
+		}
+	}
+
+	outputConfigs := make(map[string]*inferencev1.ModelOutput, len(config.GetOutput()))
+	for _, output := range config.GetOutput() {
+		outputConfigs[output.GetName()] = output
+	}
+
+	var requested []*inferencev1.ModelOutput
+	if len(req.GetOutputs()) == 0 {
+		requested = config.GetOutput()
+	}
+
+	for _, output := range req.GetOutputs() {
+		outputConfig, ok := outputConfigs[output.GetName()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unexpected output %s for model %s", output.GetName(), config.GetName())
+		}
+
+		requested = append(requested, outputConfig)
+	}
+
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	o.batchSize = uint64(batchSize)
+	o.computeInput = time.Since(o.start)
+
+	inferStart := time.Now()
+	outputs, err := v.model.Infer(ctx, inputs)
+	o.computeInfer = time.Since(inferStart)
+	o.executed = true
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	outputStart := time.Now()
+	resp := &inferencev1.ModelInferResponse{
+		ModelName:    config.GetName(),
+		ModelVersion: strconv.FormatInt(version, 10),
+		Id:           req.GetId(),
+	}
+
+	for _, output := range requested {
+		t, ok := outputs[output.GetName()]
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "model %s does not produce output %s", config.GetName(), output.GetName())
+		}
+
+		datatype, _ := tensor.Datatype(output.GetDataType())
+		if t.Datatype != datatype {
+			return nil, status.Errorf(codes.Internal, "output %s: data type %s does not match %s", output.GetName(), t.Datatype, datatype)
+		}
+
+		resp.Outputs = append(resp.Outputs, &inferencev1.ModelInferResponse_InferOutputTensor{
+			Name:     output.GetName(),
+			Datatype: t.Datatype,
+			Shape:    t.Shape,
+		})
+		resp.RawOutputContents = append(resp.RawOutputContents, t.Data)
+	}
+
+	o.computeOutput = time.Since(outputStart)
+	return resp, nil
+}
+
+// resolve returns the ready model version by name and version, the latest served
+// version is returned if the version is empty.
+func (s *Server) resolve(name, version string) (*modelVersion, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok := s.repository[name]
+	if !ok {
+		return nil, 0, status.Errorf(codes.NotFound, "model %s not found", name)
+	}
+
+	if !m.loaded {
+		return nil, 0, status.Errorf(codes.Unavailable, "model %s is not loaded", name)
+	}
+
+	served := s.served(m)
+	if version == "" {
+		if len(served) == 0 {
+			return nil, 0, status.Errorf(codes.Unavailable, "model %s has no ready version", name)
+		}
+
+		return m.versions[served[0]], served[0], nil
+	}
+
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid version %s of model %s", version, name)
+	}
+
+	if _, ok := m.versions[v]; !ok {
+		return nil, 0, status.Errorf(codes.NotFound, "model %s version %d not found", name, v)
+	}
+
+	for _, servedVersion := range served {
+		if servedVersion == v {
+			return m.versions[v], v, nil
+		}
+	}
+
+	return nil, 0, status.Errorf(codes.Unavailable, "model %s version %d is not ready", name, v)
+}
+
+// served returns the versions served by the version policy of the latest version in
+// descending order, the caller must hold the lock.
+func (s *Server) served(m *repositoryModel) []int64 {
+	versions := make([]int64, 0, len(m.versions))
+	latest := int64(0)
+	for version := range m.versions {
+		versions = append(versions, version)
+		if version > latest {
+			latest = version
+		}
+	}
+
+	if latest == 0 {
+		return nil
+	}
+
+	return policyVersions(m.versions[latest].model.Config, versions)
+}
+
+// names returns the names of the models in the repository in ascending order,
+// the caller must hold the lock.
+func (s *Server) names() []string {
+	names := make([]string, 0, len(s.repository))
+	for name := range s.repository {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// metadataShape returns the shape of the tensor metadata, the batch dimension
+// is presented as -1 if the model supports batching.
+func metadataShape(config *inferencev1.ModelConfig, dims []int64) []int64 {
+	if config.GetMaxBatchSize() > 0 {
+		return append([]int64{-1}, dims...)
+	}
+
+	return append([]int64{}, dims...)
+}
@@ -0,0 +1,492 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inferenceserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	"d7y.io/api/v2/pkg/tensor"
+)
+
+// bufSize is the buffer size of the bufconn listener.
+const bufSize = 1024 * 1024
+
+// sumModel returns the model which sums the pairs of the batched input x to the output z,
+// the optional input y is ignored, and the negative input fails the inference.
+func sumModel(policy *inferencev1.ModelVersionPolicy) *Model {
+	return &Model{
+		Config: &inferencev1.ModelConfig{
+			Name:          "sum",
+			Platform:      "go",
+			MaxBatchSize:  4,
+			VersionPolicy: policy,
+			Input: []*inferencev1.ModelInput{
+				{Name: "x", DataType: inferencev1.DataType_TYPE_FP32, Dims: []int64{2}},
+				{Name: "y", DataType: inferencev1.DataType_TYPE_FP32, Dims: []int64{2}, Optional: true},
+			},
+			Output: []*inferencev1.ModelOutput{
+				{Name: "z", DataType: inferencev1.DataType_TYPE_FP32, Dims: []int64{1}},
+			},
+		},
+		Infer: func(_ context.Context, inputs map[string]*Tensor) (map[string]*Tensor, error) {
+			x := inputs["x"]
+			values, err := tensor.DecodeRaw[float32](x.Datatype, x.Data)
+			if err != nil {
+				return nil, err
+			}
+
+			sums := make([]float32, x.Shape[0])
+			for i, v := range values {
+				if v < 0 {
+					return nil, errors.New("negative input")
+				}
+
+				sums[i/2] += v
+			}
+
+			data, err := tensor.EncodeRaw(tensor.DatatypeFP32, sums)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]*Tensor{"z": {Datatype: tensor.DatatypeFP32, Shape: []int64{x.Shape[0], 1}, Data: data}}, nil
+		},
+	}
+}
+
+// newTestClient serves the server by bufconn, and returns the client of the server.
+func newTestClient(t *testing.T, s *Server) inferencev1.GRPCInferenceServiceClient {
+	t.Helper()
+	gs := grpc.NewServer()
+	inferencev1.RegisterGRPCInferenceServiceServer(gs, s)
+
+	lis := bufconn.Listen(bufSize)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+	return inferencev1.NewGRPCInferenceServiceClient(conn)
+}
+
+// newLoadedServer returns the server of the versions of the sum model, and the model is loaded.
+func newLoadedServer(t *testing.T, policy *inferencev1.ModelVersionPolicy, versions ...int64) *Server {
+	t.Helper()
+	s := New()
+	for _, version := range versions {
+		if err := s.AddModel(version, sumModel(policy)); err != nil {
+			t.Fatalf("AddModel() error = %v", err)
+		}
+	}
+
+	if err := s.LoadModel("sum"); err != nil {
+		t.Fatalf("LoadModel() error = %v", err)
+	}
+
+	return s
+}
+
+// rawSumRequest returns the request of the sum model with the raw input x of the batch.
+func rawSumRequest(t *testing.T, batch int64, values ...float32) *inferencev1.ModelInferRequest {
+	t.Helper()
+	req := &inferencev1.ModelInferRequest{ModelName: "sum", Id: "foo"}
+	if err := tensor.AppendRawInput(req, "x", tensor.DatatypeFP32, []int64{batch, 2}, values); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	return req
+}
+
+// testContext returns the context of the test call.
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestLoadUnload(t *testing.T) {
+	s := New()
+	client := newTestClient(t, s)
+	ctx := testContext(t)
+
+	if err := s.AddModel(1, sumModel(nil)); err != nil {
+		t.Fatalf("AddModel() error = %v", err)
+	}
+
+	ready := func() bool {
+		t.Helper()
+		resp, err := client.ModelReady(ctx, &inferencev1.ModelReadyRequest{Name: "sum"})
+		if err != nil {
+			t.Fatalf("ModelReady() error = %v", err)
+		}
+
+		return resp.GetReady()
+	}
+
+	if ready() {
+		t.Fatal("ModelReady() before loading = true, want false")
+	}
+
+	if _, err := client.RepositoryModelLoad(ctx, &inferencev1.RepositoryModelLoadRequest{ModelName: "sum"}); err != nil {
+		t.Fatalf("RepositoryModelLoad() error = %v", err)
+	}
+
+	if !ready() {
+		t.Fatal("ModelReady() after loading = false, want true")
+	}
+
+	if _, err := client.RepositoryModelUnload(ctx, &inferencev1.RepositoryModelUnloadRequest{ModelName: "sum"}); err != nil {
+		t.Fatalf("RepositoryModelUnload() error = %v", err)
+	}
+
+	if ready() {
+		t.Fatal("ModelReady() after unloading = true, want false")
+	}
+
+	if _, err := client.ModelInfer(ctx, rawSumRequest(t, 1, 1, 2)); status.Code(err) != codes.Unavailable {
+		t.Fatalf("ModelInfer() of unloaded model error = %v, want %v", err, codes.Unavailable)
+	}
+
+	if _, err := client.RepositoryModelLoad(ctx, &inferencev1.RepositoryModelLoadRequest{ModelName: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("RepositoryModelLoad() of unknown model error = %v, want %v", err, codes.NotFound)
+	}
+
+	if resp, err := client.ModelReady(ctx, &inferencev1.ModelReadyRequest{Name: "unknown"}); err != nil || resp.GetReady() {
+		t.Fatalf("ModelReady() of unknown model = %v, %v, want not ready", resp, err)
+	}
+}
+
+func TestVersionPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *inferencev1.ModelVersionPolicy
+		served []string
+	}{
+		{
+			name:   "default latest",
+			served: []string{"3"},
+		},
+		{
+			name: "latest versions",
+			policy: &inferencev1.ModelVersionPolicy{PolicyChoice: &inferencev1.ModelVersionPolicy_Latest_{
+				Latest: &inferencev1.ModelVersionPolicy_Latest{NumVersions: 2},
+			}},
+			served: []string{"3", "2"},
+		},
+		{
+			name: "all versions",
+			policy: &inferencev1.ModelVersionPolicy{PolicyChoice: &inferencev1.ModelVersionPolicy_All_{
+				All: &inferencev1.ModelVersionPolicy_All{},
+			}},
+			served: []string{"3", "2", "1"},
+		},
+		{
+			name: "specific versions",
+			policy: &inferencev1.ModelVersionPolicy{PolicyChoice: &inferencev1.ModelVersionPolicy_Specific_{
+				Specific: &inferencev1.ModelVersionPolicy_Specific{Versions: []int64{1, 3, 4}},
+			}},
+			served: []string{"3", "1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, newLoadedServer(t, tc.policy, 1, 2, 3))
+			ctx := testContext(t)
+
+			metadata, err := client.ModelMetadata(ctx, &inferencev1.ModelMetadataRequest{Name: "sum"})
+			if err != nil {
+				t.Fatalf("ModelMetadata() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(metadata.GetVersions(), tc.served) {
+				t.Fatalf("ModelMetadata() versions = %v, want %v", metadata.GetVersions(), tc.served)
+			}
+
+			// The batch dimension is presented as -1.
+			if shape := metadata.GetInputs()[0].GetShape(); !reflect.DeepEqual(shape, []int64{-1, 2}) {
+				t.Fatalf("ModelMetadata() input shape = %v, want [-1 2]", shape)
+			}
+
+			index, err := client.RepositoryIndex(ctx, &inferencev1.RepositoryIndexRequest{})
+			if err != nil {
+				t.Fatalf("RepositoryIndex() error = %v", err)
+			}
+
+			if len(index.GetModels()) != 3 {
+				t.Fatalf("RepositoryIndex() models = %v, want 3 versions", index.GetModels())
+			}
+
+			served := make(map[string]bool, len(tc.served))
+			for _, version := range tc.served {
+				served[version] = true
+			}
+
+			for _, model := range index.GetModels() {
+				if (model.GetState() == stateReady) != served[model.GetVersion()] {
+					t.Fatalf("RepositoryIndex() version %s state = %s", model.GetVersion(), model.GetState())
+				}
+
+				resp, err := client.ModelReady(ctx, &inferencev1.ModelReadyRequest{Name: "sum", Version: model.GetVersion()})
+				if err != nil || resp.GetReady() != served[model.GetVersion()] {
+					t.Fatalf("ModelReady() version %s = %v, %v", model.GetVersion(), resp, err)
+				}
+			}
+
+			ready, err := client.RepositoryIndex(ctx, &inferencev1.RepositoryIndexRequest{Ready: true})
+			if err != nil {
+				t.Fatalf("RepositoryIndex() error = %v", err)
+			}
+
+			if len(ready.GetModels()) != len(tc.served) {
+				t.Fatalf("RepositoryIndex() ready models = %v, want %d versions", ready.GetModels(), len(tc.served))
+			}
+		})
+	}
+}
+
+func TestModelInfer(t *testing.T) {
+	client := newTestClient(t, newLoadedServer(t, nil, 1))
+	ctx := testContext(t)
+
+	typed, err := tensor.NewInput("x", tensor.DatatypeFP32, []int64{2, 2}, []float32{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("NewInput() error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *inferencev1.ModelInferRequest
+	}{
+		{name: "raw inputs", req: rawSumRequest(t, 2, 1, 2, 3, 4)},
+		{name: "typed inputs", req: &inferencev1.ModelInferRequest{ModelName: "sum", Id: "foo", Inputs: []*inferencev1.ModelInferRequest_InferInputTensor{typed}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := client.ModelInfer(ctx, tc.req)
+			if err != nil {
+				t.Fatalf("ModelInfer() error = %v", err)
+			}
+
+			if resp.GetModelVersion() != "1" || resp.GetId() != "foo" {
+				t.Fatalf("ModelInfer() = %v", resp)
+			}
+
+			z, shape, err := tensor.Output[float32](resp, "z")
+			if err != nil {
+				t.Fatalf("Output() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(z, []float32{3, 7}) || !reflect.DeepEqual(shape, []int64{2, 1}) {
+				t.Fatalf("Output() = %v, %v", z, shape)
+			}
+		})
+	}
+}
+
+func TestModelInferInvalid(t *testing.T) {
+	client := newTestClient(t, newLoadedServer(t, nil, 1))
+	ctx := testContext(t)
+
+	mismatchedBatch := rawSumRequest(t, 2, 1, 2, 3, 4)
+	if err := tensor.AppendRawInput(mismatchedBatch, "y", tensor.DatatypeFP32, []int64{1, 2}, []float32{1, 2}); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	missing := &inferencev1.ModelInferRequest{ModelName: "sum"}
+	if err := tensor.AppendRawInput(missing, "y", tensor.DatatypeFP32, []int64{1, 2}, []float32{1, 2}); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	mismatchedType := &inferencev1.ModelInferRequest{ModelName: "sum"}
+	if err := tensor.AppendRawInput(mismatchedType, "x", tensor.DatatypeFP64, []int64{1, 2}, []float64{1, 2}); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	truncated := rawSumRequest(t, 1, 1, 2)
+	truncated.RawInputContents[0] = truncated.RawInputContents[0][:4]
+
+	unexpectedOutput := rawSumRequest(t, 1, 1, 2)
+	unexpectedOutput.Outputs = []*inferencev1.ModelInferRequest_InferRequestedOutputTensor{{Name: "unknown"}}
+
+	tests := []struct {
+		name string
+		req  *inferencev1.ModelInferRequest
+		code codes.Code
+	}{
+		{name: "batch size mismatch", req: mismatchedBatch, code: codes.InvalidArgument},
+		{name: "missing input", req: missing, code: codes.InvalidArgument},
+		{name: "data type mismatch", req: mismatchedType, code: codes.InvalidArgument},
+		{name: "batch size exceeds max batch size", req: rawSumRequest(t, 5, make([]float32, 10)...), code: codes.InvalidArgument},
+		{name: "truncated raw contents", req: truncated, code: codes.InvalidArgument},
+		{name: "unexpected output", req: unexpectedOutput, code: codes.InvalidArgument},
+		{name: "unknown model", req: &inferencev1.ModelInferRequest{ModelName: "unknown"}, code: codes.NotFound},
+		{name: "unknown version", req: &inferencev1.ModelInferRequest{ModelName: "sum", ModelVersion: "2"}, code: codes.NotFound},
+		{name: "infer error", req: rawSumRequest(t, 1, -1, 2), code: codes.Internal},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := client.ModelInfer(ctx, tc.req); status.Code(err) != tc.code {
+				t.Fatalf("ModelInfer() error = %v, want %v", err, tc.code)
+			}
+		})
+	}
+}
+
+func TestModelStreamInfer(t *testing.T) {
+	client := newTestClient(t, newLoadedServer(t, nil, 1))
+	stream, err := client.ModelStreamInfer(testContext(t))
+	if err != nil {
+		t.Fatalf("ModelStreamInfer() error = %v", err)
+	}
+
+	invalid := rawSumRequest(t, 1, 1, 2)
+	invalid.Id = "bar"
+	invalid.RawInputContents[0] = invalid.RawInputContents[0][:4]
+	for _, req := range []*inferencev1.ModelInferRequest{invalid, rawSumRequest(t, 1, 1, 2)} {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+
+	// The stream is kept after the failed request.
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+
+	if resp.GetErrorMessage() == "" || resp.GetInferResponse().GetId() != "bar" {
+		t.Fatalf("Recv() of invalid request = %v, want error message", resp)
+	}
+
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+
+	if resp.GetErrorMessage() != "" {
+		t.Fatalf("Recv() error message = %s", resp.GetErrorMessage())
+	}
+
+	if z, _, err := tensor.Output[float32](resp.GetInferResponse(), "z"); err != nil || !reflect.DeepEqual(z, []float32{3}) {
+		t.Fatalf("Output() = %v, %v", z, err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+}
+
+func TestModelStatistics(t *testing.T) {
+	s := newLoadedServer(t, nil, 1)
+	client := newTestClient(t, s)
+	ctx := testContext(t)
+
+	for _, req := range []*inferencev1.ModelInferRequest{
+		rawSumRequest(t, 2, 1, 2, 3, 4),
+		rawSumRequest(t, 1, 1, 2),
+		rawSumRequest(t, 2, 1, 2, 3, 4),
+		// The invalid request fails before the execution.
+		rawSumRequest(t, 5, make([]float32, 10)...),
+		// The failed execution is not counted.
+		rawSumRequest(t, 1, -1, 2),
+	} {
+		client.ModelInfer(ctx, req)
+	}
+
+	resp, err := client.ModelStatistics(ctx, &inferencev1.ModelStatisticsRequest{Name: "sum", Version: "1"})
+	if err != nil {
+		t.Fatalf("ModelStatistics() error = %v", err)
+	}
+
+	if len(resp.GetModelStats()) != 1 {
+		t.Fatalf("ModelStatistics() = %v, want one model", resp)
+	}
+
+	stats := resp.GetModelStats()[0]
+	if stats.GetInferenceCount() != 5 || stats.GetExecutionCount() != 3 || stats.GetLastInference() == 0 {
+		t.Fatalf("ModelStatistics() inference count = %d, execution count = %d, last inference = %d, want 5, 3 and set",
+			stats.GetInferenceCount(), stats.GetExecutionCount(), stats.GetLastInference())
+	}
+
+	inferenceStats := stats.GetInferenceStats()
+	if inferenceStats.GetSuccess().GetCount() != 3 || inferenceStats.GetFail().GetCount() != 2 || inferenceStats.GetComputeInfer().GetCount() != 3 {
+		t.Fatalf("ModelStatistics() inference stats = %v", inferenceStats)
+	}
+
+	var batchSizes []uint64
+	for _, batch := range stats.GetBatchStats() {
+		batchSizes = append(batchSizes, batch.GetBatchSize())
+	}
+
+	if !reflect.DeepEqual(batchSizes, []uint64{1, 2}) || stats.GetBatchStats()[1].GetComputeInfer().GetCount() != 2 {
+		t.Fatalf("ModelStatistics() batch stats = %v", stats.GetBatchStats())
+	}
+
+	// The statistics are reset by loading the model.
+	if err := s.LoadModel("sum"); err != nil {
+		t.Fatalf("LoadModel() error = %v", err)
+	}
+
+	resp, err = client.ModelStatistics(ctx, &inferencev1.ModelStatisticsRequest{})
+	if err != nil {
+		t.Fatalf("ModelStatistics() error = %v", err)
+	}
+
+	if len(resp.GetModelStats()) != 1 || resp.GetModelStats()[0].GetExecutionCount() != 0 {
+		t.Fatalf("ModelStatistics() after loading = %v, want reset statistics", resp)
+	}
+}
+
+func TestAddModelInvalid(t *testing.T) {
+	s := New()
+	if err := s.AddModel(0, sumModel(nil)); err == nil {
+		t.Fatal("AddModel() of version 0 error = nil, want error")
+	}
+
+	if err := s.AddModel(1, &Model{Config: &inferencev1.ModelConfig{Name: "foo"}}); err == nil {
+		t.Fatal("AddModel() without infer func error = nil, want error")
+	}
+
+	invalid := sumModel(nil)
+	invalid.Config.Input[0].DataType = inferencev1.DataType_TYPE_INVALID
+	if err := s.AddModel(1, invalid); err == nil {
+		t.Fatal("AddModel() of invalid data type error = nil, want error")
+	}
+}
@@ -0,0 +1,209 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inferenceserver
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// duration is the cumulative count and duration.
+type duration struct {
+	// count is the count of the observations.
+	count uint64
+
+	// ns is the cumulative duration in nanoseconds.
+	ns uint64
+}
+
+// add adds the duration.
+func (d *duration) add(elapsed time.Duration) {
+	d.count++
+	d.ns += uint64(elapsed.Nanoseconds())
+}
+
+// proto returns the statistic duration.
+func (d duration) proto() *inferencev1.StatisticDuration {
+	return &inferencev1.StatisticDuration{Count: d.count, Ns: d.ns}
+}
+
+// statistics is the inference statistics of the model version, it is safe for concurrent use.
+type statistics struct {
+	// mu is the lock of the statistics.
+	mu sync.Mutex
+
+	// lastInference is the timestamp of the last inference in milliseconds since the epoch.
+	lastInference uint64
+
+	// inferenceCount is the count of the successful inferences, every element of the batch is counted.
+	inferenceCount uint64
+
+	// executionCount is the count of the model executions of the successful requests.
+	executionCount uint64
+
+	// success is the duration of the successful requests.
+	success duration
+
+	// fail is the duration of the failed requests.
+	fail duration
+
+	// computeInput is the duration of preparing the input tensors.
+	computeInput duration
+
+	// computeInfer is the duration of executing the model.
+	computeInfer duration
+
+	// computeOutput is the duration of extracting the output tensors.
+	computeOutput duration
+
+	// batches is the compute durations by batch size.
+	batches map[uint64]*batchStatistics
+}
+
+// batchStatistics is the compute durations of the batch size.
+type batchStatistics struct {
+	// computeInput is the duration of preparing the input tensors.
+	computeInput duration
+
+	// computeInfer is the duration of executing the model.
+	computeInfer duration
+
+	// computeOutput is the duration of extracting the output tensors.
+	computeOutput duration
+}
+
+// newStatistics returns a new statistics.
+func newStatistics() *statistics {
+	return &statistics{batches: make(map[uint64]*batchStatistics)}
+}
+
+// reset resets the statistics.
+func (s *statistics) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastInference, s.inferenceCount, s.executionCount = 0, 0, 0
+	s.success, s.fail = duration{}, duration{}
+	s.computeInput, s.computeInfer, s.computeOutput = duration{}, duration{}, duration{}
+	s.batches = make(map[uint64]*batchStatistics)
+}
+
+// observation is the observation of the inference request.
+type observation struct {
+	// start is the time when the request is received.
+	start time.Time
+
+	// batchSize is the batch size of the request.
+	batchSize uint64
+
+	// computeInput is the duration of preparing the input tensors.
+	computeInput time.Duration
+
+	// computeInfer is the duration of executing the model.
+	computeInfer time.Duration
+
+	// computeOutput is the duration of extracting the output tensors.
+	computeOutput time.Duration
+
+	// executed is whether the model is executed.
+	executed bool
+
+	// failed is whether the request is failed.
+	failed bool
+}
+
+// record records the observation of the request.
+func (s *statistics) record(o observation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := time.Since(o.start)
+	if o.failed {
+		s.fail.add(elapsed)
+	} else {
+		s.success.add(elapsed)
+	}
+
+	// The failed requests are only counted by the fail duration like Triton,
+	// even if the model is executed.
+	if !o.executed || o.failed {
+		return
+	}
+
+	s.lastInference = uint64(time.Now().UnixMilli())
+	s.inferenceCount += o.batchSize
+	s.executionCount++
+	s.computeInput.add(o.computeInput)
+	s.computeInfer.add(o.computeInfer)
+	s.computeOutput.add(o.computeOutput)
+
+	batch, ok := s.batches[o.batchSize]
+	if !ok {
+		batch = &batchStatistics{}
+		s.batches[o.batchSize] = batch
+	}
+
+	batch.computeInput.add(o.computeInput)
+	batch.computeInfer.add(o.computeInfer)
+	batch.computeOutput.add(o.computeOutput)
+}
+
+// proto returns the model statistics of the model version.
+func (s *statistics) proto(name string, version int64) *inferencev1.ModelStatistics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := &inferencev1.ModelStatistics{
+		Name:           name,
+		Version:        strconv.FormatInt(version, 10),
+		LastInference:  s.lastInference,
+		InferenceCount: s.inferenceCount,
+		ExecutionCount: s.executionCount,
+		InferenceStats: &inferencev1.InferStatistics{
+			Success:       s.success.proto(),
+			Fail:          s.fail.proto(),
+			Queue:         duration{count: s.executionCount}.proto(),
+			ComputeInput:  s.computeInput.proto(),
+			ComputeInfer:  s.computeInfer.proto(),
+			ComputeOutput: s.computeOutput.proto(),
+			CacheHit:      duration{}.proto(),
+			CacheMiss:     duration{}.proto(),
+		},
+	}
+
+	batchSizes := make([]uint64, 0, len(s.batches))
+	for batchSize := range s.batches {
+		batchSizes = append(batchSizes, batchSize)
+	}
+
+	sort.Slice(batchSizes, func(i, j int) bool { return batchSizes[i] < batchSizes[j] })
+	for _, batchSize := range batchSizes {
+		batch := s.batches[batchSize]
+		stats.BatchStats = append(stats.BatchStats, &inferencev1.InferBatchStatistics{
+			BatchSize:     batchSize,
+			ComputeInput:  batch.computeInput.proto(),
+			ComputeInfer:  batch.computeInfer.proto(),
+			ComputeOutput: batch.computeOutput.proto(),
+		})
+	}
+
+	return stats
+}
@@ -0,0 +1,276 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package modelagent deploys the model artifacts of manager.v2 to the inference server,
+// the files are downloaded by dfdaemon.v2 into the model repository of the inference
+// server, and the model is loaded by inference.v1 with rollback on failure.
+package modelagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+	"d7y.io/api/v2/pkg/modelconfig"
+)
+
+const (
+	// DefaultDownloadTimeout is the default timeout of downloading the file.
+	DefaultDownloadTimeout = 30 * time.Minute
+
+	// DefaultPieceLength is the default piece length of downloading the file.
+	DefaultPieceLength = 4 * 1024 * 1024
+)
+
+// Agent deploys the model artifacts to the model repository of an inference server,
+// the deployments are serialized.
+type Agent struct {
+	// dfdaemon is the client of dfdaemon.v2.Dfdaemon on the host.
+	dfdaemon dfdaemonv2.DfdaemonClient
+
+	// inference is the client of inference.v1.GRPCInferenceService of the inference server.
+	inference inferencev1.GRPCInferenceServiceClient
+
+	// repositoryPath is the path of the model repository of the inference server.
+	repositoryPath string
+
+	// application is the application of the download tasks.
+	application string
+
+	// downloadTimeout is the timeout of downloading the file.
+	downloadTimeout time.Duration
+
+	// pieceLength is the piece length of downloading the file.
+	pieceLength int32
+
+	// gpus is the available GPUs of the host, the model configs are defaulted with them.
+	gpus []int32
+
+	// mu serializes the deployments.
+	mu sync.Mutex
+}
+
+// Option is a functional option for configuring the Agent.
+type Option func(a *Agent)
+
+// WithApplication sets the application of the download tasks.
+func WithApplication(application string) Option {
+	return func(a *Agent) {
+		a.application = application
+	}
+}
+
+// WithDownloadTimeout sets the timeout of downloading the file.
+func WithDownloadTimeout(timeout time.Duration) Option {
+	return func(a *Agent) {
+		a.downloadTimeout = timeout
+	}
+}
+
+// WithPieceLength sets the piece length of downloading the file.
+func WithPieceLength(pieceLength int32) Option {
+	return func(a *Agent) {
+		a.pieceLength = pieceLength
+	}
+}
+
+// WithGPUs sets the available GPUs of the host, the instance groups of KIND_AUTO are placed on
+// the GPUs, and the instance groups of KIND_GPU without GPUs use all of them. The instance groups
+// of KIND_GPU without GPUs are left to the inference server if the GPUs of the host are unknown.
+func WithGPUs(gpus ...int32) Option {
+	return func(a *Agent) {
+		a.gpus = append([]int32{}, gpus...)
+	}
+}
+
+// New returns a new Agent of the inference server whose model repository is the repository path.
+func New(dfdaemon dfdaemonv2.DfdaemonClient, inference inferencev1.GRPCInferenceServiceClient, repositoryPath string, options ...Option) *Agent {
+	a := &Agent{
+		dfdaemon:        dfdaemon,
+		inference:       inference,
+		repositoryPath:  repositoryPath,
+		downloadTimeout: DefaultDownloadTimeout,
+		pieceLength:     DefaultPieceLength,
+	}
+
+	for _, opt := range options {
+		opt(a)
+	}
+
+	return a
+}
+
+// Deploy deploys the model artifact, the files are downloaded and verified into a staging
+// directory, then the version directory of the model is replaced, the config defaulted with
+// the GPUs of the host is written as config.pbtxt, and the model is loaded. If the model can not be loaded or the version is not ready after loading,
+// the previous version directory and config.pbtxt are restored, and the model is reloaded
+// if it was ready before, otherwise it is unloaded.
+func (a *Agent) Deploy(ctx context.Context, artifact *managerv2.ModelArtifact) error {
+	if err := artifact.Validate(); err != nil {
+		return fmt.Errorf("invalid model artifact: %w", err)
+	}
+
+	if artifact.GetConfig().GetName() != artifact.GetName() {
+		return fmt.Errorf("model config name %s does not match model %s", artifact.GetConfig().GetName(), artifact.GetName())
+	}
+
+	config := proto.Clone(artifact.GetConfig()).(*inferencev1.ModelConfig)
+	modelconfig.SetDefaults(config, modelconfig.WithGPUs(a.gpus...))
+	if err := modelconfig.Validate(config); err != nil {
+		return fmt.Errorf("invalid model config: %w", err)
+	}
+
+	if !filepath.IsLocal(artifact.GetName()) || filepath.Base(artifact.GetName()) != artifact.GetName() {
+		return fmt.Errorf("invalid model name %s", artifact.GetName())
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	version := strconv.FormatUint(artifact.GetVersion(), 10)
+	modelDir := filepath.Join(a.repositoryPath, artifact.GetName())
+	if err := os.MkdirAll(modelDir, 0755); err != nil {
+		return err
+	}
+
+	// Download into a hidden directory which is not a version directory of the inference server.
+	stagingDir := filepath.Join(modelDir, "."+version+".staging")
+	if err := os.RemoveAll(stagingDir); err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	for _, file := range artifact.GetFiles() {
+		if err := a.download(ctx, stagingDir, file); err != nil {
+			return err
+		}
+	}
+
+	ready, err := a.inference.ModelReady(ctx, &inferencev1.ModelReadyRequest{Name: artifact.GetName()})
+	if err != nil {
+		return fmt.Errorf("check model %s ready: %w", artifact.GetName(), err)
+	}
+
+	s, err := a.swap(modelDir, version, stagingDir, config)
+	if err != nil {
+		return err
+	}
+
+	if err := a.load(ctx, artifact.GetName(), version); err != nil {
+		return errors.Join(err, a.rollback(ctx, artifact.GetName(), s, ready.GetReady()))
+	}
+
+	return s.commit()
+}
+
+// Undeploy unloads the model and removes it from the model repository.
+func (a *Agent) Undeploy(ctx context.Context, name string) error {
+	if !filepath.IsLocal(name) || filepath.Base(name) != name {
+		return fmt.Errorf("invalid model name %s", name)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, err := a.inference.RepositoryModelUnload(ctx, &inferencev1.RepositoryModelUnloadRequest{ModelName: name}); err != nil {
+		return fmt.Errorf("unload model %s: %w", name, err)
+	}
+
+	return os.RemoveAll(filepath.Join(a.repositoryPath, name))
+}
+
+// download downloads the file into the directory by dfdaemon.v2.DownloadTask and verifies it.
+func (a *Agent) download(ctx context.Context, dir string, file *managerv2.ModelFile) error {
+	if !filepath.IsLocal(file.GetPath()) {
+		return fmt.Errorf("invalid model file path %s", file.GetPath())
+	}
+
+	outputPath, err := filepath.Abs(filepath.Join(dir, file.GetPath()))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+
+	if _, err := a.dfdaemon.DownloadTask(ctx, &dfdaemonv2.DownloadTaskRequest{
+		Download: &commonv2.Download{
+			Url:         file.GetUrl(),
+			Type:        commonv2.TaskType_DFDAEMON,
+			Application: a.application,
+			Priority:    commonv2.Priority_LEVEL0,
+			PieceLength: a.pieceLength,
+			OutputPath:  outputPath,
+			Timeout:     durationpb.New(a.downloadTimeout),
+		},
+	}); err != nil {
+		return fmt.Errorf("download model file %s: %w", file.GetPath(), err)
+	}
+
+	return verify(outputPath, file)
+}
+
+// load loads the model and checks the version is ready.
+func (a *Agent) load(ctx context.Context, name, version string) error {
+	if _, err := a.inference.RepositoryModelLoad(ctx, &inferencev1.RepositoryModelLoadRequest{ModelName: name}); err != nil {
+		return fmt.Errorf("load model %s: %w", name, err)
+	}
+
+	ready, err := a.inference.ModelReady(ctx, &inferencev1.ModelReadyRequest{Name: name, Version: version})
+	if err != nil {
+		return fmt.Errorf("check model %s version %s ready: %w", name, version, err)
+	}
+
+	if !ready.GetReady() {
+		return fmt.Errorf("model %s version %s is not ready after loading", name, version)
+	}
+
+	return nil
+}
+
+// rollback restores the model repository and the state of the model before the deployment.
+func (a *Agent) rollback(ctx context.Context, name string, s *swap, ready bool) error {
+	if err := s.restore(); err != nil {
+		return fmt.Errorf("rollback model %s: %w", name, err)
+	}
+
+	if ready {
+		if _, err := a.inference.RepositoryModelLoad(ctx, &inferencev1.RepositoryModelLoadRequest{ModelName: name}); err != nil {
+			return fmt.Errorf("rollback model %s: reload: %w", name, err)
+		}
+
+		return nil
+	}
+
+	if _, err := a.inference.RepositoryModelUnload(ctx, &inferencev1.RepositoryModelUnloadRequest{ModelName: name}); err != nil {
+		return fmt.Errorf("rollback model %s: unload: %w", name, err)
+	}
+
+	return nil
+}
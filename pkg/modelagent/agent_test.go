@@ -0,0 +1,405 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelagent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+	"d7y.io/api/v2/pkg/inferenceserver"
+	"d7y.io/api/v2/pkg/modelconfig"
+)
+
+// bufSize is the buffer size of the bufconn listener.
+const bufSize = 1024 * 1024
+
+// fakeDfdaemon writes the contents of the url to the output path of the download.
+type fakeDfdaemon struct {
+	dfdaemonv2.UnimplementedDfdaemonServer
+
+	// mu is the lock of the fields.
+	mu sync.Mutex
+
+	// contents is the file contents by url.
+	contents map[string][]byte
+
+	// requests is the received download requests.
+	requests []*dfdaemonv2.DownloadTaskRequest
+}
+
+// DownloadTask writes the contents of the url to the output path.
+func (f *fakeDfdaemon) DownloadTask(_ context.Context, req *dfdaemonv2.DownloadTaskRequest) (*emptypb.Empty, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, req)
+	data, ok := f.contents[req.GetDownload().GetUrl()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "url %s not found", req.GetDownload().GetUrl())
+	}
+
+	if err := os.WriteFile(req.GetDownload().GetOutputPath(), data, 0644); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// flakyServer is the in-memory inference server whose loads can be failed.
+type flakyServer struct {
+	*inferenceserver.Server
+
+	// mu is the lock of the fields.
+	mu sync.Mutex
+
+	// failLoads is the number of the next loads to fail.
+	failLoads int
+}
+
+// RepositoryModelLoad fails the load if failLoads is positive, otherwise loads the model.
+func (f *flakyServer) RepositoryModelLoad(ctx context.Context, req *inferencev1.RepositoryModelLoadRequest) (*inferencev1.RepositoryModelLoadResponse, error) {
+	f.mu.Lock()
+	if f.failLoads > 0 {
+		f.failLoads--
+		f.mu.Unlock()
+		return nil, status.Error(codes.Internal, "failed to load")
+	}
+	f.mu.Unlock()
+
+	return f.Server.RepositoryModelLoad(ctx, req)
+}
+
+// dial starts the registered server over bufconn and returns the connection to it.
+func dial(t *testing.T, register func(gs *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	gs := grpc.NewServer()
+	register(gs)
+
+	lis := bufconn.Listen(bufSize)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// testEnv is the environment of the agent tests.
+type testEnv struct {
+	// agent is the tested agent.
+	agent *Agent
+
+	// dfdaemon is the fake dfdaemon.
+	dfdaemon *fakeDfdaemon
+
+	// server is the in-memory inference server.
+	server *flakyServer
+
+	// inference is the client of the inference server.
+	inference inferencev1.GRPCInferenceServiceClient
+
+	// repositoryPath is the path of the model repository.
+	repositoryPath string
+}
+
+// newTestEnv returns the environment whose inference server has the versions of the sum model
+// of the version policy, the model is not loaded.
+func newTestEnv(t *testing.T, policy *inferencev1.ModelVersionPolicy, versions ...int64) *testEnv {
+	t.Helper()
+	env := &testEnv{
+		dfdaemon:       &fakeDfdaemon{contents: make(map[string][]byte)},
+		server:         &flakyServer{Server: inferenceserver.New()},
+		repositoryPath: t.TempDir(),
+	}
+
+	for _, version := range versions {
+		if err := env.server.AddModel(version, &inferenceserver.Model{
+			Config: &inferencev1.ModelConfig{Name: "sum", VersionPolicy: policy},
+			Infer: func(context.Context, map[string]*inferenceserver.Tensor) (map[string]*inferenceserver.Tensor, error) {
+				return nil, errors.New("not implemented")
+			},
+		}); err != nil {
+			t.Fatalf("AddModel() error = %v", err)
+		}
+	}
+
+	dfdaemonConn := dial(t, func(gs *grpc.Server) { dfdaemonv2.RegisterDfdaemonServer(gs, env.dfdaemon) })
+	inferenceConn := dial(t, func(gs *grpc.Server) { inferencev1.RegisterGRPCInferenceServiceServer(gs, env.server) })
+	env.inference = inferencev1.NewGRPCInferenceServiceClient(inferenceConn)
+	env.agent = New(dfdaemonv2.NewDfdaemonClient(dfdaemonConn), env.inference, env.repositoryPath,
+		WithApplication("model-agent"), WithPieceLength(1024), WithDownloadTimeout(time.Minute), WithGPUs(0))
+	return env
+}
+
+// artifact returns the artifact of the version of the sum model whose file is served by the fake dfdaemon.
+func (env *testEnv) artifact(version uint64, content string) *managerv2.ModelArtifact {
+	url := "https://example.com/sum/" + content + "/model.onnx"
+	env.dfdaemon.mu.Lock()
+	env.dfdaemon.contents[url] = []byte(content)
+	env.dfdaemon.mu.Unlock()
+
+	digest := sha256.Sum256([]byte(content))
+	return &managerv2.ModelArtifact{
+		Name:               "sum",
+		Version:            version,
+		SchedulerClusterId: 1,
+		Files: []*managerv2.ModelFile{
+			{Path: "model.onnx", Url: url, Digest: "sha256:" + hex.EncodeToString(digest[:]), Size: uint64(len(content))},
+		},
+		Config: &inferencev1.ModelConfig{
+			Name:         "sum",
+			Platform:     "onnxruntime_onnx",
+			MaxBatchSize: 4,
+			Input:        []*inferencev1.ModelInput{{Name: "x", DataType: inferencev1.DataType_TYPE_FP32, Dims: []int64{2}}},
+			Output:       []*inferencev1.ModelOutput{{Name: "z", DataType: inferencev1.DataType_TYPE_FP32, Dims: []int64{1}}},
+		},
+	}
+}
+
+// ready returns whether the sum model is ready.
+func (env *testEnv) ready(t *testing.T) bool {
+	t.Helper()
+	resp, err := env.inference.ModelReady(context.Background(), &inferencev1.ModelReadyRequest{Name: "sum"})
+	if err != nil {
+		t.Fatalf("ModelReady() error = %v", err)
+	}
+
+	return resp.GetReady()
+}
+
+// entries returns the names of the entries of the model directory in ascending order.
+func (env *testEnv) entries(t *testing.T) []string {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(env.repositoryPath, "sum"))
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// readFile returns the content of the file of the model directory.
+func (env *testEnv) readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(env.repositoryPath, "sum", path))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	return string(data)
+}
+
+// testContext returns the context of the test call.
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestDeploy(t *testing.T) {
+	env := newTestEnv(t, nil, 1)
+	artifact := env.artifact(1, "foo")
+	if err := env.agent.Deploy(testContext(t), artifact); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	if !env.ready(t) {
+		t.Fatal("ModelReady() after deploying = false, want true")
+	}
+
+	if entries := env.entries(t); !reflect.DeepEqual(entries, []string{"1", modelconfig.FileName}) {
+		t.Fatalf("model directory entries = %v, want [1 %s]", entries, modelconfig.FileName)
+	}
+
+	if content := env.readFile(t, "1/model.onnx"); content != "foo" {
+		t.Fatalf("model file content = %q, want %q", content, "foo")
+	}
+
+	// The config is written defaulted with the GPUs of the host.
+	config, err := modelconfig.ReadFile(filepath.Join(env.repositoryPath, "sum"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	want := proto.Clone(artifact.GetConfig()).(*inferencev1.ModelConfig)
+	modelconfig.SetDefaults(want, modelconfig.WithGPUs(0))
+	if !proto.Equal(config, want) {
+		t.Fatalf("config.pbtxt = %v, want %v", config, want)
+	}
+
+	if group := config.GetInstanceGroup(); len(group) != 1 || group[0].GetKind() != inferencev1.ModelInstanceGroup_KIND_GPU ||
+		!reflect.DeepEqual(group[0].GetGpus(), []int32{0}) {
+		t.Fatalf("config.pbtxt instance groups = %v, want KIND_GPU on gpu 0", group)
+	}
+
+	env.dfdaemon.mu.Lock()
+	download := env.dfdaemon.requests[0].GetDownload()
+	env.dfdaemon.mu.Unlock()
+	if download.GetUrl() != artifact.GetFiles()[0].GetUrl() || download.GetApplication() != "model-agent" ||
+		download.GetPieceLength() != 1024 || download.GetTimeout().AsDuration() != time.Minute || !filepath.IsAbs(download.GetOutputPath()) {
+		t.Fatalf("DownloadTask() download = %v", download)
+	}
+
+	// The version directory is replaced by redeploying, and the backup is removed.
+	if err := env.agent.Deploy(testContext(t), env.artifact(1, "bar")); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	if content := env.readFile(t, "1/model.onnx"); content != "bar" {
+		t.Fatalf("model file content = %q, want %q", content, "bar")
+	}
+
+	if entries := env.entries(t); !reflect.DeepEqual(entries, []string{"1", modelconfig.FileName}) {
+		t.Fatalf("model directory entries = %v, want [1 %s]", entries, modelconfig.FileName)
+	}
+}
+
+func TestDeployRollbackLoadFailure(t *testing.T) {
+	env := newTestEnv(t, nil, 1)
+	if err := env.agent.Deploy(testContext(t), env.artifact(1, "foo")); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	previousConfig := env.readFile(t, modelconfig.FileName)
+	env.server.mu.Lock()
+	env.server.failLoads = 1
+	env.server.mu.Unlock()
+
+	artifact := env.artifact(1, "bar")
+	artifact.Config.MaxBatchSize = 8
+	err := env.agent.Deploy(testContext(t), artifact)
+	if err == nil || !strings.Contains(err.Error(), "load model sum") {
+		t.Fatalf("Deploy() error = %v, want load error", err)
+	}
+
+	// The previous version directory and config are restored, and the model is reloaded.
+	if content := env.readFile(t, "1/model.onnx"); content != "foo" {
+		t.Fatalf("model file content = %q, want %q", content, "foo")
+	}
+
+	if config := env.readFile(t, modelconfig.FileName); config != previousConfig {
+		t.Fatalf("config.pbtxt = %q, want %q", config, previousConfig)
+	}
+
+	if entries := env.entries(t); !reflect.DeepEqual(entries, []string{"1", modelconfig.FileName}) {
+		t.Fatalf("model directory entries = %v, want [1 %s]", entries, modelconfig.FileName)
+	}
+
+	if !env.ready(t) {
+		t.Fatal("ModelReady() after rollback = false, want true")
+	}
+}
+
+func TestDeployRollbackNotReady(t *testing.T) {
+	// The version 1 is not served by the version policy, so it is not ready after loading.
+	env := newTestEnv(t, &inferencev1.ModelVersionPolicy{
+		PolicyChoice: &inferencev1.ModelVersionPolicy_Specific_{Specific: &inferencev1.ModelVersionPolicy_Specific{Versions: []int64{2}}},
+	}, 1)
+
+	err := env.agent.Deploy(testContext(t), env.artifact(1, "foo"))
+	if err == nil || !strings.Contains(err.Error(), "model sum version 1 is not ready after loading") {
+		t.Fatalf("Deploy() error = %v, want not ready error", err)
+	}
+
+	// The model was not ready before, so the files are removed and the model is unloaded.
+	if entries := env.entries(t); len(entries) != 0 {
+		t.Fatalf("model directory entries = %v, want empty", entries)
+	}
+
+	resp, err := env.inference.RepositoryIndex(testContext(t), &inferencev1.RepositoryIndexRequest{})
+	if err != nil {
+		t.Fatalf("RepositoryIndex() error = %v", err)
+	}
+
+	if models := resp.GetModels(); len(models) != 1 || models[0].GetReason() != "unloaded" {
+		t.Fatalf("RepositoryIndex() = %v, want unloaded model", models)
+	}
+}
+
+func TestDeployInvalid(t *testing.T) {
+	env := newTestEnv(t, nil, 1)
+
+	mismatched := env.artifact(1, "foo")
+	mismatched.Config.Name = "other"
+
+	invalidConfig := env.artifact(1, "foo")
+	invalidConfig.Config.Input[0].Dims = nil
+
+	invalidDigest := env.artifact(1, "foo")
+	invalidDigest.Files[0].Digest = "sha256:00"
+
+	notFound := env.artifact(1, "foo")
+	notFound.Files[0].Url = "https://example.com/unknown"
+
+	for name, artifact := range map[string]*managerv2.ModelArtifact{
+		"name mismatch":  mismatched,
+		"invalid config": invalidConfig,
+		"invalid digest": invalidDigest,
+		"url not found":  notFound,
+	} {
+		if err := env.agent.Deploy(testContext(t), artifact); err == nil {
+			t.Fatalf("Deploy() of %s error = nil, want error", name)
+		}
+	}
+
+	// The failed deployments leave neither the version directory nor the staging directory.
+	if entries, err := os.ReadDir(filepath.Join(env.repositoryPath, "sum")); err == nil && len(entries) != 0 {
+		t.Fatalf("model directory entries = %v, want empty", entries)
+	}
+
+	if env.ready(t) {
+		t.Fatal("ModelReady() after failed deployments = true, want false")
+	}
+}
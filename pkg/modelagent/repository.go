@@ -0,0 +1,177 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelagent
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+	"d7y.io/api/v2/pkg/modelconfig"
+)
+
+// swap is the replacement of the version directory and config.pbtxt of the model,
+// which can be committed or restored.
+type swap struct {
+	// versionDir is the version directory of the model.
+	versionDir string
+
+	// backupDir is the backup of the previous version directory, it is empty if the
+	// version directory did not exist.
+	backupDir string
+
+	// configPath is the path of config.pbtxt of the model.
+	configPath string
+
+	// previousConfig is the previous content of config.pbtxt, it is nil if config.pbtxt did not exist.
+	previousConfig []byte
+}
+
+// swap replaces the version directory of the model by the staging directory, and writes the config.
+func (a *Agent) swap(modelDir, version, stagingDir string, config *inferencev1.ModelConfig) (*swap, error) {
+	s := &swap{
+		versionDir: filepath.Join(modelDir, version),
+		configPath: filepath.Join(modelDir, modelconfig.FileName),
+	}
+
+	data, err := modelconfig.Format(config)
+	if err != nil {
+		return nil, err
+	}
+
+	previousConfig, err := os.ReadFile(s.configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if err == nil {
+		s.previousConfig = previousConfig
+	}
+
+	if _, err := os.Stat(s.versionDir); err == nil {
+		s.backupDir = filepath.Join(modelDir, "."+version+".backup")
+		if err := os.RemoveAll(s.backupDir); err != nil {
+			return nil, err
+		}
+
+		if err := os.Rename(s.versionDir, s.backupDir); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if err := os.Rename(stagingDir, s.versionDir); err != nil {
+		return nil, errors.Join(err, s.restore())
+	}
+
+	if err := writeFile(s.configPath, data); err != nil {
+		return nil, errors.Join(err, s.restore())
+	}
+
+	return s, nil
+}
+
+// commit removes the backup of the previous version directory.
+func (s *swap) commit() error {
+	if s.backupDir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(s.backupDir)
+}
+
+// restore restores the previous version directory and config.pbtxt.
+func (s *swap) restore() error {
+	if err := os.RemoveAll(s.versionDir); err != nil {
+		return err
+	}
+
+	if s.backupDir != "" {
+		if err := os.Rename(s.backupDir, s.versionDir); err != nil {
+			return err
+		}
+	}
+
+	if s.previousConfig == nil {
+		if err := os.Remove(s.configPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	return writeFile(s.configPath, s.previousConfig)
+}
+
+// writeFile writes the file atomically by renaming the temporary file.
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// verify verifies the size and the digest of the downloaded file.
+func verify(path string, file *managerv2.ModelFile) error {
+	algorithm, expected, ok := strings.Cut(file.GetDigest(), ":")
+	if !ok {
+		return fmt.Errorf("invalid digest %s of model file %s", file.GetDigest(), file.GetPath())
+	}
+
+	var h hash.Hash
+	switch algorithm {
+	case "md5":
+		h = md5.New()
+	case "sha256":
+		h = sha256.New()
+	default:
+		return fmt.Errorf("unsupported digest algorithm %s of model file %s", algorithm, file.GetPath())
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+
+	if file.GetSize() > 0 && uint64(n) != file.GetSize() {
+		return fmt.Errorf("model file %s has %d bytes, but expected %d", file.GetPath(), n, file.GetSize())
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("model file %s has digest %s:%s, but expected %s", file.GetPath(), algorithm, actual, file.GetDigest())
+	}
+
+	return nil
+}
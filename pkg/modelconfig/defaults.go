@@ -0,0 +1,133 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package modelconfig validates, defaults, parses and formats the inference.v1 ModelConfig
+// of the model repository, which is stored as config.pbtxt in the directory of the model.
+package modelconfig
+
+import (
+	"fmt"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+const (
+	// EnsemblePlatform is the platform of the ensemble model.
+	EnsemblePlatform = "ensemble"
+
+	// DefaultMaxSequenceIdleMicroseconds is the default max idle time of the sequence.
+	DefaultMaxSequenceIdleMicroseconds = 1000000
+)
+
+// options is the options of the defaulting.
+type options struct {
+	// gpus is the available GPUs of the server.
+	gpus []int32
+}
+
+// Option is a functional option for configuring the defaulting.
+type Option func(o *options)
+
+// WithGPUs sets the available GPUs of the server, the instance groups of KIND_AUTO are
+// placed on the GPUs, and the instance groups of KIND_GPU without GPUs use all of them.
+// Without WithGPUs, the instance groups of KIND_GPU keep empty GPUs, which Triton treats as all GPUs.
+// The instance groups of KIND_AUTO are placed on the CPU if no GPU is available.
+func WithGPUs(gpus ...int32) Option {
+	return func(o *options) {
+		o.gpus = append([]int32{}, gpus...)
+	}
+}
+
+// SetDefaults applies the defaulting rules of the inference server to the config in place:
+//
+//   - The version policy defaults to serving the latest version.
+//   - The instance groups default to one group of KIND_AUTO, and every group is named
+//     <model>_<index> and has at least one instance. The ensemble has no instance group.
+//   - The instance groups of KIND_AUTO are resolved to KIND_GPU or KIND_CPU.
+//   - The sequence batching defaults to the direct strategy with one second max idle time,
+//     and the max candidate sequences of the oldest strategy defaults to the max batch size.
+func SetDefaults(config *inferencev1.ModelConfig, opts ...Option) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if config.GetVersionPolicy().GetPolicyChoice() == nil {
+		config.VersionPolicy = &inferencev1.ModelVersionPolicy{
+			PolicyChoice: &inferencev1.ModelVersionPolicy_Latest_{
+				Latest: &inferencev1.ModelVersionPolicy_Latest{NumVersions: 1},
+			},
+		}
+	}
+
+	if latest := config.GetVersionPolicy().GetLatest(); latest != nil && latest.GetNumVersions() == 0 {
+		latest.NumVersions = 1
+	}
+
+	if config.GetPlatform() != EnsemblePlatform {
+		setInstanceGroupDefaults(config, o)
+	}
+
+	if sequence := config.GetSequenceBatching(); sequence != nil {
+		if sequence.GetMaxSequenceIdleMicroseconds() == 0 {
+			sequence.MaxSequenceIdleMicroseconds = DefaultMaxSequenceIdleMicroseconds
+		}
+
+		switch {
+		case sequence.GetOldest() != nil:
+			if sequence.GetOldest().GetMaxCandidateSequences() == 0 {
+				sequence.GetOldest().MaxCandidateSequences = config.GetMaxBatchSize()
+				if sequence.GetOldest().GetMaxCandidateSequences() < 1 {
+					sequence.GetOldest().MaxCandidateSequences = 1
+				}
+			}
+		case sequence.GetDirect() == nil:
+			sequence.StrategyChoice = &inferencev1.ModelSequenceBatching_Direct{
+				Direct: &inferencev1.ModelSequenceBatching_StrategyDirect{},
+			}
+		}
+	}
+}
+
+// setInstanceGroupDefaults applies the defaulting rules of the instance groups.
+func setInstanceGroupDefaults(config *inferencev1.ModelConfig, o *options) {
+	if len(config.GetInstanceGroup()) == 0 {
+		config.InstanceGroup = []*inferencev1.ModelInstanceGroup{{Kind: inferencev1.ModelInstanceGroup_KIND_AUTO}}
+	}
+
+	for i, group := range config.GetInstanceGroup() {
+		if group.GetName() == "" {
+			group.Name = fmt.Sprintf("%s_%d", config.GetName(), i)
+		}
+
+		if group.GetCount() < 1 {
+			group.Count = 1
+		}
+
+		if group.GetKind() == inferencev1.ModelInstanceGroup_KIND_AUTO {
+			switch {
+			case len(group.GetGpus()) > 0 || len(o.gpus) > 0:
+				group.Kind = inferencev1.ModelInstanceGroup_KIND_GPU
+			default:
+				group.Kind = inferencev1.ModelInstanceGroup_KIND_CPU
+			}
+		}
+
+		if group.GetKind() == inferencev1.ModelInstanceGroup_KIND_GPU && len(group.GetGpus()) == 0 {
+			group.Gpus = append([]int32{}, o.gpus...)
+		}
+	}
+}
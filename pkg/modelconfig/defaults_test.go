@@ -0,0 +1,123 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+func TestSetDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		opts   []Option
+		want   string
+	}{
+		{
+			name:   "version policy and instance group on CPU",
+			config: `name: "m" backend: "python"`,
+			want: `name: "m" backend: "python"
+				version_policy { latest { num_versions: 1 } }
+				instance_group [ { name: "m_0" kind: KIND_CPU count: 1 } ]`,
+		},
+		{
+			name:   "instance group on GPUs",
+			config: `name: "m" backend: "python"`,
+			opts:   []Option{WithGPUs(0, 1)},
+			want: `name: "m" backend: "python"
+				version_policy { latest { num_versions: 1 } }
+				instance_group [ { name: "m_0" kind: KIND_GPU count: 1 gpus: [ 0, 1 ] } ]`,
+		},
+		{
+			name: "keep the specified fields",
+			config: `name: "m" backend: "python"
+				version_policy { latest {} }
+				instance_group [ { name: "cpu" kind: KIND_CPU count: 2 }, { kind: KIND_GPU }, { kind: KIND_AUTO gpus: [ 1 ] } ]`,
+			opts: []Option{WithGPUs(0)},
+			want: `name: "m" backend: "python"
+				version_policy { latest { num_versions: 1 } }
+				instance_group [
+				  { name: "cpu" kind: KIND_CPU count: 2 },
+				  { name: "m_1" kind: KIND_GPU count: 1 gpus: [ 0 ] },
+				  { name: "m_2" kind: KIND_GPU count: 1 gpus: [ 1 ] }
+				]`,
+		},
+		{
+			name:   "ensemble without instance group",
+			config: `name: "m" platform: "ensemble"`,
+			want: `name: "m" platform: "ensemble"
+				version_policy { latest { num_versions: 1 } }`,
+		},
+		{
+			name:   "direct sequence batching",
+			config: `name: "m" backend: "python" instance_group [ { kind: KIND_CPU } ] sequence_batching {}`,
+			want: `name: "m" backend: "python"
+				version_policy { latest { num_versions: 1 } }
+				instance_group [ { name: "m_0" kind: KIND_CPU count: 1 } ]
+				sequence_batching { max_sequence_idle_microseconds: 1000000 direct {} }`,
+		},
+		{
+			name: "oldest sequence batching",
+			config: `name: "m" backend: "python" max_batch_size: 4 instance_group [ { kind: KIND_CPU } ]
+				sequence_batching { max_sequence_idle_microseconds: 10 oldest {} }`,
+			want: `name: "m" backend: "python" max_batch_size: 4
+				version_policy { latest { num_versions: 1 } }
+				instance_group [ { name: "m_0" kind: KIND_CPU count: 1 } ]
+				sequence_batching { max_sequence_idle_microseconds: 10 oldest { max_candidate_sequences: 4 } }`,
+		},
+		{
+			name: "oldest sequence batching without batch",
+			config: `name: "m" backend: "python" instance_group [ { kind: KIND_CPU } ]
+				sequence_batching { oldest {} }`,
+			want: `name: "m" backend: "python"
+				version_policy { latest { num_versions: 1 } }
+				instance_group [ { name: "m_0" kind: KIND_CPU count: 1 } ]
+				sequence_batching { max_sequence_idle_microseconds: 1000000 oldest { max_candidate_sequences: 1 } }`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, want := mustParse(t, tc.config), mustParse(t, tc.want)
+			SetDefaults(config, tc.opts...)
+			if !proto.Equal(config, want) {
+				t.Fatalf("SetDefaults() = %v, want %v", config, want)
+			}
+
+			// The defaulting is idempotent.
+			SetDefaults(config, tc.opts...)
+			if !proto.Equal(config, want) {
+				t.Fatalf("SetDefaults() twice = %v, want %v", config, want)
+			}
+		})
+	}
+}
+
+// mustParse parses the config of the protobuf text format.
+func mustParse(t *testing.T, text string) *inferencev1.ModelConfig {
+	t.Helper()
+	config, err := Parse([]byte(text))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	return config
+}
@@ -0,0 +1,228 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// repositoryModel is the model in the model repository.
+type repositoryModel struct {
+	// config is the defaulted config of the model.
+	config *inferencev1.ModelConfig
+
+	// versions is the version directories of the model.
+	versions map[int64]struct{}
+}
+
+// ValidateRepository lints the model repository before it is deployed, every directory of
+// the repository is a model with a config and numeric version directories. The config is
+// defaulted by SetDefaults and checked by Validate, the name must match the directory,
+// the specific versions must exist, and the steps of the ensembles are checked against
+// the configs of the step models. All the found errors are returned joined.
+func ValidateRepository(root string, opts ...Option) error {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	models := make(map[string]*repositoryModel)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		model, err := readModel(filepath.Join(root, entry.Name()), opts...)
+		errs = append(errs, prefixErrors(entry.Name(), err)...)
+
+		if model != nil {
+			models[entry.Name()] = model
+		}
+	}
+
+	for _, name := range sortedKeys(models) {
+		if models[name].config.GetPlatform() != EnsemblePlatform {
+			continue
+		}
+
+		errs = append(errs, prefixErrors(name, validateEnsembleSteps(models[name].config, models))...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// readModel reads, defaults and validates the model of the directory, the model is returned
+// with the validation error if the config can be parsed.
+func readModel(dir string, opts ...Option) (*repositoryModel, error) {
+	config, err := ReadFile(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	SetDefaults(config, opts...)
+	model := &repositoryModel{config: config, versions: make(map[int64]struct{})}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if version, err := strconv.ParseInt(entry.Name(), 10, 64); err == nil && version > 0 {
+			model.versions[version] = struct{}{}
+		}
+	}
+
+	var errs []error
+	if err := Validate(config); err != nil {
+		errs = append(errs, err)
+	}
+
+	if config.GetName() != filepath.Base(dir) {
+		errs = append(errs, fmt.Errorf("name %s does not match the directory %s", config.GetName(), filepath.Base(dir)))
+	}
+
+	if len(model.versions) == 0 {
+		errs = append(errs, errors.New("no version directory found"))
+	}
+
+	for _, version := range config.GetVersionPolicy().GetSpecific().GetVersions() {
+		if _, ok := model.versions[version]; !ok {
+			errs = append(errs, fmt.Errorf("version_policy: specific version %d not found", version))
+		}
+	}
+
+	return model, errors.Join(errs...)
+}
+
+// validateEnsembleSteps validates the steps of the ensemble against the configs of the step
+// models, the mapped tensors must exist in the step models with the same data type.
+func validateEnsembleSteps(config *inferencev1.ModelConfig, models map[string]*repositoryModel) error {
+	var errs []error
+
+	// dataTypes is the data type by ensemble tensor, the intermediate tensors are typed by the producer.
+	dataTypes := make(map[string]inferencev1.DataType)
+	for _, input := range config.GetInput() {
+		dataTypes[input.GetName()] = input.GetDataType()
+	}
+
+	for i, step := range config.GetEnsembleScheduling().GetStep() {
+		model, ok := models[step.GetModelName()]
+		if !ok {
+			errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: model %s not found", i, step.GetModelName()))
+			continue
+		}
+
+		if step.GetModelVersion() > 0 {
+			if _, ok := model.versions[step.GetModelVersion()]; !ok {
+				errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: model %s version %d not found", i, step.GetModelName(), step.GetModelVersion()))
+			}
+		}
+
+		outputs := make(map[string]inferencev1.DataType, len(model.config.GetOutput()))
+		for _, output := range model.config.GetOutput() {
+			outputs[output.GetName()] = output.GetDataType()
+		}
+
+		for _, key := range sortedKeys(step.GetOutputMap()) {
+			tensor := step.GetOutputMap()[key]
+			dataType, ok := outputs[key]
+			if !ok {
+				errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: %s is not an output of model %s", i, key, step.GetModelName()))
+				continue
+			}
+
+			dataTypes[tensor] = dataType
+		}
+	}
+
+	for i, step := range config.GetEnsembleScheduling().GetStep() {
+		model, ok := models[step.GetModelName()]
+		if !ok {
+			continue
+		}
+
+		for _, input := range model.config.GetInput() {
+			tensor, ok := step.GetInputMap()[input.GetName()]
+			if !ok {
+				if !input.GetOptional() {
+					errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: input %s of model %s is not mapped", i, input.GetName(), step.GetModelName()))
+				}
+
+				continue
+			}
+
+			if dataType, ok := dataTypes[tensor]; ok && dataType != input.GetDataType() {
+				errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: tensor %s is %s, but input %s of model %s is %s",
+					i, tensor, dataType, input.GetName(), step.GetModelName(), input.GetDataType()))
+			}
+		}
+
+		for _, key := range sortedKeys(step.GetInputMap()) {
+			if !hasInput(model.config, key) {
+				errs = append(errs, fmt.Errorf("ensemble_scheduling: step[%d]: %s is not an input of model %s", i, key, step.GetModelName()))
+			}
+		}
+	}
+
+	for _, output := range config.GetOutput() {
+		if dataType, ok := dataTypes[output.GetName()]; ok && dataType != output.GetDataType() {
+			errs = append(errs, fmt.Errorf("ensemble_scheduling: output %s is %s, but produced as %s", output.GetName(), output.GetDataType(), dataType))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// prefixErrors flattens the joined errors and prefixes every error with the model name.
+func prefixErrors(name string, err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, err := range joined.Unwrap() {
+			errs = append(errs, prefixErrors(name, err)...)
+		}
+
+		return errs
+	}
+
+	return []error{fmt.Errorf("model %s: %w", name, err)}
+}
+
+// hasInput returns whether the config has the input.
+func hasInput(config *inferencev1.ModelConfig, name string) bool {
+	for _, input := range config.GetInput() {
+		if input.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
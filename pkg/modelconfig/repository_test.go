@@ -0,0 +1,189 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testModel is the model of the test repository.
+type testModel struct {
+	// config is the config of the protobuf text format.
+	config string
+
+	// versions is the version directories of the model.
+	versions []string
+}
+
+const (
+	testPreprocessText = `
+backend: "python"
+max_batch_size: 8
+input [ { name: "raw" data_type: TYPE_UINT8 dims: [ -1 ] } ]
+output [ { name: "features" data_type: TYPE_FP32 dims: [ 16 ] } ]
+`
+
+	testClassifierText = `
+platform: "onnxruntime_onnx"
+max_batch_size: 8
+input [ { name: "features" data_type: TYPE_FP32 dims: [ 16 ] } ]
+output [ { name: "scores" data_type: TYPE_FP32 dims: [ 4 ] } ]
+`
+)
+
+// writeRepository writes the models to a temporary repository and returns its root.
+func writeRepository(t *testing.T, models map[string]*testModel) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "README.md"), []byte("models"), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	for name, model := range models {
+		if model == nil {
+			continue
+		}
+
+		dir := filepath.Join(root, name)
+		for _, version := range append([]string{""}, model.versions...) {
+			if err := os.MkdirAll(filepath.Join(dir, version), 0755); err != nil {
+				t.Fatalf("MkdirAll() error = %v", err)
+			}
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, FileName), []byte(model.config), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	return root
+}
+
+func TestValidateRepository(t *testing.T) {
+	tests := []struct {
+		name   string
+		models map[string]*testModel
+		want   []string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:   "name mismatch",
+			models: map[string]*testModel{"cls": {config: `name: "classifier"` + testClassifierText, versions: []string{"1"}}},
+			want:   []string{"model cls: name classifier does not match the directory cls"},
+		},
+		{
+			name:   "no version directory",
+			models: map[string]*testModel{"pre": {config: testPreprocessText, versions: []string{"latest", "0"}}},
+			want:   []string{"model pre: no version directory found"},
+		},
+		{
+			name:   "specific version not found",
+			models: map[string]*testModel{"cls": {config: testClassifierText + `version_policy { specific { versions: [ 1, 2 ] } }`, versions: []string{"1"}}},
+			want:   []string{"model cls: version_policy: specific version 2 not found"},
+		},
+		{
+			name:   "invalid config",
+			models: map[string]*testModel{"pre": {config: `backend: `, versions: []string{"1"}}},
+			want:   []string{"model pre: ", "model ens: ensemble_scheduling: step[0]: model pre not found"},
+		},
+		{
+			name:   "step model not found",
+			models: map[string]*testModel{"cls": nil},
+			want:   []string{"model ens: ensemble_scheduling: step[1]: model cls not found"},
+		},
+		{
+			name: "step version not found",
+			models: map[string]*testModel{"ens": {
+				config:   strings.Replace(testEnsembleText, `model_name: "cls"
+      model_version: -1`, `model_name: "cls"
+      model_version: 3`, 1),
+				versions: []string{"1"},
+			}},
+			want: []string{"model ens: ensemble_scheduling: step[1]: model cls version 3 not found"},
+		},
+		{
+			name: "step data type mismatch",
+			models: map[string]*testModel{"cls": {
+				config:   strings.Replace(testClassifierText, "TYPE_FP32 dims: [ 16 ]", "TYPE_FP16 dims: [ 16 ]", 1),
+				versions: []string{"1"},
+			}},
+			want: []string{"model ens: ensemble_scheduling: step[1]: tensor FEATURES is TYPE_FP32, but input features of model cls is TYPE_FP16"},
+		},
+		{
+			name: "step output data type mismatch",
+			models: map[string]*testModel{"cls": {
+				config:   strings.Replace(testClassifierText, "TYPE_FP32 dims: [ 4 ]", "TYPE_FP64 dims: [ 4 ]", 1),
+				versions: []string{"1"},
+			}},
+			want: []string{"model ens: ensemble_scheduling: output SCORES is TYPE_FP32, but produced as TYPE_FP64"},
+		},
+		{
+			name: "step tensors not in model",
+			models: map[string]*testModel{"pre": {
+				config: strings.Replace(testPreprocessText, `input [ { name: "raw" data_type: TYPE_UINT8 dims: [ -1 ] } ]`,
+					`input [ { name: "image" data_type: TYPE_UINT8 dims: [ -1 ] } ]`, 1),
+				versions: []string{"1"},
+			}},
+			want: []string{
+				"model ens: ensemble_scheduling: step[0]: input image of model pre is not mapped",
+				"model ens: ensemble_scheduling: step[0]: raw is not an input of model pre",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			models := map[string]*testModel{
+				"pre": {config: testPreprocessText, versions: []string{"1"}},
+				"cls": {config: testClassifierText, versions: []string{"1", "2"}},
+				"ens": {config: testEnsembleText, versions: []string{"1"}},
+			}
+
+			for name, model := range tc.models {
+				models[name] = model
+			}
+
+			err := ValidateRepository(writeRepository(t, models))
+			if len(tc.want) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateRepository() error = %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("ValidateRepository() error = nil, want %q", tc.want)
+			}
+
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("ValidateRepository() error = %v, want %q", err, want)
+				}
+			}
+		})
+	}
+
+	if err := ValidateRepository(filepath.Join(t.TempDir(), "unknown")); err == nil {
+		t.Fatal("ValidateRepository() of unknown root error = nil, want error")
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// FileName is the file name of the config in the directory of the model.
+const FileName = "config.pbtxt"
+
+// Parse parses the config of the protobuf text format.
+func Parse(data []byte) (*inferencev1.ModelConfig, error) {
+	config := &inferencev1.ModelConfig{}
+	if err := prototext.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parse model config: %w", err)
+	}
+
+	return config, nil
+}
+
+// Format formats the config in the protobuf text format. The output is not guaranteed
+// to be byte-for-byte stable across protobuf versions, so it must not be compared as bytes.
+func Format(config *inferencev1.ModelConfig) ([]byte, error) {
+	data, err := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("format model config: %w", err)
+	}
+
+	return data, nil
+}
+
+// ReadFile reads the config of the model directory, the name of the config defaults
+// to the name of the model directory.
+func ReadFile(dir string) (*inferencev1.ModelConfig, error) {
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Join(dir, FileName), err)
+	}
+
+	if config.GetName() == "" {
+		config.Name = filepath.Base(dir)
+	}
+
+	return config, nil
+}
+
+// WriteFile writes the config to the model directory.
+func WriteFile(dir string, config *inferencev1.ModelConfig) error {
+	data, err := Format(config)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, FileName), data, 0644)
+}
@@ -0,0 +1,135 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+const testConfigText = `
+name: "classifier"
+platform: "onnxruntime_onnx"
+max_batch_size: 8
+input [
+  {
+    name: "features"
+    data_type: TYPE_FP32
+    dims: [ 16 ]
+  }
+]
+output [
+  {
+    name: "scores"
+    data_type: TYPE_FP32
+    dims: [ 4 ]
+  }
+]
+instance_group [
+  {
+    kind: KIND_GPU
+    count: 2
+    gpus: [ 0, 1 ]
+  }
+]
+dynamic_batching {
+  preferred_batch_size: [ 4, 8 ]
+  max_queue_delay_microseconds: 100
+}
+`
+
+func TestParseFormat(t *testing.T) {
+	config, err := Parse([]byte(testConfigText))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if config.GetName() != "classifier" || config.GetMaxBatchSize() != 8 || len(config.GetInstanceGroup()) != 1 ||
+		config.GetInstanceGroup()[0].GetKind() != inferencev1.ModelInstanceGroup_KIND_GPU {
+		t.Fatalf("Parse() = %v", config)
+	}
+
+	data, err := Format(config)
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() of formatted config error = %v", err)
+	}
+
+	if !proto.Equal(parsed, config) {
+		t.Fatalf("Parse(Format()) = %v, want %v", parsed, config)
+	}
+
+	if _, err := Parse([]byte(`name: "classifier" unknown_field: 1`)); err == nil {
+		t.Fatal("Parse() of unknown field error = nil, want error")
+	}
+}
+
+func TestReadWriteFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "classifier")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+
+	config, err := Parse([]byte(testConfigText))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if err := WriteFile(dir, config); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	read, err := ReadFile(dir)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	if !proto.Equal(read, config) {
+		t.Fatalf("ReadFile() = %v, want %v", read, config)
+	}
+
+	// The name defaults to the name of the model directory.
+	config.Name = ""
+	if err := WriteFile(dir, config); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if read, err := ReadFile(dir); err != nil || read.GetName() != "classifier" {
+		t.Fatalf("ReadFile() = %v, %v, want name classifier", read, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, FileName), []byte("name: "), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := ReadFile(dir); err == nil {
+		t.Fatal("ReadFile() of invalid config error = nil, want error")
+	}
+
+	if _, err := ReadFile(t.TempDir()); err == nil {
+		t.Fatal("ReadFile() of directory without config error = nil, want error")
+	}
+}
@@ -0,0 +1,589 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// validator collects the errors of the config.
+type validator struct {
+	// config is the validated config.
+	config *inferencev1.ModelConfig
+
+	// errs is the collected errors.
+	errs []error
+}
+
+// errorf collects the error.
+func (v *validator) errorf(format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf(format, args...))
+}
+
+// Validate checks the config for consistency, and returns all the found errors joined.
+// The config is expected to be defaulted by SetDefaults. The steps of the ensemble are
+// checked against the ensemble inputs and outputs only, use ValidateRepository to check
+// them against the configs of the step models.
+func Validate(config *inferencev1.ModelConfig) error {
+	v := &validator{config: config}
+	if config.GetName() == "" {
+		v.errorf("name must not be empty")
+	}
+
+	if config.GetPlatform() == "" && config.GetBackend() == "" {
+		v.errorf("platform or backend must be specified")
+	}
+
+	if config.GetMaxBatchSize() < 0 {
+		v.errorf("max_batch_size %d must not be negative", config.GetMaxBatchSize())
+	}
+
+	v.validateInputs()
+	v.validateOutputs()
+	v.validateBatchInputs()
+	v.validateVersionPolicy()
+	v.validateInstanceGroups()
+	v.validateDynamicBatching()
+	v.validateSequenceBatching()
+	v.validateEnsemble()
+	v.validateWarmup()
+	return errors.Join(v.errs...)
+}
+
+// validateInputs validates the inputs.
+func (v *validator) validateInputs() {
+	names := make(map[string]struct{}, len(v.config.GetInput()))
+	for i, input := range v.config.GetInput() {
+		path := fmt.Sprintf("input[%d]", i)
+		if input.GetName() == "" {
+			v.errorf("%s: name must not be empty", path)
+		} else if _, ok := names[input.GetName()]; ok {
+			v.errorf("%s: duplicate input %s", path, input.GetName())
+		}
+
+		names[input.GetName()] = struct{}{}
+		v.validateTensor(path, input.GetDataType(), input.GetDims(), input.GetReshape())
+		if input.GetAllowRaggedBatch() && v.config.GetMaxBatchSize() == 0 {
+			v.errorf("%s: allow_ragged_batch requires max_batch_size greater than zero", path)
+		}
+	}
+}
+
+// validateOutputs validates the outputs.
+func (v *validator) validateOutputs() {
+	names := make(map[string]struct{}, len(v.config.GetOutput()))
+	for i, output := range v.config.GetOutput() {
+		path := fmt.Sprintf("output[%d]", i)
+		if output.GetName() == "" {
+			v.errorf("%s: name must not be empty", path)
+		} else if _, ok := names[output.GetName()]; ok {
+			v.errorf("%s: duplicate output %s", path, output.GetName())
+		}
+
+		names[output.GetName()] = struct{}{}
+		v.validateTensor(path, output.GetDataType(), output.GetDims(), output.GetReshape())
+	}
+}
+
+// validateTensor validates the data type, the dims and the reshape of the tensor, the dims
+// exclude the batch dimension, so the element count of the dims must match the reshape.
+func (v *validator) validateTensor(path string, dataType inferencev1.DataType, dims []int64, reshape *inferencev1.ModelTensorReshape) {
+	if dataType == inferencev1.DataType_TYPE_INVALID {
+		v.errorf("%s: data_type must be specified", path)
+	}
+
+	if len(dims) == 0 {
+		v.errorf("%s: dims must not be empty, use dims [ 1 ] with reshape { shape: [ ] } for a scalar", path)
+	}
+
+	count, variable := elementCount(dims)
+	for _, dim := range dims {
+		if dim == 0 || dim < -1 {
+			v.errorf("%s: dim %d must be positive or -1", path, dim)
+			return
+		}
+	}
+
+	if reshape == nil {
+		return
+	}
+
+	reshapeCount, reshapeVariable := elementCount(reshape.GetShape())
+	for _, dim := range reshape.GetShape() {
+		if dim == 0 || dim < -1 {
+			v.errorf("%s: reshape dim %d must be positive or -1", path, dim)
+			return
+		}
+	}
+
+	switch {
+	case reshapeVariable && !variable:
+		v.errorf("%s: reshape %v has variable dims but dims %v has not", path, reshape.GetShape(), dims)
+	case !variable && count != reshapeCount:
+		v.errorf("%s: reshape %v has %d elements but dims %v has %d", path, reshape.GetShape(), reshapeCount, dims, count)
+	}
+}
+
+// validateBatchInputs validates the batch inputs.
+func (v *validator) validateBatchInputs() {
+	inputs := v.inputNames()
+	for i, batchInput := range v.config.GetBatchInput() {
+		path := fmt.Sprintf("batch_input[%d]", i)
+		if v.config.GetMaxBatchSize() == 0 {
+			v.errorf("%s: batch input requires max_batch_size greater than zero", path)
+		}
+
+		if len(batchInput.GetTargetName()) == 0 {
+			v.errorf("%s: target_name must not be empty", path)
+		}
+
+		for _, source := range batchInput.GetSourceInput() {
+			if _, ok := inputs[source]; !ok {
+				v.errorf("%s: source input %s is not an input of the model", path, source)
+			}
+		}
+	}
+}
+
+// validateVersionPolicy validates the version policy.
+func (v *validator) validateVersionPolicy() {
+	policy := v.config.GetVersionPolicy()
+	switch {
+	case policy.GetLatest() != nil:
+		if policy.GetLatest().GetNumVersions() < 1 {
+			v.errorf("version_policy: latest num_versions must be greater than zero")
+		}
+	case policy.GetSpecific() != nil:
+		versions := policy.GetSpecific().GetVersions()
+		if len(versions) == 0 {
+			v.errorf("version_policy: specific versions must not be empty")
+		}
+
+		seen := make(map[int64]struct{}, len(versions))
+		for _, version := range versions {
+			if version < 1 {
+				v.errorf("version_policy: specific version %d must be greater than zero", version)
+			}
+
+			if _, ok := seen[version]; ok {
+				v.errorf("version_policy: duplicate specific version %d", version)
+			}
+
+			seen[version] = struct{}{}
+		}
+	case policy.GetAll() != nil:
+	default:
+		v.errorf("version_policy must be specified")
+	}
+}
+
+// validateInstanceGroups validates the instance groups.
+func (v *validator) validateInstanceGroups() {
+	if v.config.GetPlatform() == EnsemblePlatform {
+		if len(v.config.GetInstanceGroup()) > 0 {
+			v.errorf("instance_group: ensemble must not have instance groups")
+		}
+
+		return
+	}
+
+	if len(v.config.GetInstanceGroup()) == 0 {
+		v.errorf("instance_group must not be empty")
+	}
+
+	names := make(map[string]struct{}, len(v.config.GetInstanceGroup()))
+	for i, group := range v.config.GetInstanceGroup() {
+		path := fmt.Sprintf("instance_group[%d]", i)
+		if _, ok := names[group.GetName()]; ok && group.GetName() != "" {
+			v.errorf("%s: duplicate instance group %s", path, group.GetName())
+		}
+
+		names[group.GetName()] = struct{}{}
+		if group.GetCount() < 1 {
+			v.errorf("%s: count %d must be greater than zero", path, group.GetCount())
+		}
+
+		// The instance group of KIND_GPU without gpus uses all the available GPUs like Triton.
+		if kind := group.GetKind(); len(group.GetGpus()) > 0 && (kind == inferencev1.ModelInstanceGroup_KIND_CPU || kind == inferencev1.ModelInstanceGroup_KIND_MODEL) {
+			v.errorf("%s: %s must not have gpus", path, kind)
+		}
+
+		for _, gpu := range group.GetGpus() {
+			if gpu < 0 {
+				v.errorf("%s: gpu %d must not be negative", path, gpu)
+			}
+		}
+	}
+}
+
+// validateDynamicBatching validates the dynamic batching.
+func (v *validator) validateDynamicBatching() {
+	batching := v.config.GetDynamicBatching()
+	if batching == nil {
+		return
+	}
+
+	if v.config.GetMaxBatchSize() == 0 {
+		v.errorf("dynamic_batching: requires max_batch_size greater than zero")
+	}
+
+	v.validatePreferredBatchSizes("dynamic_batching", batching.GetPreferredBatchSize())
+	if batching.GetPriorityLevels() == 0 {
+		if batching.GetDefaultPriorityLevel() != 0 || len(batching.GetPriorityQueuePolicy()) > 0 {
+			v.errorf("dynamic_batching: priority requires priority_levels greater than zero")
+		}
+
+		return
+	}
+
+	if batching.GetDefaultPriorityLevel() < 1 || batching.GetDefaultPriorityLevel() > batching.GetPriorityLevels() {
+		v.errorf("dynamic_batching: default_priority_level %d is not in [1, %d]", batching.GetDefaultPriorityLevel(), batching.GetPriorityLevels())
+	}
+
+	for level := range batching.GetPriorityQueuePolicy() {
+		if level < 1 || level > batching.GetPriorityLevels() {
+			v.errorf("dynamic_batching: priority_queue_policy level %d is not in [1, %d]", level, batching.GetPriorityLevels())
+		}
+	}
+}
+
+// validatePreferredBatchSizes validates the preferred batch sizes against the max batch size.
+func (v *validator) validatePreferredBatchSizes(path string, sizes []int32) {
+	for _, size := range sizes {
+		if size < 1 || size > v.config.GetMaxBatchSize() {
+			v.errorf("%s: preferred_batch_size %d is not in [1, %d]", path, size, v.config.GetMaxBatchSize())
+		}
+	}
+}
+
+// validateSequenceBatching validates the sequence batching, the control inputs are optional
+// like Triton, but every control must be well-formed and provided at most once.
+func (v *validator) validateSequenceBatching() {
+	batching := v.config.GetSequenceBatching()
+	if batching == nil {
+		return
+	}
+
+	switch {
+	case batching.GetOldest() != nil:
+		oldest := batching.GetOldest()
+		if oldest.GetMaxCandidateSequences() < 1 {
+			v.errorf("sequence_batching: oldest max_candidate_sequences must be greater than zero")
+		}
+
+		if v.config.GetMaxBatchSize() == 0 && len(oldest.GetPreferredBatchSize()) > 0 {
+			v.errorf("sequence_batching: oldest preferred_batch_size requires max_batch_size greater than zero")
+		} else {
+			v.validatePreferredBatchSizes("sequence_batching: oldest", oldest.GetPreferredBatchSize())
+		}
+	case batching.GetDirect() != nil:
+		utilization := batching.GetDirect().GetMinimumSlotUtilization()
+		if utilization < 0 || utilization > 1 {
+			v.errorf("sequence_batching: direct minimum_slot_utilization %g is not in [0, 1]", utilization)
+		}
+	default:
+		v.errorf("sequence_batching: strategy must be specified")
+	}
+
+	inputs := v.inputNames()
+	names := make(map[string]struct{}, len(batching.GetControlInput()))
+	kinds := make(map[inferencev1.ModelSequenceBatching_Control_Kind]struct{})
+	for i, controlInput := range batching.GetControlInput() {
+		path := fmt.Sprintf("sequence_batching: control_input[%d]", i)
+		if controlInput.GetName() == "" {
+			v.errorf("%s: name must not be empty", path)
+		} else if _, ok := names[controlInput.GetName()]; ok {
+			v.errorf("%s: duplicate control input %s", path, controlInput.GetName())
+		} else if _, ok := inputs[controlInput.GetName()]; ok {
+			v.errorf("%s: control input %s conflicts with the input of the model", path, controlInput.GetName())
+		}
+
+		names[controlInput.GetName()] = struct{}{}
+		if len(controlInput.GetControl()) != 1 {
+			v.errorf("%s: must have exactly one control", path)
+		}
+
+		for _, control := range controlInput.GetControl() {
+			if _, ok := kinds[control.GetKind()]; ok {
+				v.errorf("%s: duplicate control %s", path, control.GetKind())
+			}
+
+			kinds[control.GetKind()] = struct{}{}
+			v.validateControl(path, control)
+		}
+	}
+
+	states := make(map[string]struct{}, len(batching.GetState()))
+	for i, state := range batching.GetState() {
+		path := fmt.Sprintf("sequence_batching: state[%d]", i)
+		if state.GetInputName() == "" || state.GetOutputName() == "" {
+			v.errorf("%s: input_name and output_name must not be empty", path)
+		}
+
+		if _, ok := states[state.GetInputName()]; ok {
+			v.errorf("%s: duplicate state input %s", path, state.GetInputName())
+		}
+
+		states[state.GetInputName()] = struct{}{}
+		v.validateTensor(path, state.GetDataType(), state.GetDims(), nil)
+	}
+}
+
+// validateControl validates the control of the control input.
+func (v *validator) validateControl(path string, control *inferencev1.ModelSequenceBatching_Control) {
+	var specified int
+	for _, n := range []int{len(control.GetInt32FalseTrue()), len(control.GetFp32FalseTrue()), len(control.GetBoolFalseTrue())} {
+		if n > 0 {
+			specified++
+			if n != 2 {
+				v.errorf("%s: %s false/true values must have exactly two values", path, control.GetKind())
+			}
+		}
+	}
+
+	switch control.GetKind() {
+	case inferencev1.ModelSequenceBatching_Control_CONTROL_SEQUENCE_CORRID:
+		if specified > 0 {
+			v.errorf("%s: %s must not have false/true values", path, control.GetKind())
+		}
+
+		switch control.GetDataType() {
+		case inferencev1.DataType_TYPE_UINT64, inferencev1.DataType_TYPE_INT64, inferencev1.DataType_TYPE_UINT32,
+			inferencev1.DataType_TYPE_INT32, inferencev1.DataType_TYPE_STRING:
+		default:
+			v.errorf("%s: %s data_type %s is not supported", path, control.GetKind(), control.GetDataType())
+		}
+	default:
+		if specified != 1 {
+			v.errorf("%s: %s must have exactly one of int32_false_true, fp32_false_true and bool_false_true", path, control.GetKind())
+		}
+	}
+}
+
+// validateEnsemble validates the ensemble scheduling, every step input must be produced by an
+// ensemble input or a step output, every tensor must be produced once, every ensemble output
+// must be produced, every ensemble input must be consumed, and the steps must not form a cycle.
+func (v *validator) validateEnsemble() {
+	ensemble := v.config.GetEnsembleScheduling()
+	if v.config.GetPlatform() != EnsemblePlatform {
+		if ensemble != nil {
+			v.errorf("ensemble_scheduling: requires platform %s", EnsemblePlatform)
+		}
+
+		return
+	}
+
+	if ensemble == nil {
+		v.errorf("ensemble_scheduling must be specified for platform %s", EnsemblePlatform)
+		return
+	}
+
+	if len(ensemble.GetStep()) == 0 {
+		v.errorf("ensemble_scheduling: step must not be empty")
+		return
+	}
+
+	// producers is the step index by produced tensor, the ensemble inputs are produced by -1.
+	producers := make(map[string]int)
+	for name := range v.inputNames() {
+		producers[name] = -1
+	}
+
+	for i, step := range ensemble.GetStep() {
+		path := fmt.Sprintf("ensemble_scheduling: step[%d]", i)
+		if step.GetModelName() == "" {
+			v.errorf("%s: model_name must not be empty", path)
+		}
+
+		if step.GetModelVersion() < -1 || step.GetModelVersion() == 0 {
+			v.errorf("%s: model_version %d must be positive or -1", path, step.GetModelVersion())
+		}
+
+		if len(step.GetInputMap()) == 0 || len(step.GetOutputMap()) == 0 {
+			v.errorf("%s: input_map and output_map must not be empty", path)
+		}
+
+		for _, tensor := range sortedValues(step.GetOutputMap()) {
+			if producer, ok := producers[tensor]; ok {
+				if producer == -1 {
+					v.errorf("%s: tensor %s is an ensemble input and must not be produced", path, tensor)
+				} else {
+					v.errorf("%s: tensor %s is already produced by step[%d]", path, tensor, producer)
+				}
+
+				continue
+			}
+
+			producers[tensor] = i
+		}
+	}
+
+	consumed := make(map[string]struct{})
+	dependencies := make([][]int, len(ensemble.GetStep()))
+	for i, step := range ensemble.GetStep() {
+		for _, tensor := range sortedValues(step.GetInputMap()) {
+			consumed[tensor] = struct{}{}
+			producer, ok := producers[tensor]
+			if !ok {
+				v.errorf("ensemble_scheduling: step[%d]: tensor %s is not produced by an ensemble input or a step", i, tensor)
+				continue
+			}
+
+			if producer >= 0 {
+				dependencies[i] = append(dependencies[i], producer)
+			}
+		}
+	}
+
+	for _, output := range v.config.GetOutput() {
+		consumed[output.GetName()] = struct{}{}
+		if producer, ok := producers[output.GetName()]; !ok || producer == -1 {
+			v.errorf("ensemble_scheduling: output %s is not produced by a step", output.GetName())
+		}
+	}
+
+	for _, input := range v.config.GetInput() {
+		if _, ok := consumed[input.GetName()]; !ok {
+			v.errorf("ensemble_scheduling: input %s is not consumed by a step", input.GetName())
+		}
+	}
+
+	if cycle := findCycle(dependencies); cycle != nil {
+		v.errorf("ensemble_scheduling: steps %v form a cycle", cycle)
+	}
+}
+
+// validateWarmup validates the model warmups.
+func (v *validator) validateWarmup() {
+	inputs := v.inputNames()
+	for i, warmup := range v.config.GetModelWarmup() {
+		path := fmt.Sprintf("model_warmup[%d]", i)
+		if warmup.GetName() == "" {
+			v.errorf("%s: name must not be empty", path)
+		}
+
+		maxBatchSize := uint32(1)
+		if v.config.GetMaxBatchSize() > 0 {
+			maxBatchSize = uint32(v.config.GetMaxBatchSize())
+		}
+
+		if warmup.GetBatchSize() < 1 || warmup.GetBatchSize() > maxBatchSize {
+			v.errorf("%s: batch_size %d is not in [1, %d]", path, warmup.GetBatchSize(), maxBatchSize)
+		}
+
+		for _, name := range sortedKeys(warmup.GetInputs()) {
+			if _, ok := inputs[name]; !ok {
+				v.errorf("%s: input %s is not an input of the model", path, name)
+			}
+		}
+	}
+}
+
+// inputNames returns the names of the inputs.
+func (v *validator) inputNames() map[string]struct{} {
+	names := make(map[string]struct{}, len(v.config.GetInput()))
+	for _, input := range v.config.GetInput() {
+		names[input.GetName()] = struct{}{}
+	}
+
+	return names
+}
+
+// elementCount returns the element count of the fixed dims, and whether the dims are variable.
+func elementCount(dims []int64) (int64, bool) {
+	count, variable := int64(1), false
+	for _, dim := range dims {
+		if dim == -1 {
+			variable = true
+			continue
+		}
+
+		count *= dim
+	}
+
+	return count, variable
+}
+
+// sortedKeys returns the sorted keys of the map, so the errors are stable.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedValues returns the values of the map sorted by the keys, so the errors are stable.
+func sortedValues(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+	for _, key := range sortedKeys(m) {
+		values = append(values, m[key])
+	}
+
+	return values
+}
+
+// findCycle returns the steps of a cycle in the dependencies, or nil if there is no cycle.
+func findCycle(dependencies [][]int) []int {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	states := make([]int, len(dependencies))
+	var stack []int
+	var visit func(step int) []int
+	visit = func(step int) []int {
+		states[step] = visiting
+		stack = append(stack, step)
+		for _, dependency := range dependencies[step] {
+			switch states[dependency] {
+			case visiting:
+				for i, s := range stack {
+					if s == dependency {
+						return append([]int{}, stack[i:]...)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		states[step] = visited
+		return nil
+	}
+
+	for step := range dependencies {
+		if states[step] == unvisited {
+			if cycle := visit(step); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
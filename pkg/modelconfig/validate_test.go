@@ -0,0 +1,190 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modelconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+const testEnsembleText = `
+name: "ens"
+platform: "ensemble"
+max_batch_size: 8
+input [ { name: "RAW" data_type: TYPE_UINT8 dims: [ -1 ] } ]
+output [ { name: "SCORES" data_type: TYPE_FP32 dims: [ 4 ] } ]
+ensemble_scheduling {
+  step [
+    {
+      model_name: "pre"
+      model_version: -1
+      input_map { key: "raw" value: "RAW" }
+      output_map { key: "features" value: "FEATURES" }
+    },
+    {
+      model_name: "cls"
+      model_version: -1
+      input_map { key: "features" value: "FEATURES" }
+      output_map { key: "scores" value: "SCORES" }
+    }
+  ]
+}
+`
+
+// testEnsembleHeader is the ensemble without steps.
+const testEnsembleHeader = `
+name: "ens"
+platform: "ensemble"
+input [ { name: "RAW" data_type: TYPE_UINT8 dims: [ -1 ] } ]
+output [ { name: "SCORES" data_type: TYPE_FP32 dims: [ 4 ] } ]
+`
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   []string
+	}{
+		{
+			name:   "valid model",
+			config: testConfigText,
+		},
+		{
+			name:   "valid ensemble",
+			config: testEnsembleText,
+		},
+		{
+			name:   "missing name and platform",
+			config: `input [ { name: "x" data_type: TYPE_FP32 dims: [ 1 ] } ]`,
+			want:   []string{"name must not be empty", "platform or backend must be specified"},
+		},
+		{
+			name: "invalid tensors",
+			config: `name: "m" backend: "python"
+				input [ { name: "x" dims: [ 1 ] }, { name: "x" data_type: TYPE_FP32 dims: [ 0 ] } ]
+				output [ { name: "y" data_type: TYPE_FP32 } ]`,
+			want: []string{
+				"input[0]: data_type must be specified",
+				"input[1]: duplicate input x",
+				"input[1]: dim 0 must be positive or -1",
+				"output[0]: dims must not be empty",
+			},
+		},
+		{
+			name:   "ensemble without steps",
+			config: testEnsembleHeader,
+			want:   []string{"ensemble_scheduling must be specified for platform ensemble"},
+		},
+		{
+			name:   "ensemble scheduling without ensemble platform",
+			config: `name: "m" backend: "python" ensemble_scheduling {}`,
+			want:   []string{"ensemble_scheduling: requires platform ensemble"},
+		},
+		{
+			name: "invalid step",
+			config: testEnsembleHeader + `ensemble_scheduling { step [
+				{ input_map { key: "raw" value: "RAW" } output_map { key: "scores" value: "SCORES" } },
+				{ model_name: "m" model_version: -2 output_map { key: "y" value: "Y" } }
+			] }`,
+			want: []string{
+				"step[0]: model_name must not be empty",
+				"step[0]: model_version 0 must be positive or -1",
+				"step[1]: model_version -2 must be positive or -1",
+				"step[1]: input_map and output_map must not be empty",
+			},
+		},
+		{
+			name: "ensemble wiring",
+			config: testEnsembleHeader + `ensemble_scheduling { step [
+				{ model_name: "a" model_version: -1 input_map { key: "x" value: "UNKNOWN" } output_map { key: "y" value: "RAW" } },
+				{ model_name: "b" model_version: -1 input_map { key: "x" value: "UNKNOWN" } output_map { key: "y" value: "MID" } },
+				{ model_name: "c" model_version: -1 input_map { key: "x" value: "UNKNOWN" } output_map { key: "y" value: "MID" } }
+			] }`,
+			want: []string{
+				"step[0]: tensor RAW is an ensemble input and must not be produced",
+				"step[2]: tensor MID is already produced by step[1]",
+				"step[0]: tensor UNKNOWN is not produced by an ensemble input or a step",
+				"output SCORES is not produced by a step",
+				"input RAW is not consumed by a step",
+			},
+		},
+		{
+			name: "ensemble cycle",
+			config: testEnsembleHeader + `ensemble_scheduling { step [
+				{
+				  model_name: "a" model_version: -1
+				  input_map [ { key: "x" value: "RAW" }, { key: "y" value: "B" } ]
+				  output_map { key: "z" value: "A" }
+				},
+				{
+				  model_name: "b" model_version: -1
+				  input_map { key: "x" value: "A" }
+				  output_map [ { key: "y" value: "B" }, { key: "z" value: "SCORES" } ]
+				}
+			] }`,
+			want: []string{"ensemble_scheduling: steps [0 1] form a cycle"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := mustParse(t, tc.config)
+			SetDefaults(config)
+			err := Validate(config)
+			if len(tc.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Validate() error = nil, want %q", tc.want)
+			}
+
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("Validate() error = %v, want %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStableErrors(t *testing.T) {
+	config := mustParse(t, testEnsembleHeader+`ensemble_scheduling { step [
+		{
+		  model_name: "m" model_version: -1
+		  input_map [ { key: "c" value: "U3" }, { key: "a" value: "U1" }, { key: "b" value: "U2" } ]
+		  output_map { key: "y" value: "SCORES" }
+		}
+	] }`)
+	SetDefaults(config)
+
+	want := strings.Join([]string{
+		"ensemble_scheduling: step[0]: tensor U1 is not produced by an ensemble input or a step",
+		"ensemble_scheduling: step[0]: tensor U2 is not produced by an ensemble input or a step",
+		"ensemble_scheduling: step[0]: tensor U3 is not produced by an ensemble input or a step",
+		"ensemble_scheduling: input RAW is not consumed by a step",
+	}, "\n")
+	for i := 0; i < 10; i++ {
+		if err := Validate(config); err == nil || err.Error() != want {
+			t.Fatalf("Validate() error = %v, want %v", err, want)
+		}
+	}
+}
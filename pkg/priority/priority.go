@@ -0,0 +1,249 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package priority resolves the effective priority of common.v2.Download
+// by manager.v2.ApplicationPriority.
+//
+// The effective priority is resolved in the following order:
+//
+//  1. The priority of download, if it is not LEVEL0.
+//  2. The priority of the first url priority of the application whose regex matches the download url.
+//  3. The default priority of the application.
+//  4. LEVEL0.
+//
+// The levels of common.v2.Priority mean:
+//
+//   - LEVEL0 has no special meaning for scheduler.
+//   - LEVEL1 forbids the download, and an error code is returned during the registration.
+//   - LEVEL2 allows peers to download from the other peers but not back-to-source for the first time.
+//   - LEVEL3 triggers the normal peer to download back-to-source first for the first time.
+//   - LEVEL4 triggers the weak peer to download back-to-source first for the first time.
+//   - LEVEL5 triggers the strong peer to download back-to-source first for the first time.
+//   - LEVEL6 triggers the super peer to download back-to-source first for the first time.
+//
+// When the task is not downloaded for the first time, LEVEL2 to LEVEL6 are scheduled normally.
+//
+// The url regexes starting with a literal, e.g. ^https://example\.com/ or example\.com/, are
+// indexed by the literal, so only the regexes whose literal is in the download url are matched,
+// and thousands of url priorities are evaluated per download efficiently.
+package priority
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// urlPriority is the compiled url priority.
+type urlPriority struct {
+	// regex matches the download url.
+	regex *regexp.Regexp
+
+	// value is the priority of the matched url.
+	value commonv2.Priority
+}
+
+// applicationPriority is the compiled application priority.
+type applicationPriority struct {
+	// value is the default priority of application.
+	value commonv2.Priority
+
+	// urls is the url priorities in order of the configuration.
+	urls []urlPriority
+
+	// anchored indexes the url priorities by the literal prefix of the regexes
+	// which are anchored at the beginning of the url.
+	anchored *literalNode
+
+	// unanchored indexes the url priorities by the literal prefix of the regexes
+	// which match anywhere in the url.
+	unanchored *literalNode
+
+	// unindexed is the indexes of the url priorities without literal prefix in ascending order.
+	unindexed []int
+}
+
+// literalNode is the node of the trie of the literal prefixes.
+type literalNode struct {
+	// children is the child nodes by the next byte of the literals.
+	children map[byte]*literalNode
+
+	// indexes is the indexes of the url priorities whose literal ends at the node.
+	indexes []int
+}
+
+// insert inserts the index of the url priority with the literal.
+func (n *literalNode) insert(literal string, index int) {
+	for i := 0; i < len(literal); i++ {
+		child, ok := n.children[literal[i]]
+		if !ok {
+			child = &literalNode{children: make(map[byte]*literalNode)}
+			n.children[literal[i]] = child
+		}
+
+		n = child
+	}
+
+	n.indexes = append(n.indexes, index)
+}
+
+// collect appends the indexes of the url priorities whose literals are the prefixes of s.
+func (n *literalNode) collect(s string, indexes []int) []int {
+	for i := 0; ; i++ {
+		indexes = append(indexes, n.indexes...)
+		if i == len(s) {
+			return indexes
+		}
+
+		child, ok := n.children[s[i]]
+		if !ok {
+			return indexes
+		}
+
+		n = child
+	}
+}
+
+// literalPrefix returns the case-sensitive literal which every match of the regex starts with,
+// and whether the regex is anchored at the beginning of the text.
+func literalPrefix(regex string) (string, bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+
+	anchored := len(subs) > 0 && subs[0].Op == syntax.OpBeginText
+	if anchored {
+		subs = subs[1:]
+	}
+
+	var prefix strings.Builder
+	for _, sub := range subs {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+
+		prefix.WriteString(string(sub.Rune))
+	}
+
+	return prefix.String(), anchored
+}
+
+// match returns the priority of the first url priority whose regex matches the url.
+func (p *applicationPriority) match(url string) (commonv2.Priority, bool) {
+	indexes := p.anchored.collect(url, nil)
+	for i := range url {
+		indexes = p.unanchored.collect(url[i:], indexes)
+	}
+	sort.Ints(indexes)
+
+	// Merge the indexed and the unindexed url priorities in order of the configuration.
+	i, j := 0, 0
+	for i < len(indexes) || j < len(p.unindexed) {
+		var index int
+		if j >= len(p.unindexed) || (i < len(indexes) && indexes[i] < p.unindexed[j]) {
+			index = indexes[i]
+			i++
+
+			// The literal may occur more than once in the url.
+			if i > 1 && indexes[i-2] == index {
+				continue
+			}
+		} else {
+			index = p.unindexed[j]
+			j++
+		}
+
+		if p.urls[index].regex.MatchString(url) {
+			return p.urls[index].value, true
+		}
+	}
+
+	return commonv2.Priority_LEVEL0, false
+}
+
+// Evaluator resolves the effective priority of download, it is safe for concurrent use.
+type Evaluator struct {
+	// applications is the compiled priorities by application name.
+	applications map[string]*applicationPriority
+}
+
+// New returns the evaluator of the applications, the regexes of the url priorities are compiled once.
+func New(resp *managerv2.ListApplicationsResponse) (*Evaluator, error) {
+	e := &Evaluator{applications: make(map[string]*applicationPriority, len(resp.GetApplications()))}
+	for _, application := range resp.GetApplications() {
+		priority := application.GetPriority()
+		if priority == nil {
+			continue
+		}
+
+		p := &applicationPriority{
+			value:      priority.GetValue(),
+			urls:       make([]urlPriority, 0, len(priority.GetUrls())),
+			anchored:   &literalNode{children: make(map[byte]*literalNode)},
+			unanchored: &literalNode{children: make(map[byte]*literalNode)},
+		}
+
+		for i, url := range priority.GetUrls() {
+			regex, err := regexp.Compile(url.GetRegex())
+			if err != nil {
+				return nil, fmt.Errorf("application %s: invalid url regex %s: %w", application.GetName(), url.GetRegex(), err)
+			}
+
+			p.urls = append(p.urls, urlPriority{regex: regex, value: url.GetValue()})
+			switch prefix, anchored := literalPrefix(url.GetRegex()); {
+			case prefix == "":
+				p.unindexed = append(p.unindexed, i)
+			case anchored:
+				p.anchored.insert(prefix, i)
+			default:
+				p.unanchored.insert(prefix, i)
+			}
+		}
+
+		e.applications[application.GetName()] = p
+	}
+
+	return e, nil
+}
+
+// Evaluate returns the effective priority of download.
+func (e *Evaluator) Evaluate(download *commonv2.Download) commonv2.Priority {
+	if download.GetPriority() != commonv2.Priority_LEVEL0 {
+		return download.GetPriority()
+	}
+
+	p, ok := e.applications[download.GetApplication()]
+	if !ok {
+		return commonv2.Priority_LEVEL0
+	}
+
+	if value, ok := p.match(download.GetUrl()); ok {
+		return value
+	}
+
+	return p.value
+}
@@ -0,0 +1,141 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package priority
+
+import (
+	"fmt"
+	"testing"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+)
+
+// newResponse returns the response of the application with the url priorities.
+func newResponse(value commonv2.Priority, urls ...*managerv2.URLPriority) *managerv2.ListApplicationsResponse {
+	return &managerv2.ListApplicationsResponse{
+		Applications: []*managerv2.Application{
+			{
+				Name: "foo",
+				Priority: &managerv2.ApplicationPriority{
+					Value: value,
+					Urls:  urls,
+				},
+			},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	e, err := New(newResponse(commonv2.Priority_LEVEL2,
+		&managerv2.URLPriority{Regex: `^https://example\.com/foo/bar/`, Value: commonv2.Priority_LEVEL6},
+		&managerv2.URLPriority{Regex: `\.iso$`, Value: commonv2.Priority_LEVEL5},
+		&managerv2.URLPriority{Regex: `^https://example\.com/foo/`, Value: commonv2.Priority_LEVEL4},
+		&managerv2.URLPriority{Regex: `(?i)^HTTPS://EXAMPLE\.COM/`, Value: commonv2.Priority_LEVEL3},
+		&managerv2.URLPriority{Regex: `^https://example\.com/foo/baz`, Value: commonv2.Priority_LEVEL1},
+	))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		download *commonv2.Download
+		want     commonv2.Priority
+	}{
+		{
+			name:     "download priority",
+			download: &commonv2.Download{Application: "foo", Url: "https://example.com/foo/bar/a", Priority: commonv2.Priority_LEVEL1},
+			want:     commonv2.Priority_LEVEL1,
+		},
+		{
+			name:     "first prefixed url",
+			download: &commonv2.Download{Application: "foo", Url: "https://example.com/foo/bar/a.iso"},
+			want:     commonv2.Priority_LEVEL6,
+		},
+		{
+			name:     "unprefixed url before prefixed url",
+			download: &commonv2.Download{Application: "foo", Url: "https://example.com/foo/a.iso"},
+			want:     commonv2.Priority_LEVEL5,
+		},
+		{
+			name:     "shorter prefixed url",
+			download: &commonv2.Download{Application: "foo", Url: "https://example.com/foo/baz"},
+			want:     commonv2.Priority_LEVEL4,
+		},
+		{
+			name:     "case insensitive url",
+			download: &commonv2.Download{Application: "foo", Url: "https://EXAMPLE.com/bar"},
+			want:     commonv2.Priority_LEVEL3,
+		},
+		{
+			name:     "application priority",
+			download: &commonv2.Download{Application: "foo", Url: "https://example.org/foo"},
+			want:     commonv2.Priority_LEVEL2,
+		},
+		{
+			name:     "unknown application",
+			download: &commonv2.Download{Application: "bar", Url: "https://example.com/foo/bar/a"},
+			want:     commonv2.Priority_LEVEL0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := e.Evaluate(tc.download); got != tc.want {
+				t.Fatalf("Evaluate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	for _, bc := range []struct {
+		name  string
+		regex string
+	}{
+		{name: "prefixed", regex: `^https://registry-%d\.example\.com/library/.*`},
+		{name: "unprefixed", regex: `registry-%d\.example\.com/library/`},
+	} {
+		for _, count := range []int{1000, 5000} {
+			urls := make([]*managerv2.URLPriority, 0, count)
+			for i := 0; i < count; i++ {
+				urls = append(urls, &managerv2.URLPriority{Regex: fmt.Sprintf(bc.regex, i), Value: commonv2.Priority_LEVEL3})
+			}
+
+			e, err := New(newResponse(commonv2.Priority_LEVEL2, urls...))
+			if err != nil {
+				b.Fatalf("New() error = %v", err)
+			}
+
+			for _, download := range []struct {
+				name string
+				url  string
+			}{
+				{name: "last", url: fmt.Sprintf("https://registry-%d.example.com/library/alpine/blobs/sha256:0", count-1)},
+				{name: "none", url: "https://registry.example.org/library/alpine/blobs/sha256:0"},
+			} {
+				b.Run(fmt.Sprintf("%s/%d/%s", bc.name, count, download.name), func(b *testing.B) {
+					download := &commonv2.Download{Application: "foo", Url: download.url}
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						e.Evaluate(download)
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+)
+
+// serviceWildcard is the method name which matches all methods of the service.
+const serviceWildcard = "*"
+
+// Authorizer authorizes the rpc methods by the identity of the peer certificate.
+type Authorizer struct {
+	// trustDomain is the trust domain of the identity uri.
+	trustDomain string
+
+	// methods is the allowed components by full method name.
+	methods map[string]map[securityv1.ComponentType]struct{}
+}
+
+// NewAuthorizer returns the authorizer of the policies, the rules of all policies are merged.
+func NewAuthorizer(trustDomain string, policies ...*securityv1.AuthorizationPolicy) (*Authorizer, error) {
+	a := &Authorizer{
+		trustDomain: trustDomain,
+		methods:     make(map[string]map[securityv1.ComponentType]struct{}),
+	}
+
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid authorization policy: %w", err)
+		}
+
+		for _, rule := range policy.GetRules() {
+			for _, method := range rule.GetMethods() {
+				components, ok := a.methods[method]
+				if !ok {
+					components = make(map[securityv1.ComponentType]struct{}, len(rule.GetComponents()))
+					a.methods[method] = components
+				}
+
+				for _, component := range rule.GetComponents() {
+					components[component] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return a, nil
+}
+
+// Authorize authorizes the peer of the context to call the full method, it returns the grpc status
+// error with Unauthenticated code if the peer has no identity, and PermissionDenied code if the
+// identity is not allowed.
+func (a *Authorizer) Authorize(ctx context.Context, fullMethod string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "peer not found")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return status.Error(codes.Unauthenticated, "peer certificate not found")
+	}
+
+	identity, err := IdentityFromCertificate(a.trustDomain, tlsInfo.State.PeerCertificates[0])
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid peer certificate: %s", err.Error())
+	}
+
+	if !a.allowed(fullMethod, identity.GetComponent()) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", ComponentName(identity.GetComponent()), fullMethod)
+	}
+
+	return nil
+}
+
+// allowed returns whether the component is allowed to call the full method.
+func (a *Authorizer) allowed(fullMethod string, component securityv1.ComponentType) bool {
+	if _, ok := a.methods[fullMethod][component]; ok {
+		return true
+	}
+
+	i := strings.LastIndex(fullMethod, "/")
+	if i < 0 {
+		return false
+	}
+
+	_, ok := a.methods[fullMethod[:i+1]+serviceWildcard][component]
+	return ok
+}
+
+// UnaryServerInterceptor returns the unary server interceptor which authorizes the rpc methods.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.Authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the stream server interceptor which authorizes the rpc methods.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.Authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
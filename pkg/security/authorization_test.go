@@ -0,0 +1,214 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	dfdaemonv1 "d7y.io/api/v2/pkg/apis/dfdaemon/v1"
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+	managerv1 "d7y.io/api/v2/pkg/apis/manager/v1"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+	schedulerv1 "d7y.io/api/v2/pkg/apis/scheduler/v1"
+	schedulerv2 "d7y.io/api/v2/pkg/apis/scheduler/v2"
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+)
+
+// bufSize is the buffer size of the bufconn listener.
+const bufSize = 1024 * 1024
+
+// serverIdentity is the identity of the test server which serves all services.
+var serverIdentity = &securityv1.Identity{Component: managerComponent, ClusterId: 1, Hostname: "server"}
+
+// newTestServer serves the unimplemented services of manager v1/v2, certificate, scheduler v1/v2 and dfdaemon v1/v2
+// with the default policies by bufconn, the server uses mTLS if the rotator is not nil.
+func newTestServer(t *testing.T, rotator *Rotator) *bufconn.Listener {
+	t.Helper()
+	authorizer, err := NewAuthorizer(DefaultTrustDomain, DefaultManagerPolicy(), DefaultSchedulerPolicy(), DefaultDfdaemonPolicy())
+	if err != nil {
+		t.Fatalf("NewAuthorizer() error = %v", err)
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authorizer.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(authorizer.StreamServerInterceptor()),
+	}
+
+	if rotator != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(rotator.ServerTLSConfig())))
+	}
+
+	s := grpc.NewServer(options...)
+	managerv1.RegisterManagerServer(s, &managerv1.UnimplementedManagerServer{})
+	managerv2.RegisterManagerServer(s, &managerv2.UnimplementedManagerServer{})
+	securityv1.RegisterCertificateServer(s, &securityv1.UnimplementedCertificateServer{})
+	schedulerv1.RegisterSchedulerServer(s, &schedulerv1.UnimplementedSchedulerServer{})
+	schedulerv2.RegisterSchedulerServer(s, &schedulerv2.UnimplementedSchedulerServer{})
+	dfdaemonv1.RegisterDaemonServer(s, &dfdaemonv1.UnimplementedDaemonServer{})
+	dfdaemonv2.RegisterDfdaemonServer(s, &dfdaemonv2.UnimplementedDfdaemonServer{})
+
+	lis := bufconn.Listen(bufSize)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis
+}
+
+// dial returns the client connection to the bufconn listener.
+func dial(t *testing.T, lis *bufconn.Listener, creds credentials.TransportCredentials) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// call calls the method with the empty message, and returns the status code.
+func call(t *testing.T, conn *grpc.ClientConn, method string, stream bool) codes.Code {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if !stream {
+		return status.Code(conn.Invoke(ctx, method, &emptypb.Empty{}, &emptypb.Empty{}))
+	}
+
+	s, err := conn.NewStream(ctx, &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}, method)
+	if err != nil {
+		return status.Code(err)
+	}
+
+	// The server-streaming handlers receive the request before calling the service.
+	if err := s.SendMsg(&emptypb.Empty{}); err != nil {
+		return status.Code(err)
+	}
+
+	if err := s.CloseSend(); err != nil {
+		return status.Code(err)
+	}
+
+	return status.Code(s.RecvMsg(&emptypb.Empty{}))
+}
+
+func TestAuthorizerDefaultPolicies(t *testing.T) {
+	ca := newTestCA(t)
+	lis := newTestServer(t, newTestRotator(t, ca, serverIdentity))
+
+	conns := make(map[securityv1.ComponentType]*grpc.ClientConn)
+	for _, component := range []securityv1.ComponentType{peerComponent, seedPeerComponent, schedulerComponent, managerComponent, trainerComponent} {
+		r := newTestRotator(t, ca, &securityv1.Identity{Component: component, ClusterId: 1, Hostname: ComponentName(component)})
+		conns[component] = dial(t, lis, credentials.NewTLS(r.ClientTLSConfig(serverIdentity)))
+	}
+
+	tests := []struct {
+		name      string
+		method    string
+		stream    bool
+		component securityv1.ComponentType
+		code      codes.Code
+	}{
+		// The allowed calls reach the unimplemented services.
+		{name: "manager v1 unary allowed", method: "/manager.Manager/UpdateScheduler", component: schedulerComponent, code: codes.Unimplemented},
+		{name: "manager v1 unary denied", method: "/manager.Manager/UpdateScheduler", component: peerComponent, code: codes.PermissionDenied},
+		{name: "manager v1 stream allowed", method: "/manager.Manager/KeepAlive", stream: true, component: seedPeerComponent, code: codes.Unimplemented},
+		{name: "manager v1 stream denied", method: "/manager.Manager/KeepAlive", stream: true, component: peerComponent, code: codes.PermissionDenied},
+		{name: "manager v2 unary allowed", method: "/manager.v2.Manager/DeleteSeedPeer", component: seedPeerComponent, code: codes.Unimplemented},
+		{name: "manager v2 unary denied", method: "/manager.v2.Manager/DeleteSeedPeer", component: peerComponent, code: codes.PermissionDenied},
+		{name: "manager v2 stream allowed", method: "/manager.v2.Manager/KeepAlive", stream: true, component: schedulerComponent, code: codes.Unimplemented},
+		{name: "manager v2 stream denied", method: "/manager.v2.Manager/KeepAlive", stream: true, component: trainerComponent, code: codes.PermissionDenied},
+		{name: "download token signing key allowed", method: "/manager.v2.Manager/GetDownloadTokenSigningKey", component: schedulerComponent, code: codes.Unimplemented},
+		{name: "download token signing key denied", method: "/manager.v2.Manager/GetDownloadTokenSigningKey", component: peerComponent, code: codes.PermissionDenied},
+		{name: "download token keys allowed", method: "/manager.v2.Manager/ListDownloadTokenKeys", component: peerComponent, code: codes.Unimplemented},
+		{name: "issue certificate allowed", method: "/security.Certificate/IssueCertificate", component: peerComponent, code: codes.Unimplemented},
+		{name: "renew certificate allowed", method: "/security.Certificate/RenewCertificate", component: trainerComponent, code: codes.Unimplemented},
+		{name: "get ca bundle allowed", method: "/security.Certificate/GetCABundle", component: seedPeerComponent, code: codes.Unimplemented},
+		{name: "revoke certificate allowed", method: "/security.Certificate/RevokeCertificate", component: managerComponent, code: codes.Unimplemented},
+		{name: "revoke certificate denied", method: "/security.Certificate/RevokeCertificate", component: schedulerComponent, code: codes.PermissionDenied},
+		{name: "scheduler v1 unary allowed", method: "/scheduler.Scheduler/RegisterPeerTask", component: peerComponent, code: codes.Unimplemented},
+		{name: "scheduler v1 unary denied", method: "/scheduler.Scheduler/RegisterPeerTask", component: trainerComponent, code: codes.PermissionDenied},
+		{name: "scheduler v1 stream allowed", method: "/scheduler.Scheduler/ReportPieceResult", stream: true, component: seedPeerComponent, code: codes.Unimplemented},
+		{name: "scheduler v1 stream denied", method: "/scheduler.Scheduler/ReportPieceResult", stream: true, component: schedulerComponent, code: codes.PermissionDenied},
+		{name: "scheduler v2 unary allowed", method: "/scheduler.v2.Scheduler/ListTasks", component: managerComponent, code: codes.Unimplemented},
+		{name: "scheduler v2 unary denied", method: "/scheduler.v2.Scheduler/ListTasks", component: peerComponent, code: codes.PermissionDenied},
+		{name: "scheduler v2 stream allowed", method: "/scheduler.v2.Scheduler/AnnouncePeer", stream: true, component: peerComponent, code: codes.Unimplemented},
+		{name: "scheduler v2 stream denied", method: "/scheduler.v2.Scheduler/AnnouncePeer", stream: true, component: managerComponent, code: codes.PermissionDenied},
+		{name: "dfdaemon v1 unary allowed", method: "/dfdaemon.Daemon/CheckHealth", component: trainerComponent, code: codes.Unimplemented},
+		{name: "dfdaemon v1 unary denied", method: "/dfdaemon.Daemon/DeleteTask", component: seedPeerComponent, code: codes.PermissionDenied},
+		{name: "dfdaemon v1 stream allowed", method: "/dfdaemon.Daemon/Download", stream: true, component: schedulerComponent, code: codes.Unimplemented},
+		{name: "dfdaemon v1 stream denied", method: "/dfdaemon.Daemon/Download", stream: true, component: seedPeerComponent, code: codes.PermissionDenied},
+		{name: "dfdaemon v2 unary allowed", method: "/dfdaemon.v2.Dfdaemon/DeleteTask", component: peerComponent, code: codes.Unimplemented},
+		{name: "dfdaemon v2 unary denied", method: "/dfdaemon.v2.Dfdaemon/DeleteTask", component: managerComponent, code: codes.PermissionDenied},
+		{name: "dfdaemon v2 stream allowed", method: "/dfdaemon.v2.Dfdaemon/TriggerDownloadTask", stream: true, component: schedulerComponent, code: codes.Unimplemented},
+		{name: "dfdaemon v2 stream denied", method: "/dfdaemon.v2.Dfdaemon/TriggerDownloadTask", stream: true, component: peerComponent, code: codes.PermissionDenied},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if code := call(t, conns[tc.component], tc.method, tc.stream); code != tc.code {
+				t.Fatalf("%s called by %s returns %v, want %v", tc.method, ComponentName(tc.component), code, tc.code)
+			}
+		})
+	}
+}
+
+func TestAuthorizerMissingIdentity(t *testing.T) {
+	ca := newTestCA(t)
+
+	// The certificate has no identity uri.
+	anonymous := newTestRotator(t, ca, nil)
+	tlsConn := dial(t, newTestServer(t, newTestRotator(t, ca, serverIdentity)), credentials.NewTLS(anonymous.ClientTLSConfig(serverIdentity)))
+
+	// The connection has no peer certificate.
+	insecureConn := dial(t, newTestServer(t, nil), insecure.NewCredentials())
+
+	for _, tc := range []struct {
+		name string
+		conn *grpc.ClientConn
+	}{
+		{name: "certificate without identity", conn: tlsConn},
+		{name: "insecure", conn: insecureConn},
+	} {
+		for _, stream := range []bool{false, true} {
+			method := "/scheduler.v2.Scheduler/StatTask"
+			if stream {
+				method = "/scheduler.v2.Scheduler/AnnouncePeer"
+			}
+
+			if code := call(t, tc.conn, method, stream); code != codes.Unauthenticated {
+				t.Fatalf("%s: %s returns %v, want %v", tc.name, method, code, codes.Unauthenticated)
+			}
+		}
+	}
+}
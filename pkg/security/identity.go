@@ -0,0 +1,123 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package security provides the helpers of security.Certificate, including the SPIFFE-style
+// identity of the dragonfly component and the tls.Config with the auto-rotating certificate.
+package security
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+)
+
+const (
+	// SPIFFEScheme is the scheme of the identity uri.
+	SPIFFEScheme = "spiffe"
+
+	// DefaultTrustDomain is the default trust domain of the identity uri.
+	DefaultTrustDomain = "d7y.io"
+)
+
+// ErrIdentityNotFound is returned when the certificate has no identity uri of the trust domain.
+var ErrIdentityNotFound = errors.New("identity not found")
+
+// componentNames is the path segment of the component type in the identity uri.
+var componentNames = map[securityv1.ComponentType]string{
+	securityv1.ComponentType_PEER_COMPONENT:      "peer",
+	securityv1.ComponentType_SEED_PEER_COMPONENT: "seed-peer",
+	securityv1.ComponentType_SCHEDULER_COMPONENT: "scheduler",
+	securityv1.ComponentType_MANAGER_COMPONENT:   "manager",
+	securityv1.ComponentType_TRAINER_COMPONENT:   "trainer",
+}
+
+// ComponentName returns the name of the component type, for example seed-peer.
+func ComponentName(component securityv1.ComponentType) string {
+	return componentNames[component]
+}
+
+// ParseComponentName parses the name of the component type.
+func ParseComponentName(name string) (securityv1.ComponentType, error) {
+	for component, componentName := range componentNames {
+		if componentName == name {
+			return component, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid component name %s", name)
+}
+
+// IdentityURI returns the SPIFFE-style uri of the identity,
+// for example spiffe://d7y.io/cluster/1/scheduler/hostname.
+func IdentityURI(trustDomain string, identity *securityv1.Identity) *url.URL {
+	return &url.URL{
+		Scheme: SPIFFEScheme,
+		Host:   trustDomain,
+		Path: "/" + strings.Join([]string{
+			"cluster",
+			strconv.FormatUint(identity.GetClusterId(), 10),
+			ComponentName(identity.GetComponent()),
+			identity.GetHostname(),
+		}, "/"),
+	}
+}
+
+// ParseIdentityURI parses the SPIFFE-style uri of the identity, and returns the trust domain and the identity.
+func ParseIdentityURI(u *url.URL) (string, *securityv1.Identity, error) {
+	if u.Scheme != SPIFFEScheme || u.Host == "" {
+		return "", nil, fmt.Errorf("invalid identity uri %s", u)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(segments) != 4 || segments[0] != "cluster" || segments[3] == "" {
+		return "", nil, fmt.Errorf("invalid identity uri path %s", u.Path)
+	}
+
+	clusterID, err := strconv.ParseUint(segments[1], 10, 64)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid cluster id of identity uri %s: %w", u, err)
+	}
+
+	component, err := ParseComponentName(segments[2])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return u.Host, &securityv1.Identity{
+		Component: component,
+		ClusterId: clusterID,
+		Hostname:  segments[3],
+	}, nil
+}
+
+// IdentityFromCertificate returns the identity of the certificate whose uri belongs to the trust domain.
+func IdentityFromCertificate(trustDomain string, cert *x509.Certificate) (*securityv1.Identity, error) {
+	for _, u := range cert.URIs {
+		domain, identity, err := ParseIdentityURI(u)
+		if err != nil || domain != trustDomain {
+			continue
+		}
+
+		return identity, nil
+	}
+
+	return nil, ErrIdentityNotFound
+}
@@ -0,0 +1,167 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	cdnsystemv1 "d7y.io/api/v2/pkg/apis/cdnsystem/v1"
+	dfdaemonv1 "d7y.io/api/v2/pkg/apis/dfdaemon/v1"
+	dfdaemonv2 "d7y.io/api/v2/pkg/apis/dfdaemon/v2"
+	managerv1 "d7y.io/api/v2/pkg/apis/manager/v1"
+	managerv2 "d7y.io/api/v2/pkg/apis/manager/v2"
+	schedulerv1 "d7y.io/api/v2/pkg/apis/scheduler/v1"
+	schedulerv2 "d7y.io/api/v2/pkg/apis/scheduler/v2"
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+	trainerv1 "d7y.io/api/v2/pkg/apis/trainer/v1"
+)
+
+// Shortcuts of the component types.
+const (
+	peerComponent      = securityv1.ComponentType_PEER_COMPONENT
+	seedPeerComponent  = securityv1.ComponentType_SEED_PEER_COMPONENT
+	schedulerComponent = securityv1.ComponentType_SCHEDULER_COMPONENT
+	managerComponent   = securityv1.ComponentType_MANAGER_COMPONENT
+	trainerComponent   = securityv1.ComponentType_TRAINER_COMPONENT
+)
+
+// methods returns the full method names of the service.
+func methods(serviceName string, names ...string) []string {
+	fullMethods := make([]string, 0, len(names))
+	for _, name := range names {
+		fullMethods = append(fullMethods, "/"+serviceName+"/"+name)
+	}
+
+	return fullMethods
+}
+
+// rule returns the authorization rule of the components and the methods.
+func rule(components []securityv1.ComponentType, methods ...[]string) *securityv1.AuthorizationRule {
+	r := &securityv1.AuthorizationRule{Components: components}
+	for _, m := range methods {
+		r.Methods = append(r.Methods, m...)
+	}
+
+	return r
+}
+
+// DefaultManagerPolicy returns the default authorization policy of manager.Manager, manager.v2.Manager
+// and security.Certificate which is served by the manager. The first IssueCertificate is called
+// without the certificate, so it must be served without the authorizer, e.g. by the bootstrap listener.
+func DefaultManagerPolicy() *securityv1.AuthorizationPolicy {
+	v1 := managerv1.Manager_ServiceDesc.ServiceName
+	v2 := managerv2.Manager_ServiceDesc.ServiceName
+	certificate := securityv1.Certificate_ServiceDesc.ServiceName
+
+	return &securityv1.AuthorizationPolicy{
+		Rules: []*securityv1.AuthorizationRule{
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent, schedulerComponent},
+				methods(v1, "ListSchedulers", "GetObjectStorage", "ListBuckets"),
+				methods(v2, "ListSchedulers", "GetObjectStorage", "ListBuckets", "GetQuota", "ListDownloadTokenKeys"),
+			),
+			rule([]securityv1.ComponentType{seedPeerComponent},
+				methods(v1, "GetSeedPeer", "UpdateSeedPeer", "KeepAlive"),
+				methods(v2, "GetSeedPeer", "UpdateSeedPeer", "DeleteSeedPeer", "KeepAlive"),
+			),
+			rule([]securityv1.ComponentType{schedulerComponent},
+				methods(v1, "GetScheduler", "UpdateScheduler", "ListApplications", "KeepAlive"),
+				methods(v2, "GetScheduler", "UpdateScheduler", "ListApplications", "AuthorizeApplication", "GetDownloadTokenSigningKey", "ReportOnlineEvaluation", "KeepAlive"),
+			),
+			rule([]securityv1.ComponentType{schedulerComponent, trainerComponent},
+				methods(v1, "CreateModel"),
+				methods(v2, "CreateModel", "ListModels", "GetModel"),
+			),
+			rule([]securityv1.ComponentType{trainerComponent},
+				methods(v2, "ActivateModel", "RollbackModel", "EvaluateModel"),
+			),
+			rule([]securityv1.ComponentType{peerComponent, schedulerComponent},
+				methods(v2, "GetModelArtifact", "ListModelArtifacts"),
+			),
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent, schedulerComponent, managerComponent, trainerComponent},
+				methods(certificate, "IssueCertificate", "RenewCertificate", "GetCABundle"),
+			),
+			rule([]securityv1.ComponentType{managerComponent},
+				methods(certificate, "RevokeCertificate"),
+			),
+		},
+	}
+}
+
+// DefaultSchedulerPolicy returns the default authorization policy of scheduler.Scheduler and scheduler.v2.Scheduler.
+func DefaultSchedulerPolicy() *securityv1.AuthorizationPolicy {
+	v1 := schedulerv1.Scheduler_ServiceDesc.ServiceName
+	v2 := schedulerv2.Scheduler_ServiceDesc.ServiceName
+
+	return &securityv1.AuthorizationPolicy{
+		Rules: []*securityv1.AuthorizationRule{
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent},
+				methods(v1, "RegisterPeerTask", "ReportPieceResult", "ReportPeerResult", "AnnounceTask", "LeaveTask", "AnnounceHost", "LeaveHost", "SyncProbes"),
+				methods(v2, "AnnouncePeer", "LeavePeer", "ExchangePeer", "AnnounceHost", "LeaveHost", "SyncProbes"),
+			),
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent, managerComponent},
+				methods(v1, "StatTask"),
+				methods(v2, "StatPeer", "StatTask"),
+			),
+			rule([]securityv1.ComponentType{managerComponent, trainerComponent},
+				methods(v2, "ListTasks", "ListTaskPeers", "ListHostPeers", "ListProbes", "StatProbe", "ListProbeEdges"),
+			),
+		},
+	}
+}
+
+// DefaultDfdaemonPolicy returns the default authorization policy of dfdaemon.Daemon, dfdaemon.v2.Dfdaemon
+// and cdnsystem.Seeder which is served by the seed peer.
+func DefaultDfdaemonPolicy() *securityv1.AuthorizationPolicy {
+	v1 := dfdaemonv1.Daemon_ServiceDesc.ServiceName
+	v2 := dfdaemonv2.Dfdaemon_ServiceDesc.ServiceName
+	seeder := cdnsystemv1.Seeder_ServiceDesc.ServiceName
+
+	return &securityv1.AuthorizationPolicy{
+		Rules: []*securityv1.AuthorizationRule{
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent},
+				methods(v1, "GetPieceTasks", "SyncPieceTasks"),
+				methods(v2, "SyncPieces"),
+				methods(seeder, "GetPieceTasks", "SyncPieceTasks"),
+			),
+			rule([]securityv1.ComponentType{peerComponent, schedulerComponent},
+				methods(v1, "Download", "StatTask", "ImportTask", "ExportTask", "DeleteTask"),
+				methods(v2, "DownloadTask", "UploadTask", "StatTask", "DeleteTask"),
+			),
+			rule([]securityv1.ComponentType{schedulerComponent},
+				methods(v2, "TriggerDownloadTask"),
+				methods(seeder, "ObtainSeeds"),
+			),
+			rule([]securityv1.ComponentType{peerComponent, seedPeerComponent, schedulerComponent, managerComponent, trainerComponent},
+				methods(v1, "CheckHealth"),
+			),
+		},
+	}
+}
+
+// DefaultTrainerPolicy returns the default authorization policy of trainer.Trainer.
+func DefaultTrainerPolicy() *securityv1.AuthorizationPolicy {
+	v1 := trainerv1.Trainer_ServiceDesc.ServiceName
+
+	return &securityv1.AuthorizationPolicy{
+		Rules: []*securityv1.AuthorizationRule{
+			rule([]securityv1.ComponentType{schedulerComponent},
+				methods(v1, "Train", "CreateTrainingJobs", "UploadDataset"),
+			),
+			rule([]securityv1.ComponentType{schedulerComponent, managerComponent},
+				methods(v1, "GetTrainingJob", "ListTrainingJobs"),
+			),
+		},
+	}
+}
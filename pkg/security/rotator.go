@@ -0,0 +1,508 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+)
+
+const (
+	// DefaultValidityPeriod is the default validity period of the requested certificate.
+	DefaultValidityPeriod = 24 * time.Hour
+
+	// DefaultRenewRatio is the default ratio of the elapsed validity period to renew the certificate.
+	DefaultRenewRatio = 0.7
+
+	// minRetryInterval is the minimum interval of retrying to renew the certificate.
+	minRetryInterval = time.Second
+
+	// maxRetryInterval is the maximum interval of retrying to renew the certificate.
+	maxRetryInterval = time.Minute
+
+	// alpnProtoH2 is the ALPN protocol of grpc, which is required by the grpc servers and clients.
+	alpnProtoH2 = "h2"
+)
+
+var (
+	// ErrCertificateRevoked is returned when the peer certificate is revoked.
+	ErrCertificateRevoked = errors.New("certificate is revoked")
+
+	// ErrCertificateNotReady is returned when the certificate is not issued before the handshake,
+	// i.e. Rotator.Start is not called or fails.
+	ErrCertificateNotReady = errors.New("certificate is not ready")
+
+	// ErrUnexpectedIdentity is returned when the identity of the server certificate is not the expected one.
+	ErrUnexpectedIdentity = errors.New("unexpected server identity")
+
+	// ErrRotatorStarted is returned when Rotator.Start is called after the rotator is started.
+	ErrRotatorStarted = errors.New("rotator is already started")
+)
+
+// Rotator maintains the certificate issued by security.Certificate, and renews it before expiry.
+// The tls.Config returned by Rotator always uses the latest certificate and CA bundle.
+type Rotator struct {
+	// client is the client of security.Certificate.
+	client securityv1.CertificateClient
+
+	// trustDomain is the trust domain of the identity uri.
+	trustDomain string
+
+	// identity is the identity of the component, nil represents no identity is requested.
+	identity *securityv1.Identity
+
+	// validityPeriod is the requested validity period of the certificate.
+	validityPeriod time.Duration
+
+	// renewRatio is the ratio of the elapsed validity period to renew the certificate.
+	renewRatio float64
+
+	// startMu serializes Start and protects started.
+	startMu sync.Mutex
+
+	// started indicates whether the renewal goroutine is started.
+	started bool
+
+	// mu protects the following fields.
+	mu sync.RWMutex
+
+	// certificate is the current certificate.
+	certificate *tls.Certificate
+
+	// roots is the current CA bundle.
+	roots *x509.CertPool
+
+	// revoked is the set of the serial numbers of the revoked certificates.
+	revoked map[string]struct{}
+}
+
+// RotatorOption is a functional option for configuring the Rotator.
+type RotatorOption func(r *Rotator)
+
+// WithIdentity sets the identity of the component, which is encoded as the URI SAN of the certificate.
+func WithIdentity(trustDomain string, identity *securityv1.Identity) RotatorOption {
+	return func(r *Rotator) {
+		r.trustDomain = trustDomain
+		r.identity = identity
+	}
+}
+
+// WithValidityPeriod sets the requested validity period of the certificate.
+func WithValidityPeriod(validityPeriod time.Duration) RotatorOption {
+	return func(r *Rotator) {
+		r.validityPeriod = validityPeriod
+	}
+}
+
+// WithRenewRatio sets the ratio of the elapsed validity period to renew the certificate, range is (0, 1).
+func WithRenewRatio(renewRatio float64) RotatorOption {
+	return func(r *Rotator) {
+		r.renewRatio = renewRatio
+	}
+}
+
+// NewRotator returns a new Rotator.
+func NewRotator(client securityv1.CertificateClient, options ...RotatorOption) *Rotator {
+	r := &Rotator{
+		client:         client,
+		trustDomain:    DefaultTrustDomain,
+		validityPeriod: DefaultValidityPeriod,
+		renewRatio:     DefaultRenewRatio,
+	}
+
+	for _, opt := range options {
+		opt(r)
+	}
+
+	return r
+}
+
+// Start issues the certificate and fetches the CA bundle, then renews the certificate
+// in the background before expiry until the context is done. Start can be called again
+// if it fails, and it returns ErrRotatorStarted once the rotator is started.
+func (r *Rotator) Start(ctx context.Context) error {
+	r.startMu.Lock()
+	defer r.startMu.Unlock()
+	if r.started {
+		return ErrRotatorStarted
+	}
+
+	if err := r.refreshCABundle(ctx); err != nil {
+		return err
+	}
+
+	if err := r.issue(ctx); err != nil {
+		return err
+	}
+
+	r.started = true
+	go r.run(ctx)
+	return nil
+}
+
+// run renews the certificate before expiry until the context is done.
+func (r *Rotator) run(ctx context.Context) {
+	retryInterval := minRetryInterval
+	timer := time.NewTimer(r.renewAfter())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		// The failure of refreshing the CA bundle does not block renewing the certificate,
+		// the current CA bundle is used until it is refreshed by the retries.
+		caErr := r.refreshCABundle(ctx)
+
+		var certErr error
+		if r.renewAfter() == 0 {
+			certErr = r.rotate(ctx)
+		}
+
+		if caErr != nil || certErr != nil {
+			timer.Reset(retryInterval)
+			retryInterval = nextRetryInterval(retryInterval)
+			continue
+		}
+
+		retryInterval = minRetryInterval
+		timer.Reset(r.renewAfter())
+	}
+}
+
+// nextRetryInterval returns the next retry interval by exponential backoff.
+func nextRetryInterval(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > maxRetryInterval {
+		return maxRetryInterval
+	}
+
+	return interval
+}
+
+// renewAfter returns the duration after which the current certificate should be renewed.
+func (r *Rotator) renewAfter() time.Duration {
+	r.mu.RLock()
+	leaf := r.certificate.Leaf
+	r.mu.RUnlock()
+
+	lifetime := leaf.NotAfter.Sub(leaf.NotBefore)
+	renewAt := leaf.NotBefore.Add(time.Duration(float64(lifetime) * r.renewRatio))
+	if d := time.Until(renewAt); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// issue issues the certificate by IssueCertificate.
+func (r *Rotator) issue(ctx context.Context) error {
+	key, csr, err := r.newCSR()
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.client.IssueCertificate(ctx, &securityv1.CertificateRequest{
+		Csr:            csr,
+		ValidityPeriod: durationpb.New(r.validityPeriod),
+		Identity:       r.identity,
+	})
+	if err != nil {
+		return fmt.Errorf("issue certificate: %w", err)
+	}
+
+	return r.setCertificate(key, resp.GetCertificateChain())
+}
+
+// rotate renews the certificate, and issues a new certificate if the renewal fails after the
+// current certificate expires, because RenewCertificate requires the current certificate chain
+// to be valid, e.g. the manager is unavailable longer than the remaining lifetime.
+func (r *Rotator) rotate(ctx context.Context) error {
+	err := r.renew(ctx)
+	if err == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	expired := time.Now().After(r.certificate.Leaf.NotAfter)
+	r.mu.RUnlock()
+	if !expired {
+		return err
+	}
+
+	if issueErr := r.issue(ctx); issueErr != nil {
+		return errors.Join(err, issueErr)
+	}
+
+	return nil
+}
+
+// renew renews the certificate by RenewCertificate with the rotated key pair.
+func (r *Rotator) renew(ctx context.Context) error {
+	key, csr, err := r.newCSR()
+	if err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	chain := r.certificate.Certificate
+	r.mu.RUnlock()
+
+	resp, err := r.client.RenewCertificate(ctx, &securityv1.RenewCertificateRequest{
+		Csr:              csr,
+		CertificateChain: chain,
+		ValidityPeriod:   durationpb.New(r.validityPeriod),
+	})
+	if err != nil {
+		return fmt.Errorf("renew certificate: %w", err)
+	}
+
+	return r.setCertificate(key, resp.GetCertificateChain())
+}
+
+// newCSR generates the key pair and the ASN.1 DER form certificate request.
+func (r *Rotator) newCSR() (*ecdsa.PrivateKey, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	template := &x509.CertificateRequest{}
+	if r.identity != nil {
+		template.Subject = pkix.Name{CommonName: r.identity.GetHostname()}
+		template.URIs = []*url.URL{IdentityURI(r.trustDomain, r.identity)}
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create certificate request: %w", err)
+	}
+
+	return key, csr, nil
+}
+
+// setCertificate replaces the current certificate.
+func (r *Rotator) setCertificate(key *ecdsa.PrivateKey, chain [][]byte) error {
+	if len(chain) == 0 {
+		return errors.New("empty certificate chain")
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return fmt.Errorf("parse certificate: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.certificate = &tls.Certificate{
+		Certificate: chain,
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+
+	return nil
+}
+
+// refreshCABundle fetches the CA bundle and the revoked certificates by GetCABundle.
+func (r *Rotator) refreshCABundle(ctx context.Context) error {
+	resp, err := r.client.GetCABundle(ctx, &securityv1.GetCABundleRequest{})
+	if err != nil {
+		return fmt.Errorf("get ca bundle: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	for _, der := range resp.GetCaCertificates() {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("parse ca certificate: %w", err)
+		}
+
+		roots.AddCert(cert)
+	}
+
+	revoked := make(map[string]struct{}, len(resp.GetRevokedSerialNumbers()))
+	for _, serialNumber := range resp.GetRevokedSerialNumbers() {
+		revoked[new(big.Int).SetBytes(serialNumber).String()] = struct{}{}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.roots = roots
+	r.revoked = revoked
+	return nil
+}
+
+// Certificate returns the current certificate.
+func (r *Rotator) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.certificate
+}
+
+// ServerTLSConfig returns the tls.Config of the server, which requires and verifies
+// the client certificate by the current CA bundle. The handshake fails with
+// ErrCertificateNotReady until the certificate is issued by Start.
+func (r *Rotator) ServerTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{alpnProtoH2},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			if r.certificate == nil {
+				return nil, ErrCertificateNotReady
+			}
+
+			return &tls.Config{
+				// The config of the client hello replaces the outer config, so the ALPN
+				// protocol added by credentials.NewTLS to the outer config is set again.
+				MinVersion:            tls.VersionTLS12,
+				NextProtos:            []string{alpnProtoH2},
+				Certificates:          []tls.Certificate{*r.certificate},
+				ClientAuth:            tls.RequireAndVerifyClientCert,
+				ClientCAs:             r.roots,
+				VerifyPeerCertificate: r.verifyRevoked,
+			}, nil
+		},
+	}
+}
+
+// ClientTLSConfig returns the tls.Config of the client, which verifies the server certificate
+// by the current CA bundle. The server is identified by the identity uri of the trust domain
+// instead of the server name, the component of the identity must be the expected one, and the
+// cluster id and the hostname are compared if they are not empty in the expected identity.
+// The handshake fails with ErrCertificateNotReady until the certificate is issued by Start.
+func (r *Rotator) ClientTLSConfig(expected *securityv1.Identity) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{alpnProtoH2},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate := r.Certificate()
+			if certificate == nil {
+				return nil, ErrCertificateNotReady
+			}
+
+			return certificate, nil
+		},
+		// The default verification can not use the rotated CA bundle,
+		// so the server certificate is verified by VerifyPeerCertificate.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return r.verifyServer(rawCerts, expected)
+		},
+	}
+}
+
+// verifyServer verifies the server certificate chain by the current CA bundle,
+// and verifies the identity of the server certificate is the expected one.
+func (r *Rotator) verifyServer(rawCerts [][]byte, expected *securityv1.Identity) error {
+	if len(rawCerts) == 0 {
+		return errors.New("empty server certificate chain")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("parse server certificate: %w", err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	r.mu.RLock()
+	roots := r.roots
+	r.mu.RUnlock()
+
+	// The nil roots represents the system roots, so the CA bundle must be fetched first.
+	if roots == nil {
+		return ErrCertificateNotReady
+	}
+
+	chains, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.verifyIdentity(certs[0], expected); err != nil {
+		return err
+	}
+
+	return r.verifyRevoked(nil, chains)
+}
+
+// verifyIdentity returns ErrUnexpectedIdentity if the identity of the server certificate is not the expected one.
+func (r *Rotator) verifyIdentity(cert *x509.Certificate, expected *securityv1.Identity) error {
+	if expected == nil {
+		return fmt.Errorf("%w: no expected identity", ErrUnexpectedIdentity)
+	}
+
+	identity, err := IdentityFromCertificate(r.trustDomain, cert)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnexpectedIdentity, err)
+	}
+
+	if identity.GetComponent() != expected.GetComponent() ||
+		(expected.GetClusterId() != 0 && identity.GetClusterId() != expected.GetClusterId()) ||
+		(expected.GetHostname() != "" && identity.GetHostname() != expected.GetHostname()) {
+		return fmt.Errorf("%w: %s", ErrUnexpectedIdentity, IdentityURI(r.trustDomain, identity))
+	}
+
+	return nil
+}
+
+// verifyRevoked returns ErrCertificateRevoked if any certificate of the verified chains is revoked.
+func (r *Rotator) verifyRevoked(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, chain := range verifiedChains {
+		for _, cert := range chain {
+			if _, ok := r.revoked[cert.SerialNumber.String()]; ok {
+				return fmt.Errorf("%w: serial number %s", ErrCertificateRevoked, cert.SerialNumber)
+			}
+		}
+	}
+
+	return nil
+}
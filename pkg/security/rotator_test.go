@@ -0,0 +1,416 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	securityv1 "d7y.io/api/v2/pkg/apis/security/v1"
+)
+
+// testCA is the in-memory CA which implements securityv1.CertificateClient.
+type testCA struct {
+	// key is the private key of the CA.
+	key *ecdsa.PrivateKey
+
+	// cert is the certificate of the CA.
+	cert *x509.Certificate
+
+	// mu protects the following fields.
+	mu sync.Mutex
+
+	// offset is added to the validity period of the issued certificates.
+	offset time.Duration
+
+	// failBundle indicates whether GetCABundle fails.
+	failBundle bool
+
+	// issued is the count of the issued certificates.
+	issued int
+
+	// renewed is the count of the renewed certificates.
+	renewed int
+}
+
+// newTestCA returns a new testCA.
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ca key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "dragonfly ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create ca certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse ca certificate: %v", err)
+	}
+
+	return &testCA{key: key, cert: cert}
+}
+
+// sign signs the certificate of the CSR with the identity uri.
+func (ca *testCA) sign(der []byte, validityPeriod time.Duration, identity *securityv1.Identity) ([][]byte, error) {
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ca.mu.Lock()
+	notBefore := time.Now().Add(ca.offset).Add(-time.Minute)
+	ca.mu.Unlock()
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(validityPeriod),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	if identity != nil {
+		template.URIs = append(template.URIs, IdentityURI(DefaultTrustDomain, identity))
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return [][]byte{cert, ca.cert.Raw}, nil
+}
+
+// IssueCertificate implements securityv1.CertificateClient.
+func (ca *testCA) IssueCertificate(_ context.Context, req *securityv1.CertificateRequest, _ ...grpc.CallOption) (*securityv1.CertificateResponse, error) {
+	chain, err := ca.sign(req.GetCsr(), req.GetValidityPeriod().AsDuration(), req.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+
+	ca.mu.Lock()
+	ca.issued++
+	ca.mu.Unlock()
+	return &securityv1.CertificateResponse{CertificateChain: chain}, nil
+}
+
+// RenewCertificate implements securityv1.CertificateClient, the certificate chain must be valid.
+func (ca *testCA) RenewCertificate(_ context.Context, req *securityv1.RenewCertificateRequest, _ ...grpc.CallOption) (*securityv1.CertificateResponse, error) {
+	leaf, err := x509.ParseCertificate(req.GetCertificateChain()[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	if _, err := leaf.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	var identity *securityv1.Identity
+	if len(leaf.URIs) > 0 {
+		if _, identity, err = ParseIdentityURI(leaf.URIs[0]); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	chain, err := ca.sign(req.GetCsr(), req.GetValidityPeriod().AsDuration(), identity)
+	if err != nil {
+		return nil, err
+	}
+
+	ca.mu.Lock()
+	ca.renewed++
+	ca.mu.Unlock()
+	return &securityv1.CertificateResponse{CertificateChain: chain}, nil
+}
+
+// RevokeCertificate implements securityv1.CertificateClient.
+func (ca *testCA) RevokeCertificate(context.Context, *securityv1.RevokeCertificateRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "revoke certificate is not implemented")
+}
+
+// GetCABundle implements securityv1.CertificateClient.
+func (ca *testCA) GetCABundle(context.Context, *securityv1.GetCABundleRequest, ...grpc.CallOption) (*securityv1.GetCABundleResponse, error) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	if ca.failBundle {
+		return nil, status.Error(codes.Unavailable, "ca bundle is unavailable")
+	}
+
+	return &securityv1.GetCABundleResponse{CaCertificates: [][]byte{ca.cert.Raw}}, nil
+}
+
+// counts returns the count of the issued and the renewed certificates.
+func (ca *testCA) counts() (int, int) {
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	return ca.issued, ca.renewed
+}
+
+// newTestRotator returns the started rotator of the identity.
+func newTestRotator(t *testing.T, ca *testCA, identity *securityv1.Identity, options ...RotatorOption) *Rotator {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	r := NewRotator(ca, append([]RotatorOption{WithIdentity(DefaultTrustDomain, identity)}, options...)...)
+	if err := r.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	return r
+}
+
+// handshake returns the handshake errors of the client and the server.
+func handshake(clientConfig, serverConfig *tls.Config) (error, error) {
+	_, clientErr, serverErr := handshakeState(clientConfig, serverConfig)
+	return clientErr, serverErr
+}
+
+// handshakeState returns the connection state of the client and the handshake errors of the client and the server.
+func handshakeState(clientConfig, serverConfig *tls.Config) (tls.ConnectionState, error, error) {
+	clientConn, serverConn := net.Pipe()
+	serverErr := make(chan error, 1)
+	go func() {
+		err := tls.Server(serverConn, serverConfig).Handshake()
+		// Close the underlying connection instead of sending close_notify to the unbuffered pipe,
+		// so the client does not wait for the server on failure.
+		serverConn.Close()
+		serverErr <- err
+	}()
+
+	client := tls.Client(clientConn, clientConfig)
+	err := client.Handshake()
+	clientConn.Close()
+	return client.ConnectionState(), err, <-serverErr
+}
+
+func TestRotatorClientTLSConfig(t *testing.T) {
+	ca := newTestCA(t)
+	scheduler := &securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT, ClusterId: 1, Hostname: "scheduler"}
+	server := newTestRotator(t, ca, scheduler)
+	fake := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, ClusterId: 1, Hostname: "scheduler"})
+	client := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, ClusterId: 1, Hostname: "peer"})
+
+	tests := []struct {
+		name     string
+		server   *Rotator
+		expected *securityv1.Identity
+		err      error
+	}{
+		{
+			name:     "expected component",
+			server:   server,
+			expected: &securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT},
+		},
+		{
+			name:     "expected identity",
+			server:   server,
+			expected: scheduler,
+		},
+		{
+			name:     "unexpected component",
+			server:   server,
+			expected: &securityv1.Identity{Component: securityv1.ComponentType_MANAGER_COMPONENT},
+			err:      ErrUnexpectedIdentity,
+		},
+		{
+			name:     "unexpected cluster",
+			server:   server,
+			expected: &securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT, ClusterId: 2},
+			err:      ErrUnexpectedIdentity,
+		},
+		{
+			name:     "unexpected hostname",
+			server:   server,
+			expected: &securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT, Hostname: "other"},
+			err:      ErrUnexpectedIdentity,
+		},
+		{
+			name:     "peer poses as scheduler",
+			server:   fake,
+			expected: scheduler,
+			err:      ErrUnexpectedIdentity,
+		},
+		{
+			name:   "no expected identity",
+			server: server,
+			err:    ErrUnexpectedIdentity,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientErr, _ := handshake(client.ClientTLSConfig(tc.expected), tc.server.ServerTLSConfig())
+			if tc.err == nil && clientErr != nil {
+				t.Fatalf("handshake error = %v", clientErr)
+			}
+
+			if tc.err != nil && !errors.Is(clientErr, tc.err) {
+				t.Fatalf("handshake error = %v, want %v", clientErr, tc.err)
+			}
+		})
+	}
+}
+
+func TestRotatorNotReady(t *testing.T) {
+	ca := newTestCA(t)
+	client := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, Hostname: "peer"})
+	server := NewRotator(ca)
+
+	_, serverErr := handshake(client.ClientTLSConfig(&securityv1.Identity{}), server.ServerTLSConfig())
+	if !errors.Is(serverErr, ErrCertificateNotReady) {
+		t.Fatalf("server handshake error = %v, want %v", serverErr, ErrCertificateNotReady)
+	}
+
+	clientErr, _ := handshake(NewRotator(ca).ClientTLSConfig(&securityv1.Identity{}), newTestRotator(t, ca, nil).ServerTLSConfig())
+	if !errors.Is(clientErr, ErrCertificateNotReady) {
+		t.Fatalf("client handshake error = %v, want %v", clientErr, ErrCertificateNotReady)
+	}
+}
+
+func TestRotatorRotateExpired(t *testing.T) {
+	ca := newTestCA(t)
+	ca.offset = -2 * time.Hour
+	r := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, Hostname: "peer"}, WithValidityPeriod(time.Hour))
+	if !time.Now().After(r.Certificate().Leaf.NotAfter) {
+		t.Fatalf("certificate is not expired: %v", r.Certificate().Leaf.NotAfter)
+	}
+
+	ca.mu.Lock()
+	ca.offset = 0
+	ca.mu.Unlock()
+	if err := r.rotate(context.Background()); err != nil {
+		t.Fatalf("rotate() error = %v", err)
+	}
+
+	if issued, renewed := ca.counts(); issued != 2 || renewed != 0 {
+		t.Fatalf("issued %d and renewed %d certificates, want 2 and 0", issued, renewed)
+	}
+
+	if !time.Now().Before(r.Certificate().Leaf.NotAfter) {
+		t.Fatalf("certificate is expired: %v", r.Certificate().Leaf.NotAfter)
+	}
+}
+
+func TestRotatorRenewWithoutCABundle(t *testing.T) {
+	ca := newTestCA(t)
+	r := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, Hostname: "peer"},
+		WithValidityPeriod(time.Hour), WithRenewRatio(0.0001))
+	leaf := r.Certificate().Leaf
+
+	ca.mu.Lock()
+	ca.failBundle = true
+	ca.mu.Unlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, renewed := ca.counts(); renewed > 0 {
+			if r.Certificate().Leaf.SerialNumber.Cmp(leaf.SerialNumber) == 0 {
+				t.Fatal("certificate is not replaced after renewal")
+			}
+
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("certificate is not renewed when ca bundle is unavailable")
+}
+
+func TestRotatorALPN(t *testing.T) {
+	ca := newTestCA(t)
+	server := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT, Hostname: "scheduler"})
+	client := newTestRotator(t, ca, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, Hostname: "peer"})
+
+	// The grpc credentials add h2 to the outer configs only.
+	serverConfig, clientConfig := server.ServerTLSConfig(), client.ClientTLSConfig(&securityv1.Identity{Component: securityv1.ComponentType_SCHEDULER_COMPONENT})
+	state, clientErr, serverErr := handshakeState(clientConfig, serverConfig)
+	if clientErr != nil || serverErr != nil {
+		t.Fatalf("handshake error = %v, %v", clientErr, serverErr)
+	}
+
+	if state.NegotiatedProtocol != alpnProtoH2 {
+		t.Fatalf("negotiated protocol = %q, want %q", state.NegotiatedProtocol, alpnProtoH2)
+	}
+}
+
+func TestRotatorStartTwice(t *testing.T) {
+	ca := newTestCA(t)
+	ca.failBundle = true
+	r := NewRotator(ca, WithIdentity(DefaultTrustDomain, &securityv1.Identity{Component: securityv1.ComponentType_PEER_COMPONENT, Hostname: "peer"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The failed start can be retried.
+	if err := r.Start(ctx); err == nil {
+		t.Fatal("Start() without ca bundle error = nil, want error")
+	}
+
+	ca.mu.Lock()
+	ca.failBundle = false
+	ca.mu.Unlock()
+	if err := r.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	if err := r.Start(ctx); !errors.Is(err, ErrRotatorStarted) {
+		t.Fatalf("second Start() error = %v, want %v", err, ErrRotatorStarted)
+	}
+
+	if issued, _ := ca.counts(); issued != 1 {
+		t.Fatalf("issued %d certificates, want 1", issued)
+	}
+}
@@ -0,0 +1,328 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// Contents returns the typed contents of the values, FP16 and BF16 are not supported
+// by the typed contents and must be encoded by EncodeRaw.
+func Contents[T Element](datatype string, values []T) (*inferencev1.InferTensorContents, error) {
+	if err := checkElement[T](datatype); err != nil {
+		return nil, err
+	}
+
+	contents := &inferencev1.InferTensorContents{}
+	switch v := any(values).(type) {
+	case []bool:
+		contents.BoolContents = v
+	case []uint8:
+		contents.UintContents = widen[uint8, uint32](v)
+	case []uint16:
+		contents.UintContents = widen[uint16, uint32](v)
+	case []uint32:
+		contents.UintContents = v
+	case []uint64:
+		contents.Uint64Contents = v
+	case []int8:
+		contents.IntContents = widen[int8, int32](v)
+	case []int16:
+		contents.IntContents = widen[int16, int32](v)
+	case []int32:
+		contents.IntContents = v
+	case []int64:
+		contents.Int64Contents = v
+	case []float32:
+		if datatype != DatatypeFP32 {
+			return nil, fmt.Errorf("data type %s must be represented as raw contents", datatype)
+		}
+
+		contents.Fp32Contents = v
+	case []float64:
+		contents.Fp64Contents = v
+	case [][]byte:
+		contents.BytesContents = v
+	}
+
+	return contents, nil
+}
+
+// FromContents returns the values of the typed contents, the values are checked
+// to be in the range of the data type.
+func FromContents[T Element](datatype string, contents *inferencev1.InferTensorContents) ([]T, error) {
+	if err := checkElement[T](datatype); err != nil {
+		return nil, err
+	}
+
+	var values any
+	var err error
+	switch datatype {
+	case DatatypeBool:
+		values = contents.GetBoolContents()
+	case DatatypeUint8:
+		values, err = narrow[uint32, uint8](contents.GetUintContents(), datatype)
+	case DatatypeUint16:
+		values, err = narrow[uint32, uint16](contents.GetUintContents(), datatype)
+	case DatatypeUint32:
+		values = contents.GetUintContents()
+	case DatatypeUint64:
+		values = contents.GetUint64Contents()
+	case DatatypeInt8:
+		values, err = narrow[int32, int8](contents.GetIntContents(), datatype)
+	case DatatypeInt16:
+		values, err = narrow[int32, int16](contents.GetIntContents(), datatype)
+	case DatatypeInt32:
+		values = contents.GetIntContents()
+	case DatatypeInt64:
+		values = contents.GetInt64Contents()
+	case DatatypeFP32:
+		values = contents.GetFp32Contents()
+	case DatatypeFP64:
+		values = contents.GetFp64Contents()
+	case DatatypeBytes:
+		values = contents.GetBytesContents()
+	default:
+		return nil, fmt.Errorf("data type %s must be represented as raw contents", datatype)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return values.([]T), nil
+}
+
+// EncodeRaw encodes the values to the raw contents of the data type.
+func EncodeRaw[T Element](datatype string, values []T) ([]byte, error) {
+	if err := checkElement[T](datatype); err != nil {
+		return nil, err
+	}
+
+	size, _ := ElementSize(datatype)
+	raw := make([]byte, 0, size*len(values))
+	switch v := any(values).(type) {
+	case []bool:
+		for _, b := range v {
+			if b {
+				raw = append(raw, 1)
+			} else {
+				raw = append(raw, 0)
+			}
+		}
+	case []uint8:
+		raw = append(raw, v...)
+	case []uint16:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint16(raw, n)
+		}
+	case []uint32:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint32(raw, n)
+		}
+	case []uint64:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint64(raw, n)
+		}
+	case []int8:
+		for _, n := range v {
+			raw = append(raw, uint8(n))
+		}
+	case []int16:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint16(raw, uint16(n))
+		}
+	case []int32:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint32(raw, uint32(n))
+		}
+	case []int64:
+		for _, n := range v {
+			raw = binary.LittleEndian.AppendUint64(raw, uint64(n))
+		}
+	case []float32:
+		for _, f := range v {
+			switch datatype {
+			case DatatypeFP16:
+				raw = binary.LittleEndian.AppendUint16(raw, Float32ToFP16(f))
+			case DatatypeBF16:
+				raw = binary.LittleEndian.AppendUint16(raw, Float32ToBF16(f))
+			default:
+				raw = binary.LittleEndian.AppendUint32(raw, math.Float32bits(f))
+			}
+		}
+	case []float64:
+		for _, f := range v {
+			raw = binary.LittleEndian.AppendUint64(raw, math.Float64bits(f))
+		}
+	case [][]byte:
+		for _, b := range v {
+			if uint64(len(b)) > math.MaxUint32 {
+				return nil, fmt.Errorf("bytes element size %d exceeds %d", len(b), uint32(math.MaxUint32))
+			}
+
+			raw = binary.LittleEndian.AppendUint32(raw, uint32(len(b)))
+			raw = append(raw, b...)
+		}
+	}
+
+	return raw, nil
+}
+
+// DecodeRaw decodes the values from the raw contents of the data type.
+func DecodeRaw[T Element](datatype string, raw []byte) ([]T, error) {
+	if err := checkElement[T](datatype); err != nil {
+		return nil, err
+	}
+
+	if datatype == DatatypeBytes {
+		var values [][]byte
+		for len(raw) > 0 {
+			if len(raw) < 4 {
+				return nil, fmt.Errorf("invalid length prefix of bytes element")
+			}
+
+			n := binary.LittleEndian.Uint32(raw)
+			if uint64(len(raw)-4) < uint64(n) {
+				return nil, fmt.Errorf("bytes element size %d exceeds remaining %d", n, len(raw)-4)
+			}
+
+			values = append(values, raw[4:4+n])
+			raw = raw[4+n:]
+		}
+
+		return any(values).([]T), nil
+	}
+
+	size, _ := ElementSize(datatype)
+	if len(raw)%size != 0 {
+		return nil, fmt.Errorf("raw contents size %d is not a multiple of %d", len(raw), size)
+	}
+
+	n := len(raw) / size
+	var values any
+	switch datatype {
+	case DatatypeBool:
+		v := make([]bool, n)
+		for i := range v {
+			v[i] = raw[i] != 0
+		}
+		values = v
+	case DatatypeUint8:
+		values = append([]uint8(nil), raw...)
+	case DatatypeUint16:
+		v := make([]uint16, n)
+		for i := range v {
+			v[i] = binary.LittleEndian.Uint16(raw[2*i:])
+		}
+		values = v
+	case DatatypeUint32:
+		v := make([]uint32, n)
+		for i := range v {
+			v[i] = binary.LittleEndian.Uint32(raw[4*i:])
+		}
+		values = v
+	case DatatypeUint64:
+		v := make([]uint64, n)
+		for i := range v {
+			v[i] = binary.LittleEndian.Uint64(raw[8*i:])
+		}
+		values = v
+	case DatatypeInt8:
+		v := make([]int8, n)
+		for i := range v {
+			v[i] = int8(raw[i])
+		}
+		values = v
+	case DatatypeInt16:
+		v := make([]int16, n)
+		for i := range v {
+			v[i] = int16(binary.LittleEndian.Uint16(raw[2*i:]))
+		}
+		values = v
+	case DatatypeInt32:
+		v := make([]int32, n)
+		for i := range v {
+			v[i] = int32(binary.LittleEndian.Uint32(raw[4*i:]))
+		}
+		values = v
+	case DatatypeInt64:
+		v := make([]int64, n)
+		for i := range v {
+			v[i] = int64(binary.LittleEndian.Uint64(raw[8*i:]))
+		}
+		values = v
+	case DatatypeFP16:
+		v := make([]float32, n)
+		for i := range v {
+			v[i] = FP16ToFloat32(binary.LittleEndian.Uint16(raw[2*i:]))
+		}
+		values = v
+	case DatatypeBF16:
+		v := make([]float32, n)
+		for i := range v {
+			v[i] = BF16ToFloat32(binary.LittleEndian.Uint16(raw[2*i:]))
+		}
+		values = v
+	case DatatypeFP32:
+		v := make([]float32, n)
+		for i := range v {
+			v[i] = math.Float32frombits(binary.LittleEndian.Uint32(raw[4*i:]))
+		}
+		values = v
+	case DatatypeFP64:
+		v := make([]float64, n)
+		for i := range v {
+			v[i] = math.Float64frombits(binary.LittleEndian.Uint64(raw[8*i:]))
+		}
+		values = v
+	}
+
+	return values.([]T), nil
+}
+
+// integer is the integer element types of the typed contents.
+type integer interface {
+	uint8 | uint16 | uint32 | int8 | int16 | int32
+}
+
+// widen converts the narrow integers to the integers of the typed contents.
+func widen[From, To integer](values []From) []To {
+	widened := make([]To, len(values))
+	for i, v := range values {
+		widened[i] = To(v)
+	}
+
+	return widened
+}
+
+// narrow converts the integers of the typed contents to the narrow integers, and checks the range.
+func narrow[From, To integer](values []From, datatype string) ([]To, error) {
+	narrowed := make([]To, len(values))
+	for i, v := range values {
+		narrowed[i] = To(v)
+		if From(narrowed[i]) != v {
+			return nil, fmt.Errorf("value %d overflows data type %s", v, datatype)
+		}
+	}
+
+	return narrowed, nil
+}
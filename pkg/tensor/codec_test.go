@@ -0,0 +1,221 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// rawRoundTrip checks the raw contents of the values have the expected size, and are decoded to the values.
+func rawRoundTrip[T Element](t *testing.T, datatype string, values []T) {
+	t.Helper()
+	raw, err := EncodeRaw(datatype, values)
+	if err != nil {
+		t.Fatalf("EncodeRaw(%s) error = %v", datatype, err)
+	}
+
+	if size, _ := ElementSize(datatype); size > 0 && len(raw) != size*len(values) {
+		t.Fatalf("EncodeRaw(%s) size = %d, want %d", datatype, len(raw), size*len(values))
+	}
+
+	decoded, err := DecodeRaw[T](datatype, raw)
+	if err != nil {
+		t.Fatalf("DecodeRaw(%s) error = %v", datatype, err)
+	}
+
+	if !reflect.DeepEqual(decoded, values) {
+		t.Fatalf("DecodeRaw(%s) = %v, want %v", datatype, decoded, values)
+	}
+}
+
+// typedRoundTrip checks the typed contents of the values are decoded to the values.
+func typedRoundTrip[T Element](t *testing.T, datatype string, values []T) {
+	t.Helper()
+	contents, err := Contents(datatype, values)
+	if err != nil {
+		t.Fatalf("Contents(%s) error = %v", datatype, err)
+	}
+
+	decoded, err := FromContents[T](datatype, contents)
+	if err != nil {
+		t.Fatalf("FromContents(%s) error = %v", datatype, err)
+	}
+
+	if !reflect.DeepEqual(decoded, values) {
+		t.Fatalf("FromContents(%s) = %v, want %v", datatype, decoded, values)
+	}
+}
+
+func TestRawRoundTrip(t *testing.T) {
+	rawRoundTrip(t, DatatypeBool, []bool{true, false, true})
+	rawRoundTrip(t, DatatypeUint8, []uint8{0, 1, math.MaxUint8})
+	rawRoundTrip(t, DatatypeUint16, []uint16{0, 1, math.MaxUint16})
+	rawRoundTrip(t, DatatypeUint32, []uint32{0, 1, math.MaxUint32})
+	rawRoundTrip(t, DatatypeUint64, []uint64{0, 1, math.MaxUint64})
+	rawRoundTrip(t, DatatypeInt8, []int8{math.MinInt8, -1, math.MaxInt8})
+	rawRoundTrip(t, DatatypeInt16, []int16{math.MinInt16, -1, math.MaxInt16})
+	rawRoundTrip(t, DatatypeInt32, []int32{math.MinInt32, -1, math.MaxInt32})
+	rawRoundTrip(t, DatatypeInt64, []int64{math.MinInt64, -1, math.MaxInt64})
+	rawRoundTrip(t, DatatypeFP16, []float32{0, -1.5, 65504, float32(math.Inf(1))})
+	rawRoundTrip(t, DatatypeBF16, []float32{0, -1.5, 3.140625, float32(math.Inf(-1))})
+	rawRoundTrip(t, DatatypeFP32, []float32{0, -1.5, math.MaxFloat32, math.SmallestNonzeroFloat32})
+	rawRoundTrip(t, DatatypeFP64, []float64{0, -1.5, math.MaxFloat64, math.SmallestNonzeroFloat64})
+	rawRoundTrip(t, DatatypeBytes, [][]byte{[]byte("foo"), {}, []byte("bar")})
+}
+
+func TestTypedRoundTrip(t *testing.T) {
+	typedRoundTrip(t, DatatypeBool, []bool{true, false, true})
+	typedRoundTrip(t, DatatypeUint8, []uint8{0, 1, math.MaxUint8})
+	typedRoundTrip(t, DatatypeUint16, []uint16{0, 1, math.MaxUint16})
+	typedRoundTrip(t, DatatypeUint32, []uint32{0, 1, math.MaxUint32})
+	typedRoundTrip(t, DatatypeUint64, []uint64{0, 1, math.MaxUint64})
+	typedRoundTrip(t, DatatypeInt8, []int8{math.MinInt8, -1, math.MaxInt8})
+	typedRoundTrip(t, DatatypeInt16, []int16{math.MinInt16, -1, math.MaxInt16})
+	typedRoundTrip(t, DatatypeInt32, []int32{math.MinInt32, -1, math.MaxInt32})
+	typedRoundTrip(t, DatatypeInt64, []int64{math.MinInt64, -1, math.MaxInt64})
+	typedRoundTrip(t, DatatypeFP32, []float32{0, -1.5, math.MaxFloat32})
+	typedRoundTrip(t, DatatypeFP64, []float64{0, -1.5, math.MaxFloat64})
+	typedRoundTrip(t, DatatypeBytes, [][]byte{[]byte("foo"), {}, []byte("bar")})
+}
+
+func TestTypedContentsErrors(t *testing.T) {
+	if _, err := Contents(DatatypeFP16, []float32{1}); err == nil {
+		t.Fatal("Contents(FP16) error = nil, want error")
+	}
+
+	if _, err := Contents(DatatypeBF16, []float32{1}); err == nil {
+		t.Fatal("Contents(BF16) error = nil, want error")
+	}
+
+	if _, err := Contents(DatatypeFP32, []int32{1}); err == nil {
+		t.Fatal("Contents() of mismatched element type error = nil, want error")
+	}
+
+	if _, err := FromContents[float32](DatatypeFP16, &inferencev1.InferTensorContents{Fp32Contents: []float32{1}}); err == nil {
+		t.Fatal("FromContents(FP16) error = nil, want error")
+	}
+
+	if _, err := FromContents[uint8](DatatypeUint8, &inferencev1.InferTensorContents{UintContents: []uint32{math.MaxUint8 + 1}}); err == nil {
+		t.Fatal("FromContents(UINT8) of overflowed value error = nil, want error")
+	}
+
+	if _, err := FromContents[int16](DatatypeInt16, &inferencev1.InferTensorContents{IntContents: []int32{math.MinInt16 - 1}}); err == nil {
+		t.Fatal("FromContents(INT16) of overflowed value error = nil, want error")
+	}
+}
+
+func TestEncodeRawLittleEndian(t *testing.T) {
+	tests := []struct {
+		name   string
+		encode func() ([]byte, error)
+		want   []byte
+	}{
+		{
+			name:   "int16",
+			encode: func() ([]byte, error) { return EncodeRaw(DatatypeInt16, []int16{-2}) },
+			want:   []byte{0xfe, 0xff},
+		},
+		{
+			name:   "uint32",
+			encode: func() ([]byte, error) { return EncodeRaw(DatatypeUint32, []uint32{0x01020304}) },
+			want:   []byte{0x04, 0x03, 0x02, 0x01},
+		},
+		{
+			name:   "fp16",
+			encode: func() ([]byte, error) { return EncodeRaw(DatatypeFP16, []float32{1}) },
+			want:   []byte{0x00, 0x3c},
+		},
+		{
+			name:   "bf16",
+			encode: func() ([]byte, error) { return EncodeRaw(DatatypeBF16, []float32{1}) },
+			want:   []byte{0x80, 0x3f},
+		},
+		{
+			name:   "bytes with length prefix",
+			encode: func() ([]byte, error) { return EncodeRaw(DatatypeBytes, [][]byte{[]byte("ab"), {}, []byte("c")}) },
+			want:   []byte{2, 0, 0, 0, 'a', 'b', 0, 0, 0, 0, 1, 0, 0, 0, 'c'},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := tc.encode()
+			if err != nil {
+				t.Fatalf("EncodeRaw() error = %v", err)
+			}
+
+			if !bytes.Equal(raw, tc.want) {
+				t.Fatalf("EncodeRaw() = %v, want %v", raw, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRawInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		datatype string
+		raw      []byte
+	}{
+		{name: "truncated length prefix", datatype: DatatypeBytes, raw: []byte{1, 0, 0}},
+		{name: "truncated element", datatype: DatatypeBytes, raw: []byte{3, 0, 0, 0, 'a', 'b'}},
+		{name: "truncated length prefix after element", datatype: DatatypeBytes, raw: []byte{1, 0, 0, 0, 'a', 1}},
+		{name: "huge length prefix", datatype: DatatypeBytes, raw: []byte{0xff, 0xff, 0xff, 0xff, 'a'}},
+		{name: "partial fp32", datatype: DatatypeFP32, raw: []byte{0, 0, 0}},
+		{name: "partial fp16", datatype: DatatypeFP16, raw: []byte{0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			if tc.datatype == DatatypeBytes {
+				_, err = DecodeRaw[[]byte](tc.datatype, tc.raw)
+			} else {
+				_, err = DecodeRaw[float32](tc.datatype, tc.raw)
+			}
+
+			if err == nil {
+				t.Fatalf("DecodeRaw(%s, %v) error = nil, want error", tc.datatype, tc.raw)
+			}
+		})
+	}
+
+	if _, err := DecodeRaw[int32](DatatypeFP32, []byte{0, 0, 0, 0}); err == nil {
+		t.Fatal("DecodeRaw() of mismatched element type error = nil, want error")
+	}
+}
+
+func TestDatatype(t *testing.T) {
+	for dataType, want := range map[inferencev1.DataType]string{
+		inferencev1.DataType_TYPE_FP32:   DatatypeFP32,
+		inferencev1.DataType_TYPE_BF16:   DatatypeBF16,
+		inferencev1.DataType_TYPE_STRING: DatatypeBytes,
+	} {
+		if datatype, err := Datatype(dataType); err != nil || datatype != want {
+			t.Fatalf("Datatype(%s) = %s, %v, want %s", dataType, datatype, err, want)
+		}
+	}
+
+	if _, err := Datatype(inferencev1.DataType_TYPE_INVALID); err == nil {
+		t.Fatal("Datatype(TYPE_INVALID) error = nil, want error")
+	}
+}
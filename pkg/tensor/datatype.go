@@ -0,0 +1,148 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tensor converts between the typed Go slices and the tensors of inference.v1.
+//
+// Every data type has the Go element type, the values of BOOL are []bool, UINT8 to UINT64
+// are []uint8 to []uint64, INT8 to INT64 are []int8 to []int64, FP16, BF16 and FP32 are
+// []float32, FP64 is []float64 and BYTES is [][]byte. The raw contents are the flattened,
+// row-major and little-endian elements, and every element of BYTES is prefixed by its
+// 4-byte little-endian length. FP16 and BF16 are only represented as the raw contents.
+package tensor
+
+import (
+	"fmt"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// Data types of the tensors.
+const (
+	DatatypeBool   = "BOOL"
+	DatatypeUint8  = "UINT8"
+	DatatypeUint16 = "UINT16"
+	DatatypeUint32 = "UINT32"
+	DatatypeUint64 = "UINT64"
+	DatatypeInt8   = "INT8"
+	DatatypeInt16  = "INT16"
+	DatatypeInt32  = "INT32"
+	DatatypeInt64  = "INT64"
+	DatatypeFP16   = "FP16"
+	DatatypeFP32   = "FP32"
+	DatatypeFP64   = "FP64"
+	DatatypeBF16   = "BF16"
+	DatatypeBytes  = "BYTES"
+)
+
+// datatypes is the data type of the tensor by the data type of the model config.
+var datatypes = map[inferencev1.DataType]string{
+	inferencev1.DataType_TYPE_BOOL:   DatatypeBool,
+	inferencev1.DataType_TYPE_UINT8:  DatatypeUint8,
+	inferencev1.DataType_TYPE_UINT16: DatatypeUint16,
+	inferencev1.DataType_TYPE_UINT32: DatatypeUint32,
+	inferencev1.DataType_TYPE_UINT64: DatatypeUint64,
+	inferencev1.DataType_TYPE_INT8:   DatatypeInt8,
+	inferencev1.DataType_TYPE_INT16:  DatatypeInt16,
+	inferencev1.DataType_TYPE_INT32:  DatatypeInt32,
+	inferencev1.DataType_TYPE_INT64:  DatatypeInt64,
+	inferencev1.DataType_TYPE_FP16:   DatatypeFP16,
+	inferencev1.DataType_TYPE_FP32:   DatatypeFP32,
+	inferencev1.DataType_TYPE_FP64:   DatatypeFP64,
+	inferencev1.DataType_TYPE_STRING: DatatypeBytes,
+	inferencev1.DataType_TYPE_BF16:   DatatypeBF16,
+}
+
+// elementSizes is the size of the raw element by data type, BYTES has no fixed size.
+var elementSizes = map[string]int{
+	DatatypeBool:   1,
+	DatatypeUint8:  1,
+	DatatypeUint16: 2,
+	DatatypeUint32: 4,
+	DatatypeUint64: 8,
+	DatatypeInt8:   1,
+	DatatypeInt16:  2,
+	DatatypeInt32:  4,
+	DatatypeInt64:  8,
+	DatatypeFP16:   2,
+	DatatypeFP32:   4,
+	DatatypeFP64:   8,
+	DatatypeBF16:   2,
+	DatatypeBytes:  0,
+}
+
+// Datatype returns the data type of the tensor of the data type of the model config,
+// for example TYPE_FP32 is FP32 and TYPE_STRING is BYTES.
+func Datatype(dataType inferencev1.DataType) (string, error) {
+	datatype, ok := datatypes[dataType]
+	if !ok {
+		return "", fmt.Errorf("invalid data type %s", dataType)
+	}
+
+	return datatype, nil
+}
+
+// ElementSize returns the size of the raw element of the data type, it is zero for BYTES.
+func ElementSize(datatype string) (int, error) {
+	size, ok := elementSizes[datatype]
+	if !ok {
+		return 0, fmt.Errorf("invalid data type %s", datatype)
+	}
+
+	return size, nil
+}
+
+// Element is the Go element type of the tensor.
+type Element interface {
+	bool | uint8 | uint16 | uint32 | uint64 | int8 | int16 | int32 | int64 | float32 | float64 | []byte
+}
+
+// checkElement returns error if the Go element type does not match the data type.
+func checkElement[T Element](datatype string) error {
+	var zero T
+	var ok bool
+	switch any(zero).(type) {
+	case bool:
+		ok = datatype == DatatypeBool
+	case uint8:
+		ok = datatype == DatatypeUint8
+	case uint16:
+		ok = datatype == DatatypeUint16
+	case uint32:
+		ok = datatype == DatatypeUint32
+	case uint64:
+		ok = datatype == DatatypeUint64
+	case int8:
+		ok = datatype == DatatypeInt8
+	case int16:
+		ok = datatype == DatatypeInt16
+	case int32:
+		ok = datatype == DatatypeInt32
+	case int64:
+		ok = datatype == DatatypeInt64
+	case float32:
+		ok = datatype == DatatypeFP32 || datatype == DatatypeFP16 || datatype == DatatypeBF16
+	case float64:
+		ok = datatype == DatatypeFP64
+	case []byte:
+		ok = datatype == DatatypeBytes
+	}
+
+	if !ok {
+		return fmt.Errorf("element type %T does not match data type %s", zero, datatype)
+	}
+
+	return nil
+}
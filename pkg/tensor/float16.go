@@ -0,0 +1,110 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import "math"
+
+// Float32ToFP16 converts the float32 to the bits of IEEE 754 half-precision float,
+// the value is rounded to nearest even, and the overflow value is converted to infinity.
+func Float32ToFP16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mantissa := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Infinity or NaN, NaN keeps the quiet bit.
+		if mantissa != 0 {
+			return sign | 0x7e00
+		}
+
+		return sign | 0x7c00
+	case exp-127+15 >= 0x1f:
+		// Overflow to infinity.
+		return sign | 0x7c00
+	case exp-127+15 <= 0:
+		// Subnormal or zero of half-precision.
+		shift := uint32(14 - (exp - 127 + 15))
+		if shift > 24 {
+			return sign
+		}
+
+		mantissa |= 0x800000
+		half := mantissa >> shift
+		remainder := mantissa & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if remainder > halfway || (remainder == halfway && half&1 == 1) {
+			half++
+		}
+
+		return sign | uint16(half)
+	default:
+		half := uint32(exp-127+15)<<10 | mantissa>>13
+		remainder := mantissa & 0x1fff
+		if remainder > 0x1000 || (remainder == 0x1000 && half&1 == 1) {
+			// The carry may overflow to the exponent, and to infinity correctly.
+			half++
+		}
+
+		return sign | uint16(half)
+	}
+}
+
+// FP16ToFloat32 converts the bits of IEEE 754 half-precision float to the float32.
+func FP16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mantissa := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mantissa<<13)
+	case exp == 0:
+		if mantissa == 0 {
+			return math.Float32frombits(sign)
+		}
+
+		// Normalize the subnormal value.
+		exp = 127 - 15 + 1
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			exp--
+		}
+
+		return math.Float32frombits(sign | exp<<23 | (mantissa&0x3ff)<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mantissa<<13)
+	}
+}
+
+// Float32ToBF16 converts the float32 to the bits of bfloat16, the value is rounded to nearest even.
+func Float32ToBF16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		// NaN keeps the quiet bit.
+		return uint16(bits>>16) | 0x40
+	}
+
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+// BF16ToFloat32 converts the bits of bfloat16 to the float32.
+func BF16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
@@ -0,0 +1,150 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"math"
+	"testing"
+)
+
+// fp16Value returns the value of the bits of half-precision float by the definition of IEEE 754.
+func fp16Value(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+
+	exp, mantissa := int(h>>10)&0x1f, float64(h&0x3ff)
+	switch exp {
+	case 0x1f:
+		if mantissa != 0 {
+			return math.NaN()
+		}
+
+		return math.Inf(int(sign))
+	case 0:
+		return sign * math.Ldexp(mantissa/1024, -14)
+	default:
+		return sign * math.Ldexp(1+mantissa/1024, exp-15)
+	}
+}
+
+func TestFP16AllBitPatterns(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := uint16(i)
+		f := FP16ToFloat32(h)
+		want := fp16Value(h)
+		if math.IsNaN(want) {
+			if !math.IsNaN(float64(f)) {
+				t.Fatalf("FP16ToFloat32(%#04x) = %v, want NaN", h, f)
+			}
+
+			// The NaN keeps the sign and the quiet bit, the payload is dropped.
+			if got := Float32ToFP16(f); got&0x7c00 != 0x7c00 || got&0x3ff == 0 || got&0x8000 != h&0x8000 {
+				t.Fatalf("Float32ToFP16(FP16ToFloat32(%#04x)) = %#04x, want NaN", h, got)
+			}
+
+			continue
+		}
+
+		if float64(f) != want || math.Signbit(float64(f)) != math.Signbit(want) {
+			t.Fatalf("FP16ToFloat32(%#04x) = %v, want %v", h, f, want)
+		}
+
+		if got := Float32ToFP16(f); got != h {
+			t.Fatalf("Float32ToFP16(FP16ToFloat32(%#04x)) = %#04x", h, got)
+		}
+	}
+}
+
+func TestFloat32ToFP16Rounding(t *testing.T) {
+	tests := []struct {
+		name string
+		f    float32
+		want uint16
+	}{
+		{name: "max", f: 65504, want: 0x7bff},
+		{name: "below the halfway of overflow", f: 65519, want: 0x7bff},
+		{name: "overflow", f: 65520, want: 0x7c00},
+		{name: "negative overflow", f: -1e10, want: 0xfc00},
+		{name: "halfway rounds to even down", f: 1 + 1.0/2048, want: 0x3c00},
+		{name: "halfway rounds to even up", f: 1 + 3.0/2048, want: 0x3c02},
+		{name: "above halfway", f: math.Nextafter32(1+1.0/2048, 2), want: 0x3c01},
+		{name: "min subnormal", f: 1.0 / (1 << 24), want: 0x0001},
+		{name: "halfway of min subnormal rounds to zero", f: 1.0 / (1 << 25), want: 0x0000},
+		{name: "above halfway of min subnormal", f: 1.5 / (1 << 25), want: 0x0001},
+		{name: "subnormal rounds to normal", f: math.Nextafter32(1.0/(1<<14), 0), want: 0x0400},
+		{name: "underflow", f: 1e-10, want: 0x0000},
+		{name: "negative zero", f: float32(math.Copysign(0, -1)), want: 0x8000},
+		{name: "infinity", f: float32(math.Inf(-1)), want: 0xfc00},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Float32ToFP16(tc.f); got != tc.want {
+				t.Fatalf("Float32ToFP16(%v) = %#04x, want %#04x", tc.f, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBF16AllBitPatterns(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		b := uint16(i)
+		f := BF16ToFloat32(b)
+		if bits := math.Float32bits(f); bits != uint32(b)<<16 {
+			t.Fatalf("BF16ToFloat32(%#04x) bits = %#08x, want %#08x", b, bits, uint32(b)<<16)
+		}
+
+		got := Float32ToBF16(f)
+		if math.IsNaN(float64(f)) {
+			// The signaling NaN is quieted.
+			if got != b|0x40 {
+				t.Fatalf("Float32ToBF16(BF16ToFloat32(%#04x)) = %#04x, want %#04x", b, got, b|0x40)
+			}
+
+			continue
+		}
+
+		if got != b {
+			t.Fatalf("Float32ToBF16(BF16ToFloat32(%#04x)) = %#04x", b, got)
+		}
+	}
+}
+
+func TestFloat32ToBF16Rounding(t *testing.T) {
+	tests := []struct {
+		name string
+		f    float32
+		want uint16
+	}{
+		{name: "halfway rounds to even down", f: 1 + 1.0/256, want: 0x3f80},
+		{name: "halfway rounds to even up", f: 1 + 3.0/256, want: 0x3f82},
+		{name: "above halfway", f: math.Nextafter32(1+1.0/256, 2), want: 0x3f81},
+		{name: "overflow", f: math.MaxFloat32, want: 0x7f80},
+		{name: "negative overflow", f: -math.MaxFloat32, want: 0xff80},
+		{name: "quiet NaN", f: float32(math.NaN()), want: 0x7fc0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Float32ToBF16(tc.f); got != tc.want {
+				t.Fatalf("Float32ToBF16(%v) = %#04x, want %#04x", tc.f, got, tc.want)
+			}
+		})
+	}
+}
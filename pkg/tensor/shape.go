@@ -0,0 +1,130 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"fmt"
+	"math"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// Config is the tensor config of the model, it is implemented by
+// inference.v1.ModelInput and inference.v1.ModelOutput.
+type Config interface {
+	GetName() string
+	GetDims() []int64
+	GetReshape() *inferencev1.ModelTensorReshape
+}
+
+// ElementCount returns the element count of the shape, the dimensions must not be negative.
+func ElementCount(shape []int64) (int64, error) {
+	count := int64(1)
+	for _, dim := range shape {
+		if dim < 0 {
+			return 0, fmt.Errorf("invalid dimension %d of shape %v", dim, shape)
+		}
+
+		if dim != 0 && count > math.MaxInt64/dim {
+			return 0, fmt.Errorf("element count of shape %v overflows", shape)
+		}
+
+		count *= dim
+	}
+
+	return count, nil
+}
+
+// ValidateShape validates the shape of the tensor against the dims of the config, the dimension
+// of -1 in the dims matches any size, and the first dimension of the shape is the batch dimension
+// if the max batch size of the model is greater than zero.
+func ValidateShape(config Config, maxBatchSize int32, shape []int64) error {
+	dims, err := batchDims(config, maxBatchSize, shape)
+	if err != nil {
+		return err
+	}
+
+	if len(dims) != len(config.GetDims()) {
+		return fmt.Errorf("tensor %s: shape %v does not match dims %v", config.GetName(), shape, config.GetDims())
+	}
+
+	for i, dim := range config.GetDims() {
+		if dims[i] < 0 || (dim != -1 && dims[i] != dim) {
+			return fmt.Errorf("tensor %s: shape %v does not match dims %v", config.GetName(), shape, config.GetDims())
+		}
+	}
+
+	return nil
+}
+
+// Reshape returns the shape which is presented to the model by the reshape of the config,
+// the batch dimension is kept, and the dimension of -1 in the reshape is resolved by the
+// element count of the shape. The shape is returned if the config has no reshape.
+func Reshape(config Config, maxBatchSize int32, shape []int64) ([]int64, error) {
+	if err := ValidateShape(config, maxBatchSize, shape); err != nil {
+		return nil, err
+	}
+
+	if config.GetReshape() == nil {
+		return shape, nil
+	}
+
+	dims, _ := batchDims(config, maxBatchSize, shape)
+	count, err := ElementCount(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	reshaped := append([]int64{}, shape[:len(shape)-len(dims)]...)
+	variable, known := -1, int64(1)
+	for i, dim := range config.GetReshape().GetShape() {
+		switch {
+		case dim == -1 && variable == -1:
+			variable = i
+		case dim <= 0:
+			return nil, fmt.Errorf("tensor %s: invalid reshape %v", config.GetName(), config.GetReshape().GetShape())
+		default:
+			known *= dim
+		}
+	}
+
+	resolved := append([]int64{}, config.GetReshape().GetShape()...)
+	if variable != -1 {
+		if count%known != 0 {
+			return nil, fmt.Errorf("tensor %s: shape %v can not be reshaped to %v", config.GetName(), shape, resolved)
+		}
+
+		resolved[variable] = count / known
+	} else if known != count {
+		return nil, fmt.Errorf("tensor %s: shape %v can not be reshaped to %v", config.GetName(), shape, resolved)
+	}
+
+	return append(reshaped, resolved...), nil
+}
+
+// batchDims returns the dimensions of the shape without the batch dimension.
+func batchDims(config Config, maxBatchSize int32, shape []int64) ([]int64, error) {
+	if maxBatchSize <= 0 {
+		return shape, nil
+	}
+
+	if len(shape) == 0 || shape[0] < 1 || shape[0] > int64(maxBatchSize) {
+		return nil, fmt.Errorf("tensor %s: batch size of shape %v is not in [1, %d]", config.GetName(), shape, maxBatchSize)
+	}
+
+	return shape[1:], nil
+}
@@ -0,0 +1,131 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// testConfig returns the input config of the dims and the reshape, the nil reshape represents no reshape.
+func testConfig(dims []int64, reshape []int64) *inferencev1.ModelInput {
+	config := &inferencev1.ModelInput{Name: "input", Dims: dims}
+	if reshape != nil {
+		config.Reshape = &inferencev1.ModelTensorReshape{Shape: reshape}
+	}
+
+	return config
+}
+
+func TestValidateShape(t *testing.T) {
+	tests := []struct {
+		name         string
+		dims         []int64
+		maxBatchSize int32
+		shape        []int64
+		ok           bool
+	}{
+		{name: "fixed dims", dims: []int64{2, 3}, shape: []int64{2, 3}, ok: true},
+		{name: "dims mismatch", dims: []int64{2, 3}, shape: []int64{3, 2}},
+		{name: "rank mismatch", dims: []int64{2, 3}, shape: []int64{6}},
+		{name: "variable dim", dims: []int64{-1, 3}, shape: []int64{5, 3}, ok: true},
+		{name: "variable dim with negative size", dims: []int64{-1, 3}, shape: []int64{-1, 3}},
+		{name: "zero size of variable dim", dims: []int64{-1}, shape: []int64{0}, ok: true},
+		{name: "batch", dims: []int64{-1, 3}, maxBatchSize: 4, shape: []int64{4, 2, 3}, ok: true},
+		{name: "batch without batch dimension", dims: []int64{2, 3}, maxBatchSize: 4, shape: []int64{2, 3}},
+		{name: "batch size exceeds max batch size", dims: []int64{3}, maxBatchSize: 4, shape: []int64{5, 3}},
+		{name: "zero batch size", dims: []int64{3}, maxBatchSize: 4, shape: []int64{0, 3}},
+		{name: "empty shape with batch", dims: []int64{}, maxBatchSize: 4},
+		{name: "scalar of batch", dims: []int64{}, maxBatchSize: 4, shape: []int64{2}, ok: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateShape(testConfig(tc.dims, nil), tc.maxBatchSize, tc.shape); (err == nil) != tc.ok {
+				t.Fatalf("ValidateShape() error = %v, want ok %t", err, tc.ok)
+			}
+		})
+	}
+}
+
+func TestReshape(t *testing.T) {
+	tests := []struct {
+		name         string
+		dims         []int64
+		reshape      []int64
+		maxBatchSize int32
+		shape        []int64
+		want         []int64
+	}{
+		{name: "no reshape", dims: []int64{-1, 3}, shape: []int64{2, 3}, want: []int64{2, 3}},
+		{name: "flatten", dims: []int64{2, 3}, reshape: []int64{6}, shape: []int64{2, 3}, want: []int64{6}},
+		{name: "flatten with batch", dims: []int64{2, 3}, reshape: []int64{6}, maxBatchSize: 8, shape: []int64{4, 2, 3}, want: []int64{4, 6}},
+		{name: "resolve variable dim", dims: []int64{-1, 4}, reshape: []int64{2, -1}, shape: []int64{3, 4}, want: []int64{2, 6}},
+		{name: "resolve variable dim with batch", dims: []int64{-1}, reshape: []int64{-1, 2}, maxBatchSize: 2, shape: []int64{2, 6}, want: []int64{2, 3, 2}},
+		{name: "scalar", dims: []int64{1}, reshape: []int64{}, shape: []int64{1}, want: []int64{}},
+		{name: "element count mismatch", dims: []int64{2, 3}, reshape: []int64{4}, shape: []int64{2, 3}},
+		{name: "indivisible variable dim", dims: []int64{2, 3}, reshape: []int64{-1, 4}, shape: []int64{2, 3}},
+		{name: "two variable dims", dims: []int64{2, 3}, reshape: []int64{-1, -1}, shape: []int64{2, 3}},
+		{name: "zero reshape dim", dims: []int64{2, 3}, reshape: []int64{0, 6}, shape: []int64{2, 3}},
+		{name: "invalid shape", dims: []int64{2, 3}, reshape: []int64{6}, shape: []int64{3, 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Reshape(testConfig(tc.dims, tc.reshape), tc.maxBatchSize, tc.shape)
+			if tc.want == nil {
+				if err == nil {
+					t.Fatalf("Reshape() = %v, want error", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Reshape() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("Reshape() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestElementCount(t *testing.T) {
+	tests := []struct {
+		shape []int64
+		want  int64
+		ok    bool
+	}{
+		{shape: nil, want: 1, ok: true},
+		{shape: []int64{2, 3, 4}, want: 24, ok: true},
+		{shape: []int64{2, 0, math.MaxInt64}, want: 0, ok: true},
+		{shape: []int64{2, -1}},
+		{shape: []int64{math.MaxInt64, 2}},
+	}
+
+	for _, tc := range tests {
+		count, err := ElementCount(tc.shape)
+		if (err == nil) != tc.ok || count != tc.want {
+			t.Fatalf("ElementCount(%v) = %d, %v, want %d and ok %t", tc.shape, count, err, tc.want, tc.ok)
+		}
+	}
+}
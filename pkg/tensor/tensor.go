@@ -0,0 +1,174 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"fmt"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+// NewInput returns the input tensor of the values with the typed contents.
+func NewInput[T Element](name, datatype string, shape []int64, values []T) (*inferencev1.ModelInferRequest_InferInputTensor, error) {
+	if err := checkCount(name, shape, len(values)); err != nil {
+		return nil, err
+	}
+
+	contents, err := Contents(datatype, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inferencev1.ModelInferRequest_InferInputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+		Contents: contents,
+	}, nil
+}
+
+// AppendRawInput appends the input tensor of the values with the raw contents to the request,
+// the inputs of the request must be all represented as the raw contents.
+func AppendRawInput[T Element](req *inferencev1.ModelInferRequest, name, datatype string, shape []int64, values []T) error {
+	if len(req.GetInputs()) != len(req.GetRawInputContents()) {
+		return fmt.Errorf("request mixes typed contents and raw contents")
+	}
+
+	if err := checkCount(name, shape, len(values)); err != nil {
+		return err
+	}
+
+	raw, err := EncodeRaw(datatype, values)
+	if err != nil {
+		return err
+	}
+
+	req.Inputs = append(req.Inputs, &inferencev1.ModelInferRequest_InferInputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+	})
+	req.RawInputContents = append(req.RawInputContents, raw)
+	return nil
+}
+
+// Input returns the values and the shape of the input tensor of the request,
+// the values are decoded from the raw contents or the typed contents.
+func Input[T Element](req *inferencev1.ModelInferRequest, name string) ([]T, []int64, error) {
+	for i, input := range req.GetInputs() {
+		if input.GetName() != name {
+			continue
+		}
+
+		var values []T
+		var err error
+		if len(req.GetRawInputContents()) > 0 {
+			if i >= len(req.GetRawInputContents()) {
+				return nil, nil, fmt.Errorf("raw contents of input %s not found", name)
+			}
+
+			values, err = DecodeRaw[T](input.GetDatatype(), req.GetRawInputContents()[i])
+		} else {
+			values, err = FromContents[T](input.GetDatatype(), input.GetContents())
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("input %s: %w", name, err)
+		}
+
+		if err := checkCount(name, input.GetShape(), len(values)); err != nil {
+			return nil, nil, err
+		}
+
+		return values, input.GetShape(), nil
+	}
+
+	return nil, nil, fmt.Errorf("input %s not found", name)
+}
+
+// AppendRawOutput appends the output tensor of the values with the raw contents to the response,
+// the outputs of the response must be all represented as the raw contents.
+func AppendRawOutput[T Element](resp *inferencev1.ModelInferResponse, name, datatype string, shape []int64, values []T) error {
+	if len(resp.GetOutputs()) != len(resp.GetRawOutputContents()) {
+		return fmt.Errorf("response mixes typed contents and raw contents")
+	}
+
+	if err := checkCount(name, shape, len(values)); err != nil {
+		return err
+	}
+
+	raw, err := EncodeRaw(datatype, values)
+	if err != nil {
+		return err
+	}
+
+	resp.Outputs = append(resp.Outputs, &inferencev1.ModelInferResponse_InferOutputTensor{
+		Name:     name,
+		Datatype: datatype,
+		Shape:    shape,
+	})
+	resp.RawOutputContents = append(resp.RawOutputContents, raw)
+	return nil
+}
+
+// Output returns the values and the shape of the output tensor of the response,
+// the values are decoded from the raw contents or the typed contents.
+func Output[T Element](resp *inferencev1.ModelInferResponse, name string) ([]T, []int64, error) {
+	for i, output := range resp.GetOutputs() {
+		if output.GetName() != name {
+			continue
+		}
+
+		var values []T
+		var err error
+		if len(resp.GetRawOutputContents()) > 0 {
+			if i >= len(resp.GetRawOutputContents()) {
+				return nil, nil, fmt.Errorf("raw contents of output %s not found", name)
+			}
+
+			values, err = DecodeRaw[T](output.GetDatatype(), resp.GetRawOutputContents()[i])
+		} else {
+			values, err = FromContents[T](output.GetDatatype(), output.GetContents())
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("output %s: %w", name, err)
+		}
+
+		if err := checkCount(name, output.GetShape(), len(values)); err != nil {
+			return nil, nil, err
+		}
+
+		return values, output.GetShape(), nil
+	}
+
+	return nil, nil, fmt.Errorf("output %s not found", name)
+}
+
+// checkCount returns error if the element count of the shape does not match the count of the values.
+func checkCount(name string, shape []int64, n int) error {
+	count, err := ElementCount(shape)
+	if err != nil {
+		return fmt.Errorf("tensor %s: %w", name, err)
+	}
+
+	if count != int64(n) {
+		return fmt.Errorf("tensor %s: shape %v requires %d elements, but got %d", name, shape, count, n)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tensor
+
+import (
+	"reflect"
+	"testing"
+
+	inferencev1 "d7y.io/api/v2/pkg/apis/inference/v1"
+)
+
+func TestTypedInput(t *testing.T) {
+	input, err := NewInput("features", DatatypeFP32, []int64{2, 2}, []float32{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("NewInput() error = %v", err)
+	}
+
+	req := &inferencev1.ModelInferRequest{Inputs: []*inferencev1.ModelInferRequest_InferInputTensor{input}}
+	values, shape, err := Input[float32](req, "features")
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(values, []float32{1, 2, 3, 4}) || !reflect.DeepEqual(shape, []int64{2, 2}) {
+		t.Fatalf("Input() = %v, %v", values, shape)
+	}
+
+	if _, err := NewInput("features", DatatypeFP32, []int64{3}, []float32{1, 2}); err == nil {
+		t.Fatal("NewInput() of mismatched shape error = nil, want error")
+	}
+
+	if _, _, err := Input[float32](req, "unknown"); err == nil {
+		t.Fatal("Input() of unknown input error = nil, want error")
+	}
+}
+
+func TestRawInput(t *testing.T) {
+	req := &inferencev1.ModelInferRequest{}
+	if err := AppendRawInput(req, "features", DatatypeFP16, []int64{2}, []float32{0.5, -2}); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	if err := AppendRawInput(req, "ids", DatatypeBytes, []int64{1}, [][]byte{[]byte("foo")}); err != nil {
+		t.Fatalf("AppendRawInput() error = %v", err)
+	}
+
+	features, shape, err := Input[float32](req, "features")
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(features, []float32{0.5, -2}) || !reflect.DeepEqual(shape, []int64{2}) {
+		t.Fatalf("Input() = %v, %v", features, shape)
+	}
+
+	ids, _, err := Input[[]byte](req, "ids")
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, [][]byte{[]byte("foo")}) {
+		t.Fatalf("Input() = %q", ids)
+	}
+
+	// The shape of the raw contents is checked when the input is read.
+	req.Inputs[0].Shape = []int64{3}
+	if _, _, err := Input[float32](req, "features"); err == nil {
+		t.Fatal("Input() of mismatched shape error = nil, want error")
+	}
+}
+
+func TestRawInputMixedContents(t *testing.T) {
+	input, err := NewInput("typed", DatatypeInt64, []int64{1}, []int64{1})
+	if err != nil {
+		t.Fatalf("NewInput() error = %v", err)
+	}
+
+	req := &inferencev1.ModelInferRequest{Inputs: []*inferencev1.ModelInferRequest_InferInputTensor{input}}
+	if err := AppendRawInput(req, "raw", DatatypeInt64, []int64{1}, []int64{2}); err == nil {
+		t.Fatal("AppendRawInput() to typed request error = nil, want error")
+	}
+}
+
+func TestRawOutput(t *testing.T) {
+	resp := &inferencev1.ModelInferResponse{}
+	if err := AppendRawOutput(resp, "scores", DatatypeBF16, []int64{1, 2}, []float32{1, 0.5}); err != nil {
+		t.Fatalf("AppendRawOutput() error = %v", err)
+	}
+
+	values, shape, err := Output[float32](resp, "scores")
+	if err != nil {
+		t.Fatalf("Output() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(values, []float32{1, 0.5}) || !reflect.DeepEqual(shape, []int64{1, 2}) {
+		t.Fatalf("Output() = %v, %v", values, shape)
+	}
+
+	if _, _, err := Output[float64](resp, "scores"); err == nil {
+		t.Fatal("Output() of mismatched element type error = nil, want error")
+	}
+
+	// The typed outputs are decoded from the typed contents.
+	typed := &inferencev1.ModelInferResponse{Outputs: []*inferencev1.ModelInferResponse_InferOutputTensor{
+		{Name: "scores", Datatype: DatatypeFP64, Shape: []int64{1}, Contents: &inferencev1.InferTensorContents{Fp64Contents: []float64{0.25}}},
+	}}
+	if scores, _, err := Output[float64](typed, "scores"); err != nil || !reflect.DeepEqual(scores, []float64{0.25}) {
+		t.Fatalf("Output() = %v, %v", scores, err)
+	}
+}
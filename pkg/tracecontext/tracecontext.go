@@ -0,0 +1,107 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracecontext injects and extracts the OpenTelemetry span context into and from
+// common.v2.TraceContext, which is carried by common.v2.Download, scheduler.v2.AnnouncePeerRequest
+// and dfdaemon.v2.SyncPiecesRequest, so the spans of the pieces downloaded on the different
+// streams belong to the trace of the download.
+package tracecontext
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+const (
+	// TraceparentKey is the key of W3C traceparent.
+	TraceparentKey = "traceparent"
+
+	// TracestateKey is the key of W3C tracestate.
+	TracestateKey = "tracestate"
+)
+
+// propagator is the W3C trace context propagator.
+var propagator = propagation.TraceContext{}
+
+// Carrier adapts common.v2.TraceContext to propagation.TextMapCarrier,
+// the keys other than traceparent and tracestate are ignored.
+type Carrier struct {
+	// TraceContext is the carried trace context.
+	TraceContext *commonv2.TraceContext
+}
+
+// Get returns the value of the key.
+func (c Carrier) Get(key string) string {
+	switch key {
+	case TraceparentKey:
+		return c.TraceContext.GetTraceparent()
+	case TracestateKey:
+		return c.TraceContext.GetTracestate()
+	default:
+		return ""
+	}
+}
+
+// Set sets the value of the key, it is a no-op if the trace context is nil.
+func (c Carrier) Set(key, value string) {
+	if c.TraceContext == nil {
+		return
+	}
+
+	switch key {
+	case TraceparentKey:
+		c.TraceContext.Traceparent = value
+	case TracestateKey:
+		c.TraceContext.Tracestate = value
+	}
+}
+
+// Keys returns the keys of the carrier.
+func (c Carrier) Keys() []string {
+	return []string{TraceparentKey, TracestateKey}
+}
+
+// Inject returns the trace context of the span context of the context, it returns nil
+// if the context has no valid span context.
+func Inject(ctx context.Context) *commonv2.TraceContext {
+	tc := &commonv2.TraceContext{}
+	propagator.Inject(ctx, Carrier{TraceContext: tc})
+	if tc.GetTraceparent() == "" {
+		return nil
+	}
+
+	return tc
+}
+
+// Extract returns the context with the remote span context of the trace context, the
+// context is returned unchanged if the trace context is empty or invalid.
+func Extract(ctx context.Context, tc *commonv2.TraceContext) context.Context {
+	if tc.GetTraceparent() == "" {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, Carrier{TraceContext: tc})
+}
+
+// SpanContext returns the remote span context of the trace context, it is invalid
+// if the trace context is empty or invalid.
+func SpanContext(tc *commonv2.TraceContext) trace.SpanContext {
+	return trace.SpanContextFromContext(Extract(context.Background(), tc))
+}
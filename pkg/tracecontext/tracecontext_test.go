@@ -0,0 +1,145 @@
+/*
+ *     Copyright 2023 The Dragonfly Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracecontext
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	commonv2 "d7y.io/api/v2/pkg/apis/common/v2"
+)
+
+// testSpanContext returns the sampled span context of the tests with the trace state.
+func testSpanContext(t *testing.T) trace.SpanContext {
+	t.Helper()
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatalf("TraceIDFromHex() error = %v", err)
+	}
+
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatalf("SpanIDFromHex() error = %v", err)
+	}
+
+	traceState, err := trace.ParseTraceState("congo=t61rcWkgMzE,rojo=00f067aa0ba902b7")
+	if err != nil {
+		t.Fatalf("ParseTraceState() error = %v", err)
+	}
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		TraceState: traceState,
+	})
+}
+
+func TestInjectExtract(t *testing.T) {
+	sc := testSpanContext(t)
+	tc := Inject(trace.ContextWithSpanContext(context.Background(), sc))
+	if tc == nil {
+		t.Fatal("Inject() = nil, want trace context")
+	}
+
+	if want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"; tc.GetTraceparent() != want {
+		t.Fatalf("Inject() traceparent = %s, want %s", tc.GetTraceparent(), want)
+	}
+
+	if want := "congo=t61rcWkgMzE,rojo=00f067aa0ba902b7"; tc.GetTracestate() != want {
+		t.Fatalf("Inject() tracestate = %s, want %s", tc.GetTracestate(), want)
+	}
+
+	// The extracted span context is the remote span context of the injected one.
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), tc))
+	if !extracted.Equal(sc.WithRemote(true)) {
+		t.Fatalf("Extract() span context = %v, want %v", extracted, sc.WithRemote(true))
+	}
+
+	if got := SpanContext(tc); !got.Equal(extracted) {
+		t.Fatalf("SpanContext() = %v, want %v", got, extracted)
+	}
+}
+
+func TestInjectWithoutSpanContext(t *testing.T) {
+	if tc := Inject(context.Background()); tc != nil {
+		t.Fatalf("Inject() = %v, want nil", tc)
+	}
+}
+
+func TestExtractInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		tc   *commonv2.TraceContext
+	}{
+		{name: "nil trace context"},
+		{name: "empty traceparent", tc: &commonv2.TraceContext{Tracestate: "congo=t61rcWkgMzE"}},
+		{name: "invalid traceparent", tc: &commonv2.TraceContext{Traceparent: "00-foo-bar-01"}},
+		{name: "zero trace id", tc: &commonv2.TraceContext{Traceparent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if sc := trace.SpanContextFromContext(Extract(ctx, tc.tc)); sc.IsValid() {
+				t.Fatalf("Extract() span context = %v, want invalid", sc)
+			}
+
+			if sc := SpanContext(tc.tc); sc.IsValid() {
+				t.Fatalf("SpanContext() = %v, want invalid", sc)
+			}
+		})
+	}
+}
+
+func TestTracestatePassthrough(t *testing.T) {
+	tc := &commonv2.TraceContext{
+		Traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		Tracestate:  "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE",
+	}
+
+	// The trace context of the upstream is forwarded to the downstream unchanged.
+	forwarded := Inject(Extract(context.Background(), tc))
+	if forwarded.GetTraceparent() != tc.GetTraceparent() || forwarded.GetTracestate() != tc.GetTracestate() {
+		t.Fatalf("Inject(Extract()) = %v, want %v", forwarded, tc)
+	}
+
+	if sc := SpanContext(tc); sc.IsSampled() || sc.TraceState().Get("congo") != "t61rcWkgMzE" {
+		t.Fatalf("SpanContext() = %v, want unsampled with tracestate", sc)
+	}
+}
+
+func TestCarrier(t *testing.T) {
+	tc := &commonv2.TraceContext{}
+	c := Carrier{TraceContext: tc}
+	c.Set(TraceparentKey, "foo")
+	c.Set(TracestateKey, "bar")
+	c.Set("baggage", "baz")
+
+	if c.Get(TraceparentKey) != "foo" || c.Get(TracestateKey) != "bar" || c.Get("baggage") != "" {
+		t.Fatalf("Carrier = %v", tc)
+	}
+
+	// The carrier of the nil trace context is empty and ignores the values.
+	nilCarrier := Carrier{}
+	nilCarrier.Set(TraceparentKey, "foo")
+	if nilCarrier.Get(TraceparentKey) != "" || nilCarrier.Get(TracestateKey) != "" {
+		t.Fatal("Carrier of nil trace context is not empty")
+	}
+}
@@ -10,6 +10,12 @@ pub mod dfdaemon {
     pub mod v2;
 }
 
+#[path = ""]
+pub mod inference {
+    #[path = "inference.v1.rs"]
+    pub mod v1;
+}
+
 #[path = ""]
 pub mod manager {
     #[path = "manager.v2.rs"]